@@ -0,0 +1,907 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Category is a catalog category. Departments are categories without a parent
+type Category struct {
+	ID                            int64  `json:"Id,omitempty"`
+	Name                          string `json:"Name"`
+	FatherCategoryID              *int64 `json:"FatherCategoryId"`
+	Title                         string `json:"Title"`
+	Description                   string `json:"Description"`
+	Keywords                      string `json:"Keywords"`
+	IsActive                      bool   `json:"IsActive"`
+	ShowInStoreFront              bool   `json:"ShowInStoreFront"`
+	ActiveStoreFrontLink          bool   `json:"ActiveStoreFrontLink"`
+	ShowBrandFilter               bool   `json:"ShowBrandFilter"`
+	GlobalCategoryID              int64  `json:"GlobalCategoryId"`
+	Score                         *int64 `json:"Score"`
+	StockKeepingUnitSelectionMode string `json:"StockKeepingUnitSelectionMode"`
+	LinkID                        string `json:"LinkId,omitempty"`
+	HasChildren                   bool   `json:"HasChildren,omitempty"`
+}
+
+// CreateCategory creates a category, or a department when it has no parent
+func (c *VtexClient) CreateCategory(ctx context.Context, category Category) (*Category, error) {
+	var result Category
+	if err := c.Post(ctx, "/api/catalog/pvt/category", category, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCategory gets a category by ID
+func (c *VtexClient) GetCategory(ctx context.Context, categoryID int64) (*Category, error) {
+	var result Category
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/category/%d", categoryID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateCategory replaces a category. Changing FatherCategoryID moves it in the tree
+func (c *VtexClient) UpdateCategory(ctx context.Context, categoryID int64, category Category) (*Category, error) {
+	var result Category
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/category/%d", categoryID), category, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SpecificationGroup groups specification fields of a category
+type SpecificationGroup struct {
+	ID         int64  `json:"Id,omitempty"`
+	CategoryID int64  `json:"CategoryId"`
+	Name       string `json:"Name"`
+	Position   *int64 `json:"Position"`
+}
+
+// CreateSpecificationGroup creates a specification group in a category
+func (c *VtexClient) CreateSpecificationGroup(ctx context.Context, group SpecificationGroup) (*SpecificationGroup, error) {
+	var result SpecificationGroup
+	if err := c.Post(ctx, "/api/catalog/pvt/specificationgroup", group, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSpecificationGroup gets a specification group by ID
+func (c *VtexClient) GetSpecificationGroup(ctx context.Context, groupID int64) (*SpecificationGroup, error) {
+	var result SpecificationGroup
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/specificationgroup/%d", groupID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSpecificationGroup replaces the name and position of a specification group
+func (c *VtexClient) UpdateSpecificationGroup(ctx context.Context, groupID int64, group SpecificationGroup) (*SpecificationGroup, error) {
+	var result SpecificationGroup
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/specificationgroup/%d", groupID), group, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSpecificationGroup deletes a specification group
+func (c *VtexClient) DeleteSpecificationGroup(ctx context.Context, groupID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/catalog/pvt/specificationgroup/%d", groupID), nil)
+}
+
+// SpecificationField is a product or SKU specification field of a category
+type SpecificationField struct {
+	ID                   int64  `json:"Id,omitempty"`
+	FieldTypeID          int64  `json:"FieldTypeId"`
+	CategoryID           int64  `json:"CategoryId"`
+	FieldGroupID         int64  `json:"FieldGroupId"`
+	Name                 string `json:"Name"`
+	Description          string `json:"Description"`
+	Position             *int64 `json:"Position"`
+	IsFilter             bool   `json:"IsFilter"`
+	IsRequired           bool   `json:"IsRequired"`
+	IsOnProductDetails   bool   `json:"IsOnProductDetails"`
+	IsStockKeepingUnit   bool   `json:"IsStockKeepingUnit"`
+	IsActive             bool   `json:"IsActive"`
+	IsTopMenuLinkActive  bool   `json:"IsTopMenuLinkActive"`
+	IsSideMenuLinkActive bool   `json:"IsSideMenuLinkActive"`
+	DefaultValue         string `json:"DefaultValue"`
+}
+
+// CreateSpecificationField creates a specification field in a group
+func (c *VtexClient) CreateSpecificationField(ctx context.Context, field SpecificationField) (*SpecificationField, error) {
+	var result SpecificationField
+	if err := c.Post(ctx, "/api/catalog/pvt/specification", field, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSpecificationField gets a specification field by ID
+func (c *VtexClient) GetSpecificationField(ctx context.Context, fieldID int64) (*SpecificationField, error) {
+	var result SpecificationField
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/specification/%d", fieldID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSpecificationField replaces a specification field
+func (c *VtexClient) UpdateSpecificationField(ctx context.Context, fieldID int64, field SpecificationField) (*SpecificationField, error) {
+	var result SpecificationField
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/specification/%d", fieldID), field, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SpecificationValue is a specification field value associated with a product or SKU.
+// FieldValueID is set for fields with predefined values (Combo, Radio, Checkbox), Text for free text.
+type SpecificationValue struct {
+	ID           int64  `json:"Id,omitempty"`
+	FieldID      int64  `json:"FieldId"`
+	FieldValueID *int64 `json:"FieldValueId,omitempty"`
+	Text         string `json:"Text,omitempty"`
+}
+
+// specificationEndpoint returns the specification path of a product, or of a SKU when sku is true
+func specificationEndpoint(sku bool, ownerID int64) string {
+	if sku {
+		return fmt.Sprintf("/api/catalog/pvt/stockkeepingunit/%d/specification", ownerID)
+	}
+	return fmt.Sprintf("/api/catalog/pvt/product/%d/specification", ownerID)
+}
+
+// CreateSpecificationValue associates a specification value with a product or SKU
+func (c *VtexClient) CreateSpecificationValue(ctx context.Context, sku bool, ownerID int64, value SpecificationValue) (*SpecificationValue, error) {
+	var result SpecificationValue
+	if err := c.Post(ctx, specificationEndpoint(sku, ownerID), value, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListSpecificationValues gets the specification values of a product or SKU
+func (c *VtexClient) ListSpecificationValues(ctx context.Context, sku bool, ownerID int64) ([]SpecificationValue, error) {
+	var result []SpecificationValue
+	if err := c.Get(ctx, specificationEndpoint(sku, ownerID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteSpecificationValue removes a specification value from a product or SKU
+func (c *VtexClient) DeleteSpecificationValue(ctx context.Context, sku bool, ownerID, valueID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("%s/%d", specificationEndpoint(sku, ownerID), valueID), nil)
+}
+
+// Collection is a catalog collection of products, used by campaigns and shelves
+type Collection struct {
+	ID            int64  `json:"Id,omitempty"`
+	Name          string `json:"Name"`
+	Searchable    bool   `json:"Searchable"`
+	Highlight     bool   `json:"Highlight"`
+	DateFrom      string `json:"DateFrom"`
+	DateTo        string `json:"DateTo"`
+	TotalProducts int64  `json:"TotalProducts,omitempty"`
+	Type          string `json:"Type,omitempty"`
+}
+
+// CreateCollection creates a collection
+func (c *VtexClient) CreateCollection(ctx context.Context, collection Collection) (*Collection, error) {
+	var result Collection
+	if err := c.Post(ctx, "/api/catalog/pvt/collection/", collection, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCollection gets a collection by ID
+func (c *VtexClient) GetCollection(ctx context.Context, collectionID int64) (*Collection, error) {
+	var result Collection
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/collection/%d", collectionID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateCollection replaces the settings of a collection
+func (c *VtexClient) UpdateCollection(ctx context.Context, collectionID int64, collection Collection) (*Collection, error) {
+	var result Collection
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/collection/%d", collectionID), collection, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteCollection deletes a collection
+func (c *VtexClient) DeleteCollection(ctx context.Context, collectionID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/catalog/pvt/collection/%d", collectionID), nil)
+}
+
+// Subcollection is a group of SKUs (or categories, brands) inside a collection
+type Subcollection struct {
+	ID           int64  `json:"Id,omitempty"`
+	CollectionID int64  `json:"CollectionId"`
+	Name         string `json:"Name"`
+	Type         string `json:"Type"`
+	PreSale      bool   `json:"PreSale"`
+	Release      bool   `json:"Release"`
+}
+
+// CreateSubcollection creates a subcollection in a collection
+func (c *VtexClient) CreateSubcollection(ctx context.Context, subcollection Subcollection) (*Subcollection, error) {
+	var result Subcollection
+	if err := c.Post(ctx, "/api/catalog/pvt/subcollection", subcollection, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSubcollection gets a subcollection by ID
+func (c *VtexClient) GetSubcollection(ctx context.Context, subcollectionID int64) (*Subcollection, error) {
+	var result Subcollection
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/subcollection/%d", subcollectionID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSubcollection deletes a subcollection and removes its SKUs from the collection
+func (c *VtexClient) DeleteSubcollection(ctx context.Context, subcollectionID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/catalog/pvt/subcollection/%d", subcollectionID), nil)
+}
+
+// ImportCollectionSKUs adds SKUs to a collection with one spreadsheet upload
+func (c *VtexClient) ImportCollectionSKUs(ctx context.Context, collectionID int64, skuIDs []int64) error {
+	return c.postCollectionSKUFile(ctx, fmt.Sprintf("/api/catalog/pvt/collection/%d/stockkeepingunit/importinsert", collectionID), skuIDs)
+}
+
+// ExcludeCollectionSKUs removes SKUs from a collection with one spreadsheet upload
+func (c *VtexClient) ExcludeCollectionSKUs(ctx context.Context, collectionID int64, skuIDs []int64) error {
+	return c.postCollectionSKUFile(ctx, fmt.Sprintf("/api/catalog/pvt/collection/%d/stockkeepingunit/importexclude", collectionID), skuIDs)
+}
+
+// postCollectionSKUFile uploads the SKU IDs as the spreadsheet the collection import endpoints expect
+func (c *VtexClient) postCollectionSKUFile(ctx context.Context, endpoint string, skuIDs []int64) error {
+	content, err := skuSpreadsheet(skuIDs)
+	if err != nil {
+		return fmt.Errorf("could not build the SKU spreadsheet: %w", err)
+	}
+	return c.PostFile(ctx, endpoint, "file", "skus.xlsx", content, nil)
+}
+
+// Attachment is a customization (engraving, gift message) that can be added to SKUs
+type Attachment struct {
+	ID         int64              `json:"Id,omitempty"`
+	Name       string             `json:"Name"`
+	IsRequired bool               `json:"IsRequired"`
+	IsActive   bool               `json:"IsActive"`
+	Domains    []AttachmentDomain `json:"Domains"`
+}
+
+// AttachmentDomain is one field of an attachment. MaxCaracters is spelled as in the API
+type AttachmentDomain struct {
+	FieldName    string `json:"FieldName"`
+	MaxCaracters string `json:"MaxCaracters"`
+	DomainValues string `json:"DomainValues"`
+}
+
+// SKUAttachment is the association of an attachment with a SKU
+type SKUAttachment struct {
+	ID           int64 `json:"Id,omitempty"`
+	AttachmentID int64 `json:"AttachmentId"`
+	SkuID        int64 `json:"SkuId"`
+}
+
+// CreateAttachment creates an attachment
+func (c *VtexClient) CreateAttachment(ctx context.Context, attachment Attachment) (*Attachment, error) {
+	var result Attachment
+	if err := c.Post(ctx, "/api/catalog/pvt/attachment", attachment, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAttachment gets an attachment by ID
+func (c *VtexClient) GetAttachment(ctx context.Context, attachmentID int64) (*Attachment, error) {
+	var result Attachment
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/attachment/%d", attachmentID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateAttachment replaces an attachment
+func (c *VtexClient) UpdateAttachment(ctx context.Context, attachmentID int64, attachment Attachment) (*Attachment, error) {
+	var result Attachment
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/attachment/%d", attachmentID), attachment, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteAttachment deletes an attachment
+func (c *VtexClient) DeleteAttachment(ctx context.Context, attachmentID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/catalog/pvt/attachment/%d", attachmentID), nil)
+}
+
+// AddSKUAttachment associates an attachment with a SKU
+func (c *VtexClient) AddSKUAttachment(ctx context.Context, skuID, attachmentID int64) error {
+	payload := SKUAttachment{AttachmentID: attachmentID, SkuID: skuID}
+	return c.Post(ctx, "/api/catalog/pvt/skuattachment", payload, nil)
+}
+
+// ListSKUAttachments gets the attachment associations of a SKU
+func (c *VtexClient) ListSKUAttachments(ctx context.Context, skuID int64) ([]SKUAttachment, error) {
+	var result []SKUAttachment
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/stockkeepingunit/%d/attachment", skuID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RemoveSKUAttachment removes the association of an attachment with a SKU
+func (c *VtexClient) RemoveSKUAttachment(ctx context.Context, skuID, attachmentID int64) error {
+	endpoint := fmt.Sprintf("/api/catalog/pvt/skuattachment?skuId=%d&attachmentId=%d", skuID, attachmentID)
+	return c.Delete(ctx, endpoint, nil)
+}
+
+// SKUServiceType is a kind of service sold with SKUs (warranty, gift wrap)
+type SKUServiceType struct {
+	ID                    int64  `json:"Id,omitempty"`
+	Name                  string `json:"Name"`
+	IsActive              bool   `json:"IsActive"`
+	ShowOnProductFront    bool   `json:"ShowOnProductFront"`
+	ShowOnCartFront       bool   `json:"ShowOnCartFront"`
+	ShowOnAttachmentFront bool   `json:"ShowOnAttachmentFront"`
+	ShowOnFileUpload      bool   `json:"ShowOnFileUpload"`
+	IsGiftCard            bool   `json:"IsGiftCard"`
+	IsRequired            bool   `json:"IsRequired"`
+}
+
+// SKUServiceValue is a priced option of a service type (e.g. 12 month warranty)
+type SKUServiceValue struct {
+	ID               int64   `json:"Id,omitempty"`
+	SkuServiceTypeID int64   `json:"SkuServiceTypeId"`
+	Name             string  `json:"Name"`
+	Value            float64 `json:"Value"`
+	Cost             float64 `json:"Cost"`
+}
+
+// SKUService binds a service type and value to a SKU
+type SKUService struct {
+	ID                int64  `json:"Id,omitempty"`
+	SkuServiceTypeID  int64  `json:"SkuServiceTypeId"`
+	SkuServiceValueID int64  `json:"SkuServiceValueId"`
+	SkuID             int64  `json:"SkuId"`
+	Name              string `json:"Name"`
+	Text              string `json:"Text"`
+	IsActive          bool   `json:"IsActive"`
+}
+
+// CreateSKUServiceType creates a SKU service type
+func (c *VtexClient) CreateSKUServiceType(ctx context.Context, serviceType SKUServiceType) (*SKUServiceType, error) {
+	var result SKUServiceType
+	if err := c.Post(ctx, "/api/catalog/pvt/skuservicetype", serviceType, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSKUServiceType gets a SKU service type by ID
+func (c *VtexClient) GetSKUServiceType(ctx context.Context, serviceTypeID int64) (*SKUServiceType, error) {
+	var result SKUServiceType
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/skuservicetype/%d", serviceTypeID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSKUServiceType replaces a SKU service type
+func (c *VtexClient) UpdateSKUServiceType(ctx context.Context, serviceTypeID int64, serviceType SKUServiceType) (*SKUServiceType, error) {
+	var result SKUServiceType
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/skuservicetype/%d", serviceTypeID), serviceType, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSKUServiceType deletes a SKU service type
+func (c *VtexClient) DeleteSKUServiceType(ctx context.Context, serviceTypeID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/catalog/pvt/skuservicetype/%d", serviceTypeID), nil)
+}
+
+// CreateSKUServiceValue creates a SKU service value
+func (c *VtexClient) CreateSKUServiceValue(ctx context.Context, value SKUServiceValue) (*SKUServiceValue, error) {
+	var result SKUServiceValue
+	if err := c.Post(ctx, "/api/catalog/pvt/skuservicevalue", value, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSKUServiceValue gets a SKU service value by ID
+func (c *VtexClient) GetSKUServiceValue(ctx context.Context, valueID int64) (*SKUServiceValue, error) {
+	var result SKUServiceValue
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/skuservicevalue/%d", valueID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSKUServiceValue replaces a SKU service value
+func (c *VtexClient) UpdateSKUServiceValue(ctx context.Context, valueID int64, value SKUServiceValue) (*SKUServiceValue, error) {
+	var result SKUServiceValue
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/skuservicevalue/%d", valueID), value, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSKUServiceValue deletes a SKU service value
+func (c *VtexClient) DeleteSKUServiceValue(ctx context.Context, valueID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/catalog/pvt/skuservicevalue/%d", valueID), nil)
+}
+
+// CreateSKUService binds a service to a SKU
+func (c *VtexClient) CreateSKUService(ctx context.Context, service SKUService) (*SKUService, error) {
+	var result SKUService
+	if err := c.Post(ctx, "/api/catalog/pvt/skuservice", service, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSKUService gets a SKU service by ID
+func (c *VtexClient) GetSKUService(ctx context.Context, serviceID int64) (*SKUService, error) {
+	var result SKUService
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/skuservice/%d", serviceID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSKUService replaces a SKU service
+func (c *VtexClient) UpdateSKUService(ctx context.Context, serviceID int64, service SKUService) (*SKUService, error) {
+	var result SKUService
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/skuservice/%d", serviceID), service, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSKUService removes a service from a SKU
+func (c *VtexClient) DeleteSKUService(ctx context.Context, serviceID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/catalog/pvt/skuservice/%d", serviceID), nil)
+}
+
+// Product is a catalog product
+type Product struct {
+	ID           int64  `json:"Id,omitempty"`
+	Name         string `json:"Name"`
+	DepartmentID int64  `json:"DepartmentId,omitempty"`
+	CategoryID   int64  `json:"CategoryId"`
+	BrandID      int64  `json:"BrandId"`
+	LinkID       string `json:"LinkId"`
+	RefID        string `json:"RefId"`
+	Title        string `json:"Title"`
+	Description  string `json:"Description"`
+	IsVisible    bool   `json:"IsVisible"`
+	IsActive     bool   `json:"IsActive"`
+}
+
+// ProductSKU is a SKU with its product and reference code
+type ProductSKU struct {
+	ID        int64  `json:"Id"`
+	ProductID int64  `json:"ProductId"`
+	Name      string `json:"Name"`
+	RefID     string `json:"RefId"`
+	IsActive  bool   `json:"IsActive"`
+}
+
+// ProductSpecification is a product specification with its field name and values
+type ProductSpecification struct {
+	FieldID int64    `json:"Id"`
+	Name    string   `json:"Name"`
+	Value   []string `json:"Value"`
+}
+
+// Brand is a catalog brand
+type Brand struct {
+	ID       int64  `json:"Id"`
+	Name     string `json:"Name"`
+	IsActive bool   `json:"IsActive"`
+}
+
+// GetProduct gets a product by ID
+func (c *VtexClient) GetProduct(ctx context.Context, productID int64) (*Product, error) {
+	var result Product
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/product/%d", productID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetProductByRefID gets a product by its reference code
+func (c *VtexClient) GetProductByRefID(ctx context.Context, refID string) (*Product, error) {
+	var result *Product
+	endpoint := "/api/catalog_system/pvt/products/productgetbyrefid/" + url.PathEscape(refID)
+	if err := c.Get(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+	// The endpoint answers 200 with an empty body for unknown codes
+	if result == nil || result.ID == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound}
+	}
+	return result, nil
+}
+
+// GetProductBySlug gets a product by the text of its storefront URL
+func (c *VtexClient) GetProductBySlug(ctx context.Context, slug string) (*Product, error) {
+	var results []struct {
+		ProductID string `json:"productId"`
+	}
+	endpoint := fmt.Sprintf("/api/catalog_system/pub/products/search/%s/p", url.PathEscape(slug))
+	if err := c.Get(ctx, endpoint, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound}
+	}
+
+	productID, err := strconv.ParseInt(results[0].ProductID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected product ID %q in search result", results[0].ProductID)
+	}
+	return c.GetProduct(ctx, productID)
+}
+
+// ListProductSKUs gets the SKUs of a product
+func (c *VtexClient) ListProductSKUs(ctx context.Context, productID int64) ([]ProductSKU, error) {
+	var result []ProductSKU
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog_system/pvt/sku/stockkeepingunitByProductId/%d", productID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListProductSpecifications gets the specifications of a product with their field names
+func (c *VtexClient) ListProductSpecifications(ctx context.Context, productID int64) ([]ProductSpecification, error) {
+	var result []ProductSpecification
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog_system/pvt/products/%d/specification", productID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetBrand gets a brand by ID
+func (c *VtexClient) GetBrand(ctx context.Context, brandID int64) (*Brand, error) {
+	var result Brand
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/brand/%d", brandID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSKUByEAN gets a SKU by its EAN/GTIN barcode
+func (c *VtexClient) GetSKUByEAN(ctx context.Context, ean string) (*ProductSKU, error) {
+	var result ProductSKU
+	if err := c.Get(ctx, "/api/catalog/pvt/stockkeepingunit?ean="+url.QueryEscape(ean), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// collectionsPage is one page of the collection search
+type collectionsPage struct {
+	Items  []Collection `json:"items"`
+	Paging struct {
+		Pages int `json:"pages"`
+	} `json:"paging"`
+}
+
+// ListCollections gets the collections whose name matches search (all when empty), following pagination
+func (c *VtexClient) ListCollections(ctx context.Context, search string) ([]Collection, error) {
+	base := "/api/catalog_system/pvt/collection/search"
+	if search != "" {
+		base += "/" + url.PathEscape(search)
+	}
+
+	var collections []Collection
+	for page := 1; ; page++ {
+		var result collectionsPage
+		if err := c.Get(ctx, fmt.Sprintf("%s?page=%d&pageSize=100", base, page), &result); err != nil {
+			return nil, err
+		}
+
+		collections = append(collections, result.Items...)
+		if page >= result.Paging.Pages || len(result.Items) == 0 {
+			return collections, nil
+		}
+	}
+}
+
+// CategorySpecificationField is a specification field available to the products of a category
+type CategorySpecificationField struct {
+	FieldID            int64  `json:"FieldId"`
+	Name               string `json:"Name"`
+	IsActive           bool   `json:"IsActive"`
+	IsStockKeepingUnit bool   `json:"IsStockKeepingUnit"`
+}
+
+// SpecificationFieldValue is a predefined value of a specification field
+type SpecificationFieldValue struct {
+	FieldValueID int64  `json:"FieldValueId"`
+	Value        string `json:"Value"`
+	IsActive     bool   `json:"IsActive"`
+	Position     int64  `json:"Position"`
+}
+
+// ListCategorySpecificationGroups gets the specification groups of a category
+func (c *VtexClient) ListCategorySpecificationGroups(ctx context.Context, categoryID int64) ([]SpecificationGroup, error) {
+	var result []SpecificationGroup
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog_system/pvt/specification/groupbycategory/%d", categoryID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListCategorySpecificationFields gets the specification fields of a category, including inherited ones
+func (c *VtexClient) ListCategorySpecificationFields(ctx context.Context, categoryID int64) ([]CategorySpecificationField, error) {
+	var result []CategorySpecificationField
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog_system/pub/specification/field/listByCategoryId/%d", categoryID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListSpecificationFieldValues gets the predefined values of a specification field
+func (c *VtexClient) ListSpecificationFieldValues(ctx context.Context, fieldID int64) ([]SpecificationFieldValue, error) {
+	var result []SpecificationFieldValue
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog_system/pub/specification/fieldvalue/%d", fieldID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SKU is a catalog SKU with its packaged dimensions, which are required to create it
+type SKU struct {
+	ID               int64   `json:"Id,omitempty"`
+	ProductID        int64   `json:"ProductId"`
+	Name             string  `json:"Name"`
+	RefID            string  `json:"RefId"`
+	IsActive         bool    `json:"IsActive"`
+	PackagedHeight   float64 `json:"PackagedHeight"`
+	PackagedLength   float64 `json:"PackagedLength"`
+	PackagedWidth    float64 `json:"PackagedWidth"`
+	PackagedWeightKg float64 `json:"PackagedWeightKg"`
+}
+
+// CreateProduct creates a product
+func (c *VtexClient) CreateProduct(ctx context.Context, product Product) (*Product, error) {
+	var result Product
+	if err := c.Post(ctx, "/api/catalog/pvt/product", product, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateProduct replaces a product
+func (c *VtexClient) UpdateProduct(ctx context.Context, productID int64, product Product) (*Product, error) {
+	var result Product
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/product/%d", productID), product, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSKUByRefID gets a SKU by its reference code
+func (c *VtexClient) GetSKUByRefID(ctx context.Context, refID string) (*SKU, error) {
+	var result *SKU
+	if err := c.Get(ctx, "/api/catalog/pvt/stockkeepingunit?refId="+url.QueryEscape(refID), &result); err != nil {
+		return nil, err
+	}
+	if result == nil || result.ID == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound}
+	}
+	return result, nil
+}
+
+// CreateSKU creates a SKU
+func (c *VtexClient) CreateSKU(ctx context.Context, sku SKU) (*SKU, error) {
+	var result SKU
+	if err := c.Post(ctx, "/api/catalog/pvt/stockkeepingunit", sku, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSKU replaces a SKU
+func (c *VtexClient) UpdateSKU(ctx context.Context, skuID int64, sku SKU) (*SKU, error) {
+	var result SKU
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/stockkeepingunit/%d", skuID), sku, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListSKUEANs gets the EAN/GTIN barcodes of a SKU
+func (c *VtexClient) ListSKUEANs(ctx context.Context, skuID int64) ([]string, error) {
+	var result []string
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/stockkeepingunit/%d/ean", skuID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AddSKUEAN adds an EAN/GTIN barcode to a SKU
+func (c *VtexClient) AddSKUEAN(ctx context.Context, skuID int64, ean string) error {
+	return c.Post(ctx, fmt.Sprintf("/api/catalog/pvt/stockkeepingunit/%d/ean/%s", skuID, url.PathEscape(ean)), nil, nil)
+}
+
+// productSKUIDsPage is one page of the product and SKU IDs listing
+type productSKUIDsPage struct {
+	Data  map[string][]int64 `json:"data"`
+	Range struct {
+		Total int `json:"total"`
+	} `json:"range"`
+}
+
+// ListProductSKUIDs gets the IDs of products from..to (1-based, at most 250) with their SKU IDs,
+// and the total number of products. categoryID 0 lists the whole catalog.
+func (c *VtexClient) ListProductSKUIDs(ctx context.Context, categoryID int64, from, to int) (map[int64][]int64, int, error) {
+	endpoint := fmt.Sprintf("/api/catalog_system/pvt/products/GetProductAndSkuIds?_from=%d&_to=%d", from, to)
+	if categoryID != 0 {
+		endpoint += fmt.Sprintf("&categoryId=%d", categoryID)
+	}
+
+	var page productSKUIDsPage
+	if err := c.Get(ctx, endpoint, &page); err != nil {
+		return nil, 0, err
+	}
+
+	result := make(map[int64][]int64, len(page.Data))
+	for key, skuIDs := range page.Data {
+		productID, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unexpected product ID %q in listing", key)
+		}
+		result[productID] = skuIDs
+	}
+	return result, page.Range.Total, nil
+}
+
+// SKUFile is an image attached to a SKU
+type SKUFile struct {
+	ID        int64  `json:"Id"`
+	ArchiveID int64  `json:"ArchiveId"`
+	SKUID     int64  `json:"SkuId"`
+	Name      string `json:"Name"`
+	IsMain    bool   `json:"IsMain"`
+	Label     string `json:"Label"`
+}
+
+// SKUImage is the public URL of a SKU image, by archive ID
+type SKUImage struct {
+	ImageURL string `json:"ImageUrl"`
+	FileID   int64  `json:"FileId"`
+}
+
+// ListSKUFiles gets the images attached to a SKU
+func (c *VtexClient) ListSKUFiles(ctx context.Context, skuID int64) ([]SKUFile, error) {
+	var result []SKUFile
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog/pvt/stockkeepingunit/%d/file", skuID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SKUContext is the SKU as seen by the storefront, with its product, brand, categories and images
+type SKUContext struct {
+	ID                 int64      `json:"Id"`
+	ProductID          int64      `json:"ProductId"`
+	BrandID            string     `json:"BrandId"`
+	ProductCategoryIDs string     `json:"ProductCategoryIds"`
+	Images             []SKUImage `json:"Images"`
+}
+
+// CategoryIDs returns the IDs of the category path of the SKU, from the department down
+func (s SKUContext) CategoryIDs() []string {
+	return strings.FieldsFunc(s.ProductCategoryIDs, func(r rune) bool { return r == '/' })
+}
+
+// GetSKUContext gets the storefront context of a SKU
+func (c *VtexClient) GetSKUContext(ctx context.Context, skuID int64) (*SKUContext, error) {
+	var result SKUContext
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog_system/pvt/sku/stockkeepingunitbyid/%d", skuID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SalesChannel is a trade policy: the catalog, prices, currency and culture of a storefront or marketplace
+type SalesChannel struct {
+	ID                    int64                           `json:"Id,omitempty"`
+	Name                  string                          `json:"Name"`
+	IsActive              bool                            `json:"IsActive"`
+	ProductClusterID      *int64                          `json:"ProductClusterId"`
+	CountryCode           string                          `json:"CountryCode"`
+	CultureInfo           string                          `json:"CultureInfo"`
+	TimeZone              string                          `json:"TimeZone"`
+	CurrencyCode          string                          `json:"CurrencyCode"`
+	CurrencySymbol        string                          `json:"CurrencySymbol"`
+	CurrencyLocale        int64                           `json:"CurrencyLocale"`
+	CurrencyDecimalDigits int64                           `json:"CurrencyDecimalDigits"`
+	CurrencyFormatInfo    *SalesChannelCurrencyFormatInfo `json:"CurrencyFormatInfo,omitempty"`
+	Origin                *string                         `json:"Origin"`
+	Position              *int64                          `json:"Position"`
+	ConditionRule         *string                         `json:"ConditionRule"`
+}
+
+// SalesChannelCurrencyFormatInfo is how the storefront shows amounts in the currency
+type SalesChannelCurrencyFormatInfo struct {
+	CurrencyDecimalDigits    int64  `json:"CurrencyDecimalDigits"`
+	CurrencyDecimalSeparator string `json:"CurrencyDecimalSeparator"`
+	CurrencyGroupSeparator   string `json:"CurrencyGroupSeparator"`
+	CurrencyGroupSize        int64  `json:"CurrencyGroupSize"`
+	StartsWithCurrencySymbol bool   `json:"StartsWithCurrencySymbol"`
+}
+
+// CreateSalesChannel creates a sales channel
+func (c *VtexClient) CreateSalesChannel(ctx context.Context, salesChannel SalesChannel) (*SalesChannel, error) {
+	var result SalesChannel
+	if err := c.Post(ctx, "/api/catalog/pvt/saleschannel", salesChannel, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSalesChannel gets a sales channel by ID
+func (c *VtexClient) GetSalesChannel(ctx context.Context, salesChannelID int64) (*SalesChannel, error) {
+	var result SalesChannel
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog_system/pub/saleschannel/%d", salesChannelID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSalesChannel replaces a sales channel
+func (c *VtexClient) UpdateSalesChannel(ctx context.Context, salesChannelID int64, salesChannel SalesChannel) (*SalesChannel, error) {
+	var result SalesChannel
+	if err := c.Put(ctx, fmt.Sprintf("/api/catalog/pvt/saleschannel/%d", salesChannelID), salesChannel, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListSalesChannels lists the sales channels of the account
+func (c *VtexClient) ListSalesChannels(ctx context.Context) ([]SalesChannel, error) {
+	var result []SalesChannel
+	if err := c.Get(ctx, "/api/catalog_system/pvt/saleschannel/list", &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Seller is a seller of the account, the account itself or a marketplace seller
+type Seller struct {
+	SellerID string `json:"SellerId"`
+	Name     string `json:"Name"`
+	IsActive bool   `json:"IsActive"`
+}
+
+// ListSellers lists the sellers that sell in a sales channel
+func (c *VtexClient) ListSellers(ctx context.Context, salesChannelID int64) ([]Seller, error) {
+	var result []Seller
+	if err := c.Get(ctx, fmt.Sprintf("/api/catalog_system/pvt/seller/list?sc=%d", salesChannelID), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SimulationRequest is a cart to simulate in checkout
+type SimulationRequest struct {
+	Items      []SimulationRequestItem `json:"items"`
+	PostalCode string                  `json:"postalCode,omitempty"`
+	Country    string                  `json:"country,omitempty"`
+}
+
+// SimulationRequestItem is an item of a cart to simulate
+type SimulationRequestItem struct {
+	ID       string `json:"id"`
+	Quantity int64  `json:"quantity"`
+	Seller   string `json:"seller"`
+}
+
+// Simulation is the result of a cart simulation. Values are in cents.
+type Simulation struct {
+	Items         []SimulationItem          `json:"items"`
+	Totals        []SimulationTotal         `json:"totals"`
+	LogisticsInfo []SimulationLogisticsInfo `json:"logisticsInfo"`
+}
+
+// SimulationItem is a priced item of a simulation
+type SimulationItem struct {
+	ID           string `json:"id"`
+	Quantity     int64  `json:"quantity"`
+	Seller       string `json:"seller"`
+	Price        int64  `json:"price"`
+	ListPrice    int64  `json:"listPrice"`
+	SellingPrice int64  `json:"sellingPrice"`
+	Availability string `json:"availability"`
+}
+
+// SimulationTotal is a total of a simulation, such as Items or Shipping
+type SimulationTotal struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Value int64  `json:"value"`
+}
+
+// SimulationLogisticsInfo are the delivery options of an item of a simulation
+type SimulationLogisticsInfo struct {
+	ItemIndex int64           `json:"itemIndex"`
+	SLAs      []SimulationSLA `json:"slas"`
+}
+
+// SimulationSLA is a delivery option of a simulation
+type SimulationSLA struct {
+	ID               string                    `json:"id"`
+	Name             string                    `json:"name"`
+	Price            int64                     `json:"price"`
+	ShippingEstimate string                    `json:"shippingEstimate"`
+	DeliveryChannel  string                    `json:"deliveryChannel"`
+	PickupPointID    string                    `json:"pickupPointId,omitempty"`
+	PickupStoreInfo  SimulationPickupStoreInfo `json:"pickupStoreInfo"`
+	DeliveryIDs      []SimulationDeliveryID    `json:"deliveryIds"`
+}
+
+// SimulationPickupStoreInfo is the pickup point of a pickup-in-point delivery option
+type SimulationPickupStoreInfo struct {
+	IsPickupStore bool   `json:"isPickupStore"`
+	FriendlyName  string `json:"friendlyName"`
+}
+
+// SimulationDeliveryID is the warehouse and dock a delivery option ships from
+type SimulationDeliveryID struct {
+	CourierID   string `json:"courierId"`
+	CourierName string `json:"courierName"`
+	WarehouseID string `json:"warehouseId"`
+	DockID      string `json:"dockId"`
+	Quantity    int64  `json:"quantity"`
+}
+
+// SimulateCart prices a cart in a trade policy, with delivery options for the postal code.
+// An empty trade policy uses the default one.
+func (c *VtexClient) SimulateCart(ctx context.Context, tradePolicyID string, cart SimulationRequest) (*Simulation, error) {
+	endpoint := "/api/checkout/pub/orderForms/simulation"
+	if tradePolicyID != "" {
+		endpoint += "?sc=" + url.QueryEscape(tradePolicyID)
+	}
+
+	var result Simulation
+	if err := c.Post(ctx, endpoint, cart, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SimulateFulfillment simulates the fulfillment of a cart by the account's own logistics,
+// returning the delivery options Logistics computes for the postal code.
+// An empty trade policy uses the default one.
+func (c *VtexClient) SimulateFulfillment(ctx context.Context, tradePolicyID string, cart SimulationRequest) (*Simulation, error) {
+	endpoint := "/api/fulfillment/pvt/orderForms/simulation"
+	if tradePolicyID != "" {
+		endpoint += "?sc=" + url.QueryEscape(tradePolicyID)
+	}
+
+	var result Simulation
+	if err := c.Post(ctx, endpoint, cart, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// OrderFormConfiguration is the orderForm configuration of the account's checkout. Every field
+// is listed, so saving the configuration does not reset the ones the provider does not manage.
+// Values are in cents.
+type OrderFormConfiguration struct {
+	PaymentConfiguration               OrderFormPaymentConfiguration `json:"paymentConfiguration"`
+	TaxConfiguration                   *OrderFormTaxConfiguration    `json:"taxConfiguration"`
+	MinimumQuantityAccumulatedForItems int64                         `json:"minimumQuantityAccumulatedForItems"`
+	DecimalDigitsPrecision             int64                         `json:"decimalDigitsPrecision"`
+	MinimumValueAccumulated            *int64                        `json:"minimumValueAccumulated"`
+	Apps                               []OrderFormApp                `json:"apps"`
+	AllowMultipleDeliveries            *bool                         `json:"allowMultipleDeliveries"`
+	AllowManualPrice                   *bool                         `json:"allowManualPrice"`
+	SavePersonalDataAsOptions          *bool                         `json:"savePersonalDataAsOptions"`
+	MaxNumberOfWhiteLabelSellers       *int64                        `json:"maxNumberOfWhiteLabelSellers"`
+	MaskFirstPurchaseData              *bool                         `json:"maskFirstPurchaseData"`
+	RecaptchaValidation                string                        `json:"recaptchaValidation,omitempty"`
+	MaskStateOnAddress                 *bool                         `json:"maskStateOnAddress"`
+	RequiresLoginToPlaceOrder          bool                          `json:"requiresLoginToPlaceOrder"`
+	MinimumPurchaseDowngradeThreshold  int64                         `json:"minimumPurchaseDowngradeThreshold"`
+	CartAgeToUseNewCardSeconds         int64                         `json:"cartAgeToUseNewCardSeconds"`
+}
+
+// OrderFormPaymentConfiguration are the payment settings of the checkout
+type OrderFormPaymentConfiguration struct {
+	RequiresAuthenticationForPreAuthorizedPaymentOption bool    `json:"requiresAuthenticationForPreAuthorizedPaymentOption"`
+	AllowInstallmentsMerge                              *bool   `json:"allowInstallmentsMerge"`
+	BlockPaymentSession                                 *bool   `json:"blockPaymentSession"`
+	PaymentSystemToCheckFirstInstallment                *string `json:"paymentSystemToCheckFirstInstallment"`
+	DefaultPaymentSystemToApplyOnUserOrderForm          *string `json:"defaultPaymentSystemToApplyOnUserOrderForm"`
+}
+
+// OrderFormTaxConfiguration is the external tax service checkout calls to compute taxes
+type OrderFormTaxConfiguration struct {
+	URL                       string  `json:"url"`
+	AuthorizationHeader       string  `json:"authorizationHeader"`
+	AllowExecutionAfterErrors bool    `json:"allowExecutionAfterErrors"`
+	IntegratedAuthentication  bool    `json:"integratedAuthentication"`
+	AppID                     *string `json:"appId"`
+}
+
+// OrderFormApp is an app of the orderForm custom data: the fields it stores in the orderForm
+type OrderFormApp struct {
+	ID     string   `json:"id"`
+	Fields []string `json:"fields"`
+	Major  int64    `json:"major"`
+}
+
+// GetOrderFormConfiguration gets the orderForm configuration of the account
+func (c *VtexClient) GetOrderFormConfiguration(ctx context.Context) (*OrderFormConfiguration, error) {
+	var result OrderFormConfiguration
+	if err := c.Get(ctx, "/api/checkout/pvt/configuration/orderForm", &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateOrderFormConfiguration replaces the orderForm configuration of the account and reads it back
+func (c *VtexClient) UpdateOrderFormConfiguration(ctx context.Context, configuration OrderFormConfiguration) (*OrderFormConfiguration, error) {
+	if configuration.Apps == nil {
+		configuration.Apps = []OrderFormApp{}
+	}
+	if err := c.Post(ctx, "/api/checkout/pvt/configuration/orderForm", configuration, nil); err != nil {
+		return nil, err
+	}
+	return c.GetOrderFormConfiguration(ctx)
+}
+
+// EditOrderFormConfiguration reads the orderForm configuration, applies edit to it and saves it,
+// one edit at a time, so changes to different parts of the configuration do not overwrite each other.
+// The configuration is not saved when edit returns errOrderFormUnchanged.
+func (c *VtexClient) EditOrderFormConfiguration(ctx context.Context, edit func(*OrderFormConfiguration) error) (*OrderFormConfiguration, error) {
+	c.orderFormMutex.Lock()
+	defer c.orderFormMutex.Unlock()
+
+	configuration, err := c.GetOrderFormConfiguration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := edit(configuration); err == errOrderFormUnchanged {
+		return configuration, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return c.UpdateOrderFormConfiguration(ctx, *configuration)
+}
+
+// errOrderFormUnchanged tells EditOrderFormConfiguration there is nothing to save
+var errOrderFormUnchanged = errors.New("orderForm configuration unchanged")
+
+// GetOrderFormApp gets an app of the orderForm custom data by ID
+func (c *VtexClient) GetOrderFormApp(ctx context.Context, appID string) (*OrderFormApp, error) {
+	configuration, err := c.GetOrderFormConfiguration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, app := range configuration.Apps {
+		if app.ID == appID {
+			return &app, nil
+		}
+	}
+	return nil, &APIError{StatusCode: http.StatusNotFound}
+}
+
+// SetOrderFormApp adds or replaces an app of the orderForm custom data, keeping the other apps.
+// With create it fails when the app is already configured, so it does not take over an app
+// someone else manages.
+func (c *VtexClient) SetOrderFormApp(ctx context.Context, app OrderFormApp, create bool) (*OrderFormApp, error) {
+	updated, err := c.EditOrderFormConfiguration(ctx, func(configuration *OrderFormConfiguration) error {
+		for i := range configuration.Apps {
+			if configuration.Apps[i].ID == app.ID {
+				if create {
+					return fmt.Errorf("app %q is already in the orderForm configuration", app.ID)
+				}
+				configuration.Apps[i] = app
+				return nil
+			}
+		}
+		configuration.Apps = append(configuration.Apps, app)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, current := range updated.Apps {
+		if current.ID == app.ID {
+			return &current, nil
+		}
+	}
+	return nil, fmt.Errorf("app %q was not saved in the orderForm configuration", app.ID)
+}
+
+// DeleteOrderFormApp removes an app of the orderForm custom data, keeping the other apps
+func (c *VtexClient) DeleteOrderFormApp(ctx context.Context, appID string) error {
+	_, err := c.EditOrderFormConfiguration(ctx, func(configuration *OrderFormConfiguration) error {
+		apps := make([]OrderFormApp, 0, len(configuration.Apps))
+		for _, app := range configuration.Apps {
+			if app.ID != appID {
+				apps = append(apps, app)
+			}
+		}
+		if len(apps) == len(configuration.Apps) {
+			return errOrderFormUnchanged
+		}
+		configuration.Apps = apps
+		return nil
+	})
+	return err
+}
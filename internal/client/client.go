@@ -2,13 +2,21 @@ package client
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
+	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Retry settings
@@ -18,6 +26,9 @@ const (
 	maxWait      = 5 * time.Second
 	minWait      = 50 * time.Millisecond
 	adjustFactor = 1.5
+
+	// Token requests fail fast on auth errors, so a few retries are enough
+	tokenMaxRetries = 5
 )
 
 // VtexClient handles communication with the VTEX API
@@ -28,10 +39,60 @@ type VtexClient struct {
 	oktaSecret    string
 	oktaGrantType string
 	oktaScope     string
+	appKey        string
+	appToken      string
 	httpClient    *http.Client
 	token         string
 	tokenExpiry   time.Time
 	tokenMutex    sync.RWMutex
+	// orderFormMutex serializes the edits of the orderForm configuration
+	orderFormMutex sync.Mutex
+}
+
+// TransportConfig holds the HTTP connection pool settings
+type TransportConfig struct {
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	DialTimeout         time.Duration
+	DisableHTTP2        bool
+}
+
+// DefaultTransportConfig returns the settings used when the provider block does not set them.
+// All requests go to the same VTEX host, so idle connections per host match the total.
+func DefaultTransportConfig() TransportConfig {
+	return TransportConfig{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 100,
+		IdleConnTimeout:     90 * time.Second,
+		DialTimeout:         30 * time.Second,
+	}
+}
+
+// newTransport creates an HTTP transport with keep-alive and pool settings
+func newTransport(cfg TransportConfig) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   cfg.DialTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     !cfg.DisableHTTP2,
+		MaxIdleConns:          cfg.MaxIdleConns,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		IdleConnTimeout:       cfg.IdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	// A non-nil empty map is the documented way to turn off HTTP/2
+	if cfg.DisableHTTP2 {
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+
+	return transport
 }
 
 // UserRole represents a user with a role in VTEX
@@ -47,6 +108,27 @@ type UserRoleRequest struct {
 	Users []UserRole `json:"users"`
 }
 
+// UserRoleResponse is the Apps Service answer to create requests
+type UserRoleResponse struct {
+	Users []UserRoleResult `json:"users"`
+}
+
+// UserRoleResult is the License Manager user behind one created assignment
+type UserRoleResult struct {
+	Email  string `json:"email"`
+	UserID string `json:"userId"`
+}
+
+// UserID returns the user ID for an email, or "" if the response does not have it
+func (r *UserRoleResponse) UserID(email string) string {
+	for _, u := range r.Users {
+		if strings.EqualFold(u.Email, email) {
+			return u.UserID
+		}
+	}
+	return ""
+}
+
 // OktaTokenResponse is the token response from Okta
 type OktaTokenResponse struct {
 	AccessToken string `json:"access_token"`
@@ -55,7 +137,12 @@ type OktaTokenResponse struct {
 }
 
 // NewVtexClient creates a new VTEX client
-func NewVtexClient(vtexBaseURL, oktaURL, oktaClientID, oktaSecret, oktaGrantType, oktaScope string) (*VtexClient, error) {
+// Okta settings are only needed for the Apps Service endpoints, native VTEX APIs can use app key/token only.
+func NewVtexClient(vtexBaseURL, oktaURL, oktaClientID, oktaSecret, oktaGrantType, oktaScope, appKey, appToken string, transport TransportConfig) (*VtexClient, error) {
+	if oktaURL == "" && appKey == "" {
+		return nil, fmt.Errorf("either Okta settings or a VTEX app key and token are required")
+	}
+
 	return &VtexClient{
 		vtexBaseURL:   vtexBaseURL,
 		oktaURL:       oktaURL,
@@ -63,14 +150,17 @@ func NewVtexClient(vtexBaseURL, oktaURL, oktaClientID, oktaSecret, oktaGrantType
 		oktaSecret:    oktaSecret,
 		oktaGrantType: oktaGrantType,
 		oktaScope:     oktaScope,
+		appKey:        appKey,
+		appToken:      appToken,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: newTransport(transport),
 		},
 	}, nil
 }
 
 // getToken gets a valid token, renews it if needed
-func (c *VtexClient) getToken() (string, error) {
+func (c *VtexClient) getToken(ctx context.Context) (string, error) {
 	c.tokenMutex.RLock()
 	if c.token != "" && time.Now().Before(c.tokenExpiry) {
 		token := c.token
@@ -88,14 +178,44 @@ func (c *VtexClient) getToken() (string, error) {
 		return c.token, nil
 	}
 
-	// Get new token
+	// Get new token, retrying transient Okta errors
+	currentWait := baseWait
+	var lastErr error
+
+	for attempt := 0; attempt < tokenMaxRetries; attempt++ {
+		tokenResp, retryable, err := c.requestToken(ctx)
+		if err == nil {
+			c.token = tokenResp.AccessToken
+			// Set expiry with 5 minutes margin
+			c.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn-300) * time.Second)
+			return c.token, nil
+		}
+
+		// Auth errors (bad credentials, scope, grant type) will not fix themselves
+		if !retryable {
+			return "", err
+		}
+
+		lastErr = err
+		if err := sleep(ctx, currentWait); err != nil {
+			return "", fmt.Errorf("gave up getting token: %w (last error: %v)", err, lastErr)
+		}
+		currentWait = min(time.Duration(float64(currentWait)*adjustFactor), maxWait)
+	}
+
+	return "", fmt.Errorf("max token retries (%d) exceeded: %w", tokenMaxRetries, lastErr)
+}
+
+// requestToken asks Okta for a new token once.
+// The bool result tells if the error is transient and the request can be retried.
+func (c *VtexClient) requestToken(ctx context.Context) (*OktaTokenResponse, bool, error) {
 	data := url.Values{}
 	data.Set("grant_type", c.oktaGrantType)
 	data.Set("scope", c.oktaScope)
 
-	req, err := http.NewRequest("POST", c.oktaURL, bytes.NewBufferString(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.oktaURL, bytes.NewBufferString(data.Encode()))
 	if err != nil {
-		return "", fmt.Errorf("error creating token request: %w", err)
+		return nil, false, fmt.Errorf("error creating token request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -103,65 +223,157 @@ func (c *VtexClient) getToken() (string, error) {
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("error requesting token: %w", err)
+		// Network error, retry
+		return nil, true, fmt.Errorf("error requesting token: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("error obtaining token: status %d, body: %s", resp.StatusCode, string(body))
+		tflog.Trace(ctx, "Okta token response", map[string]interface{}{
+			"status": resp.StatusCode,
+			"body":   string(body),
+		})
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return nil, retryable, fmt.Errorf("error obtaining token: status %d, body: %s", resp.StatusCode, redact(string(body)))
 	}
 
 	var tokenResp OktaTokenResponse
 	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		return "", fmt.Errorf("error decoding token response: %w", err)
+		return nil, false, fmt.Errorf("error decoding token response: %w", err)
 	}
 
-	c.token = tokenResp.AccessToken
-	// Set expiry with 5 minutes margin
-	c.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn-300) * time.Second)
+	return &tokenResp, false, nil
+}
 
-	return c.token, nil
+// usesOkta tells if requests are authenticated with an Okta bearer token
+func (c *VtexClient) usesOkta() bool {
+	return c.oktaURL != ""
 }
 
 // refreshToken forces token renewal
-func (c *VtexClient) refreshToken() (string, error) {
+func (c *VtexClient) refreshToken(ctx context.Context) (string, error) {
 	c.tokenMutex.Lock()
 	c.token = ""
 	c.tokenExpiry = time.Time{}
 	c.tokenMutex.Unlock()
-	return c.getToken()
+	return c.getToken(ctx)
+}
+
+// APIError is returned when the VTEX API answers with a non-retryable status.
+// Body holds the raw response; Error() redacts it so it is safe for diagnostics.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("request failed: status %d, body: %s", e.StatusCode, redact(e.Body))
+}
+
+// IsNotFound checks if an error is a 404 from the VTEX API
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
 }
 
-// doRequestWithRetry runs a request with retries and exponential backoff
-func (c *VtexClient) doRequestWithRetry(method, endpoint string, payload interface{}) error {
+// retryMode says which failures a request is sent again for
+type retryMode int
+
+const (
+	// retryTransient retries network errors, rate limits and server errors
+	retryTransient retryMode = iota
+	// retryTransientAndNotFound also retries 404, which Apps Service endpoints answer while warming up
+	retryTransientAndNotFound
+	// retryRateLimited only retries rate limits, for requests that must not run twice: after a network
+	// or server error the request may have run anyway
+	retryRateLimited
+)
+
+// doRequestWithRetry runs a request with retries and exponential backoff and returns the response body.
+// Apps Service endpoints answer 404 while the app is warming up, so retryNotFound keeps retrying them;
+// native API calls use it as a real "not found".
+func (c *VtexClient) doRequestWithRetry(ctx context.Context, method, endpoint string, payload interface{}, retryNotFound bool) ([]byte, error) {
+	retry := retryTransient
+	if retryNotFound {
+		retry = retryTransientAndNotFound
+	}
+	body, _, err := c.doRequestWithHeaders(ctx, method, endpoint, payload, nil, retry)
+	return body, err
+}
+
+// doRequestWithHeaders is doRequestWithRetry with extra request headers, returning the response headers too
+func (c *VtexClient) doRequestWithHeaders(ctx context.Context, method, endpoint string, payload interface{}, headers http.Header, retry retryMode) ([]byte, http.Header, error) {
 	currentWait := baseWait
 	currentMaxWait := maxWait
 
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		token, err := c.getToken()
+	var jsonData []byte
+	contentType := "application/json"
+	if file, ok := payload.(filePayload); ok {
+		var err error
+		jsonData, contentType, err = file.encode()
 		if err != nil {
-			return fmt.Errorf("error getting token: %w", err)
+			return nil, nil, fmt.Errorf("error encoding file upload: %w", err)
 		}
-
-		jsonData, err := json.Marshal(payload)
+	} else if payload != nil {
+		var err error
+		jsonData, err = json.Marshal(payload)
 		if err != nil {
-			return fmt.Errorf("error marshaling request: %w", err)
+			return nil, nil, fmt.Errorf("error marshaling request: %w", err)
+		}
+	}
+
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		var reqBody io.Reader
+		if jsonData != nil {
+			reqBody = bytes.NewBuffer(jsonData)
 		}
 
-		reqURL := fmt.Sprintf("%s%s", c.vtexBaseURL, endpoint)
-		req, err := http.NewRequest(method, reqURL, bytes.NewBuffer(jsonData))
+		// Endpoints on other hosts, like the VTEX IO infrastructure, are absolute URLs
+		reqURL := endpoint
+		if !strings.HasPrefix(endpoint, "https://") {
+			reqURL = fmt.Sprintf("%s%s", c.vtexBaseURL, endpoint)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
 		if err != nil {
-			return fmt.Errorf("error creating request: %w", err)
+			return nil, nil, fmt.Errorf("error creating request: %w", err)
+		}
+
+		if c.usesOkta() {
+			token, err := c.getToken(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error getting token: %w", err)
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		if c.appKey != "" {
+			req.Header.Set("X-VTEX-API-AppKey", c.appKey)
+			req.Header.Set("X-VTEX-API-AppToken", c.appToken)
 		}
 
-		req.Header.Set("Authorization", "Bearer "+token)
-		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+		for name, values := range headers {
+			req.Header[http.CanonicalHeaderKey(name)] = values
+		}
+		if jsonData != nil {
+			req.Header.Set("Content-Type", contentType)
+		}
 
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			// Network error, retry with backoff
-			time.Sleep(currentWait)
+			// Timeout or cancellation, do not retry
+			if ctx.Err() != nil {
+				return nil, nil, fmt.Errorf("request %s %s stopped: %w", method, endpoint, ctx.Err())
+			}
+
+			// Network error, retry with backoff unless the request may have run
+			if retry == retryRateLimited {
+				return nil, nil, fmt.Errorf("request %s %s failed: %w", method, endpoint, err)
+			}
+			if err := sleep(ctx, currentWait); err != nil {
+				return nil, nil, err
+			}
 			currentWait = min(time.Duration(float64(currentWait)*adjustFactor), currentMaxWait)
 			continue
 		}
@@ -169,23 +381,33 @@ func (c *VtexClient) doRequestWithRetry(method, endpoint string, payload interfa
 		body, _ := io.ReadAll(resp.Body)
 		resp.Body.Close()
 
+		// Full body only at TRACE, it may contain emails or tokens
+		tflog.Trace(ctx, "VTEX API response", map[string]interface{}{
+			"method":   method,
+			"endpoint": endpoint,
+			"status":   resp.StatusCode,
+			"body":     string(body),
+		})
+
 		// Success
 		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-			return nil
+			return body, resp.Header, nil
 		}
 
-		// Invalid or expired token - renew and retry
-		if resp.StatusCode == 401 || resp.StatusCode == 403 {
-			_, err := c.refreshToken()
+		// Invalid or expired token - renew and retry (app keys do not expire)
+		if (resp.StatusCode == 401 || resp.StatusCode == 403) && c.usesOkta() {
+			_, err := c.refreshToken(ctx)
 			if err != nil {
-				return fmt.Errorf("error refreshing token: %w", err)
+				return nil, nil, fmt.Errorf("error refreshing token: %w", err)
 			}
 			continue
 		}
 
 		// Rate limit or temporary error (404, 504) - wait and retry
-		if resp.StatusCode == 404 || resp.StatusCode == 504 || resp.StatusCode == 429 {
-			time.Sleep(currentWait)
+		if (resp.StatusCode == 404 && retry == retryTransientAndNotFound) || (resp.StatusCode == 504 && retry != retryRateLimited) || resp.StatusCode == 429 {
+			if err := sleep(ctx, currentWait); err != nil {
+				return nil, nil, err
+			}
 			currentWait = min(time.Duration(float64(currentWait)*adjustFactor), currentMaxWait)
 			// Increase max wait slowly
 			currentMaxWait = min(time.Duration(float64(currentMaxWait)*1.1), 15*time.Second)
@@ -193,39 +415,176 @@ func (c *VtexClient) doRequestWithRetry(method, endpoint string, payload interfa
 		}
 
 		// Server error (5xx) - retry
-		if resp.StatusCode >= 500 {
-			time.Sleep(currentWait)
+		if resp.StatusCode >= 500 && retry != retryRateLimited {
+			if err := sleep(ctx, currentWait); err != nil {
+				return nil, nil, err
+			}
 			currentWait = min(time.Duration(float64(currentWait)*adjustFactor), currentMaxWait)
 			continue
 		}
 
-		// Other error (4xx) - do not retry
-		return fmt.Errorf("request failed: status %d, body: %s", resp.StatusCode, string(body))
+		// Other error (4xx, or 5xx of requests that must not run twice) - do not retry
+		return nil, nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
-	return fmt.Errorf("max retries (%d) exceeded", maxRetries)
+	return nil, nil, fmt.Errorf("max retries (%d) exceeded", maxRetries)
 }
 
-// CreateUserRole creates a user with a role in VTEX
-func (c *VtexClient) CreateUserRole(user UserRole) error {
-	payload := UserRoleRequest{
-		Users: []UserRole{user},
+// sleep waits for the backoff duration, or returns early when the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("stopped retrying: %w", ctx.Err())
+	case <-timer.C:
+		return nil
 	}
-	return c.doRequestWithRetry("POST", "/_v/create-user-role", payload)
+}
+
+// doJSON runs a request with retries and decodes the JSON response into out (if not nil)
+func (c *VtexClient) doJSON(ctx context.Context, method, endpoint string, payload, out interface{}) error {
+	body, err := c.doRequestWithRetry(ctx, method, endpoint, payload, false)
+	if err != nil {
+		return err
+	}
+
+	if out == nil || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return nil
+}
+
+// Get sends a GET request and decodes the response into out
+func (c *VtexClient) Get(ctx context.Context, endpoint string, out interface{}) error {
+	return c.doJSON(ctx, http.MethodGet, endpoint, nil, out)
+}
+
+// GetWithHeaders sends a GET request with extra headers, decodes the response into out and
+// returns the response headers
+func (c *VtexClient) GetWithHeaders(ctx context.Context, endpoint string, headers http.Header, out interface{}) (http.Header, error) {
+	body, responseHeaders, err := c.doRequestWithHeaders(ctx, http.MethodGet, endpoint, nil, headers, retryTransient)
+	if err != nil {
+		return nil, err
+	}
+
+	if out != nil && len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, out); err != nil {
+			return nil, fmt.Errorf("error decoding response: %w", err)
+		}
+	}
+
+	return responseHeaders, nil
+}
+
+// Post sends a POST request with a JSON payload and decodes the response into out
+func (c *VtexClient) Post(ctx context.Context, endpoint string, payload, out interface{}) error {
+	return c.doJSON(ctx, http.MethodPost, endpoint, payload, out)
+}
+
+// PostOnce sends a POST request that must not run twice, like one that generates codes, and decodes
+// the response into out. Only rate-limited attempts are sent again.
+func (c *VtexClient) PostOnce(ctx context.Context, endpoint string, payload, out interface{}) error {
+	body, _, err := c.doRequestWithHeaders(ctx, http.MethodPost, endpoint, payload, nil, retryRateLimited)
+	if err != nil {
+		return err
+	}
+
+	if out != nil && len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("error decoding response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// filePayload is a file sent as a multipart form upload instead of a JSON payload
+type filePayload struct {
+	field    string
+	fileName string
+	content  []byte
+}
+
+// encode returns the multipart body of the upload and its content type
+func (f filePayload) encode() ([]byte, string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile(f.field, f.fileName)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := part.Write(f.content); err != nil {
+		return nil, "", err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return body.Bytes(), writer.FormDataContentType(), nil
+}
+
+// PostFile uploads a file as the field of a multipart form and decodes the response into out
+func (c *VtexClient) PostFile(ctx context.Context, endpoint, field, fileName string, content []byte, out interface{}) error {
+	return c.doJSON(ctx, http.MethodPost, endpoint, filePayload{field: field, fileName: fileName, content: content}, out)
+}
+
+// Put sends a PUT request with a JSON payload and decodes the response into out
+func (c *VtexClient) Put(ctx context.Context, endpoint string, payload, out interface{}) error {
+	return c.doJSON(ctx, http.MethodPut, endpoint, payload, out)
+}
+
+// Patch sends a PATCH request with a JSON payload and decodes the response into out
+func (c *VtexClient) Patch(ctx context.Context, endpoint string, payload, out interface{}) error {
+	return c.doJSON(ctx, http.MethodPatch, endpoint, payload, out)
+}
+
+// Delete sends a DELETE request and decodes the response into out
+func (c *VtexClient) Delete(ctx context.Context, endpoint string, out interface{}) error {
+	return c.doJSON(ctx, http.MethodDelete, endpoint, nil, out)
+}
+
+// CreateUserRole creates a user with a role in VTEX
+func (c *VtexClient) CreateUserRole(ctx context.Context, user UserRole) (*UserRoleResponse, error) {
+	return c.CreateUserRoles(ctx, []UserRole{user})
 }
 
 // DeleteUserRole deletes a user with a role in VTEX
-func (c *VtexClient) DeleteUserRole(user UserRole) error {
+func (c *VtexClient) DeleteUserRole(ctx context.Context, user UserRole) error {
+	return c.DeleteUserRoles(ctx, []UserRole{user})
+}
+
+// CreateUserRoles creates several users with roles in VTEX in a single request
+func (c *VtexClient) CreateUserRoles(ctx context.Context, users []UserRole) (*UserRoleResponse, error) {
 	payload := UserRoleRequest{
-		Users: []UserRole{user},
+		Users: users,
+	}
+	body, err := c.doRequestWithRetry(ctx, "POST", "/_v/create-user-role", payload, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Older app versions answer without user IDs (or not JSON at all), that is not an error
+	var result UserRoleResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		tflog.Debug(ctx, "Create user role response has no user IDs", map[string]interface{}{
+			"error": err.Error(),
+		})
 	}
-	return c.doRequestWithRetry("POST", "/_v/remove-user-role", payload)
+
+	return &result, nil
 }
 
-// ReadUserRole checks if a user exists
-// Note: VTEX does not have an endpoint to query users
-// This makes the resource "write-only"
-func (c *VtexClient) ReadUserRole(email, account, roleName string) (*UserRole, error) {
-	// TODO: Implement if VTEX has an endpoint to query users
-	return nil, nil
+// DeleteUserRoles deletes several users with roles in VTEX in a single request
+func (c *VtexClient) DeleteUserRoles(ctx context.Context, users []UserRole) error {
+	payload := UserRoleRequest{
+		Users: users,
+	}
+	_, err := c.doRequestWithRetry(ctx, "POST", "/_v/remove-user-role", payload, true)
+	return err
 }
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// GiftCardProvider is a gift card provider registered in the Gift Card Hub
+type GiftCardProvider struct {
+	ID             string `json:"id,omitempty"`
+	ServiceURL     string `json:"serviceUrl"`
+	OAuthProvider  string `json:"oauthProvider"`
+	PreAuthEnabled bool   `json:"preAuthEnabled"`
+	CancelEnabled  bool   `json:"cancelEnabled"`
+	AppKey         string `json:"appKey,omitempty"`
+	AppToken       string `json:"appToken,omitempty"`
+}
+
+// giftCardProviderEndpoint is the endpoint of a gift card provider of the Gift Card Hub
+func giftCardProviderEndpoint(providerID string) string {
+	return "/api/gift-card-hub/pvt/giftCardProviders/" + url.PathEscape(providerID)
+}
+
+// GetGiftCardProvider gets a gift card provider by ID
+func (c *VtexClient) GetGiftCardProvider(ctx context.Context, providerID string) (*GiftCardProvider, error) {
+	var result GiftCardProvider
+	if err := c.Get(ctx, giftCardProviderEndpoint(providerID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SaveGiftCardProvider creates or replaces a gift card provider and reads it back
+func (c *VtexClient) SaveGiftCardProvider(ctx context.Context, providerID string, provider GiftCardProvider) (*GiftCardProvider, error) {
+	provider.ID = ""
+	if err := c.Put(ctx, giftCardProviderEndpoint(providerID), provider, nil); err != nil {
+		return nil, err
+	}
+	return c.GetGiftCardProvider(ctx, providerID)
+}
+
+// DeleteGiftCardProvider removes a gift card provider
+func (c *VtexClient) DeleteGiftCardProvider(ctx context.Context, providerID string) error {
+	return c.Delete(ctx, giftCardProviderEndpoint(providerID), nil)
+}
+
+// GiftCard is a gift card of the account's own Gift Card API
+type GiftCard struct {
+	ID             string  `json:"id"`
+	RedemptionCode string  `json:"redemptionCode"`
+	RelationName   string  `json:"relationName"`
+	Caption        string  `json:"caption"`
+	Balance        float64 `json:"balance"`
+	EmissionDate   string  `json:"emissionDate"`
+	ExpiringDate   string  `json:"expiringDate"`
+}
+
+// GiftCardSearch selects the gift cards SearchGiftCards returns
+type GiftCardSearch struct {
+	ClientID     string
+	RelationName string
+}
+
+// SearchGiftCards lists the gift cards of a shopper (client ID or email), or of a relation
+// name like a loyalty program, or of both
+func (c *VtexClient) SearchGiftCards(ctx context.Context, search GiftCardSearch) ([]GiftCard, error) {
+	payload := map[string]interface{}{}
+	if search.ClientID != "" {
+		payload["client"] = map[string]string{"id": search.ClientID}
+	}
+	if search.RelationName != "" {
+		payload["relationName"] = search.RelationName
+	}
+
+	var result []GiftCard
+	if err := c.Post(ctx, "/api/giftcards/_search", payload, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
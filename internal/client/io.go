@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ioInfraURL is the host of the VTEX IO infrastructure APIs (apps and workspaces)
+const ioInfraURL = "https://infra.io.vtex.com"
+
+// ioMasterWorkspace is the production workspace every account has
+const ioMasterWorkspace = "master"
+
+// ioAppURL is the address of a VTEX IO app (vendor.name) of major version major in a workspace
+func ioAppURL(app string, major int, account, workspace string) string {
+	return fmt.Sprintf("https://app.io.vtex.com/%s/v%d/%s/%s", app, major, url.PathEscape(account), url.PathEscape(workspace))
+}
+
+// ioGraphQL runs a GraphQL query or mutation against the GraphQL API of a VTEX IO app and decodes
+// its data into out. GraphQL errors come with status 200, so they are returned as errors.
+func (c *VtexClient) ioGraphQL(ctx context.Context, appURL, query string, variables map[string]interface{}, out interface{}) error {
+	payload := map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	}
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := c.Post(ctx, appURL+"/_v/graphql", payload, &result); err != nil {
+		return err
+	}
+	if len(result.Errors) > 0 {
+		messages := make([]string, 0, len(result.Errors))
+		for _, graphQLError := range result.Errors {
+			messages = append(messages, graphQLError.Message)
+		}
+		return fmt.Errorf("graphql error: %s", strings.Join(messages, "; "))
+	}
+	if out == nil || len(result.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(result.Data, out)
+}
+
+// IOApp is an app installed in a VTEX IO workspace
+type IOApp struct {
+	// ID is the app and its installed version, vendor.name@version
+	ID string `json:"id"`
+}
+
+// SplitIOAppID splits an app ID like vendor.name@1.2.3 into the app name and version
+func SplitIOAppID(id string) (string, string) {
+	name, version, _ := strings.Cut(id, "@")
+	return name, version
+}
+
+// ioAppsEndpoint is the Apps API path of the apps installed in a workspace
+func ioAppsEndpoint(account, workspace string) string {
+	return fmt.Sprintf("%s/apps/v0/%s/%s/apps", ioInfraURL, url.PathEscape(account), url.PathEscape(workspace))
+}
+
+// GetIOApp gets an app installed in a workspace by name (vendor.name)
+func (c *VtexClient) GetIOApp(ctx context.Context, account, workspace, app string) (*IOApp, error) {
+	var result IOApp
+	if err := c.Get(ctx, ioAppsEndpoint(account, workspace)+"/"+url.PathEscape(app), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// InstallIOApp installs an app version (vendor.name@version, where version can be a range like 2.x)
+// in a workspace, upgrading or downgrading it when another version is installed, and reads it back
+func (c *VtexClient) InstallIOApp(ctx context.Context, account, workspace, appID string) (*IOApp, error) {
+	payload := map[string]string{"id": appID}
+	if err := c.Post(ctx, ioAppsEndpoint(account, workspace), payload, nil); err != nil {
+		return nil, err
+	}
+	name, _ := SplitIOAppID(appID)
+	return c.GetIOApp(ctx, account, workspace, name)
+}
+
+// UninstallIOApp uninstalls an app (vendor.name) from a workspace
+func (c *VtexClient) UninstallIOApp(ctx context.Context, account, workspace, app string) error {
+	return c.Delete(ctx, ioAppsEndpoint(account, workspace)+"/"+url.PathEscape(app), nil)
+}
+
+// ListIOApps lists the apps installed in a workspace
+func (c *VtexClient) ListIOApps(ctx context.Context, account, workspace string) ([]IOApp, error) {
+	var result struct {
+		Data []IOApp `json:"data"`
+	}
+	if err := c.Get(ctx, ioAppsEndpoint(account, workspace), &result); err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+// GetIOAppDependencies gets the resolved dependencies of the apps of a workspace: the IDs of the
+// apps each app depends on, by app ID
+func (c *VtexClient) GetIOAppDependencies(ctx context.Context, account, workspace string) (map[string][]string, error) {
+	var result map[string][]string
+	endpoint := fmt.Sprintf("%s/apps/v0/%s/%s/dependencies", ioInfraURL, url.PathEscape(account), url.PathEscape(workspace))
+	if err := c.Get(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IOAppSettings are the settings of an installed app, by setting name
+type IOAppSettings map[string]json.RawMessage
+
+// GetIOAppSettings gets the settings of an app (vendor.name) installed in a workspace
+func (c *VtexClient) GetIOAppSettings(ctx context.Context, account, workspace, app string) (IOAppSettings, error) {
+	var result IOAppSettings
+	if err := c.Get(ctx, ioAppsEndpoint(account, workspace)+"/"+url.PathEscape(app)+"/settings", &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result = IOAppSettings{}
+	}
+	return result, nil
+}
+
+// SaveIOAppSettings replaces the settings of an app installed in a workspace and reads them back
+func (c *VtexClient) SaveIOAppSettings(ctx context.Context, account, workspace, app string, settings IOAppSettings) (IOAppSettings, error) {
+	if settings == nil {
+		settings = IOAppSettings{}
+	}
+	if err := c.Put(ctx, ioAppsEndpoint(account, workspace)+"/"+url.PathEscape(app)+"/settings", settings, nil); err != nil {
+		return nil, err
+	}
+	return c.GetIOAppSettings(ctx, account, workspace, app)
+}
+
+// IOWorkspace is a VTEX IO workspace of an account. Production workspaces serve real traffic and
+// can take part in A/B tests, where weight sets their share of it.
+type IOWorkspace struct {
+	Name       string `json:"name"`
+	Production bool   `json:"production"`
+	Weight     int64  `json:"weight"`
+}
+
+// ioWorkspacesEndpoint is the Workspaces API path of the workspaces of an account
+func ioWorkspacesEndpoint(account string) string {
+	return fmt.Sprintf("%s/workspaces/v0/%s/workspaces", ioInfraURL, url.PathEscape(account))
+}
+
+// ListIOWorkspaces lists the workspaces of an account
+func (c *VtexClient) ListIOWorkspaces(ctx context.Context, account string) ([]IOWorkspace, error) {
+	var result []IOWorkspace
+	if err := c.Get(ctx, ioWorkspacesEndpoint(account), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetIOWorkspace gets a workspace of an account by name
+func (c *VtexClient) GetIOWorkspace(ctx context.Context, account, name string) (*IOWorkspace, error) {
+	var result IOWorkspace
+	if err := c.Get(ctx, ioWorkspacesEndpoint(account)+"/"+url.PathEscape(name), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateIOWorkspace creates a workspace in an account, sets its weight and reads it back
+func (c *VtexClient) CreateIOWorkspace(ctx context.Context, account string, workspace IOWorkspace) (*IOWorkspace, error) {
+	payload := map[string]interface{}{
+		"name":       workspace.Name,
+		"production": workspace.Production,
+	}
+	if err := c.Post(ctx, ioWorkspacesEndpoint(account), payload, nil); err != nil {
+		return nil, err
+	}
+	return c.UpdateIOWorkspace(ctx, account, workspace)
+}
+
+// UpdateIOWorkspace sets the production flag and weight of a workspace and reads it back
+func (c *VtexClient) UpdateIOWorkspace(ctx context.Context, account string, workspace IOWorkspace) (*IOWorkspace, error) {
+	payload := map[string]interface{}{
+		"production": workspace.Production,
+		"weight":     workspace.Weight,
+	}
+	if err := c.Put(ctx, ioWorkspacesEndpoint(account)+"/"+url.PathEscape(workspace.Name), payload, nil); err != nil {
+		return nil, err
+	}
+	return c.GetIOWorkspace(ctx, account, workspace.Name)
+}
+
+// DeleteIOWorkspace deletes a workspace of an account, with the apps installed in it
+func (c *VtexClient) DeleteIOWorkspace(ctx context.Context, account, name string) error {
+	return c.Delete(ctx, ioWorkspacesEndpoint(account)+"/"+url.PathEscape(name), nil)
+}
+
+// IOEdition is the edition app of an account: the apps it must have, set by its sponsor account
+type IOEdition struct {
+	// ID is the edition app and its version, vendor.name@version
+	ID string `json:"id"`
+}
+
+// ioSponsorEndpoint is the sponsor account app path of an account and workspace
+func ioSponsorEndpoint(account, workspace string) string {
+	return ioAppURL("vtex.sponsor-account", 0, account, workspace)
+}
+
+// GetIOEdition gets the edition of an account's workspace
+func (c *VtexClient) GetIOEdition(ctx context.Context, account, workspace string) (*IOEdition, error) {
+	var result IOEdition
+	if err := c.Get(ctx, ioSponsorEndpoint(account, workspace)+"/edition", &result); err != nil {
+		return nil, err
+	}
+	if result.ID == "" {
+		return nil, &APIError{StatusCode: http.StatusNotFound}
+	}
+	return &result, nil
+}
+
+// SetIOEdition sets the edition (vendor.name@version, where version can be a range like 5.x) of a
+// workspace of an account sponsored by sponsorAccount, and reads it back
+func (c *VtexClient) SetIOEdition(ctx context.Context, sponsorAccount, account, workspace, edition string) (*IOEdition, error) {
+	payload := map[string]string{
+		"sponsoredAccount": account,
+		"edition":          edition,
+		"workspace":        workspace,
+	}
+	if err := c.Post(ctx, ioSponsorEndpoint(sponsorAccount, ioMasterWorkspace)+"/editions", payload, nil); err != nil {
+		return nil, err
+	}
+	return c.GetIOEdition(ctx, account, workspace)
+}
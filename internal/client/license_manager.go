@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// LicenseManagerUser is a user as returned by the License Manager API
+type LicenseManagerUser struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+	// IsBlocked is set when the user can no longer log in to the account
+	IsBlocked bool `json:"isBlocked"`
+}
+
+// LicenseManagerRole is a License Manager role, as assigned to users or managed by itself
+type LicenseManagerRole struct {
+	ID        int64                    `json:"id"`
+	Name      string                   `json:"name"`
+	Resources []LicenseManagerResource `json:"resources,omitempty"`
+}
+
+// LicenseManagerResource is a permission (resource key of a product) granted by a role
+type LicenseManagerResource struct {
+	Product string `json:"product"`
+	Key     string `json:"key"`
+}
+
+// LicenseManagerRoleRequest is the payload to create or update a custom role
+type LicenseManagerRoleRequest struct {
+	Name      string                   `json:"name"`
+	Resources []LicenseManagerResource `json:"resources"`
+}
+
+// accountQuery scopes a native API call to another account with the "an" parameter
+func accountQuery(account string) string {
+	if account == "" {
+		return ""
+	}
+	return "?an=" + url.QueryEscape(account)
+}
+
+// GetUser gets a License Manager user by user ID or email
+func (c *VtexClient) GetUser(ctx context.Context, account, userIDOrEmail string) (*LicenseManagerUser, error) {
+	var user LicenseManagerUser
+	endpoint := fmt.Sprintf("/api/license-manager/users/%s%s", url.PathEscape(userIDOrEmail), accountQuery(account))
+	if err := c.Get(ctx, endpoint, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUserRoles gets the roles assigned to a License Manager user
+func (c *VtexClient) GetUserRoles(ctx context.Context, account, userID string) ([]LicenseManagerRole, error) {
+	var roles []LicenseManagerRole
+	endpoint := fmt.Sprintf("/api/license-manager/users/%s/roles%s", url.PathEscape(userID), accountQuery(account))
+	if err := c.Get(ctx, endpoint, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// DeleteUser removes a user from the account in License Manager
+func (c *VtexClient) DeleteUser(ctx context.Context, account, userID string) error {
+	endpoint := fmt.Sprintf("/api/license-manager/users/%s%s", url.PathEscape(userID), accountQuery(account))
+	return c.Delete(ctx, endpoint, nil)
+}
+
+// LicenseManagerUserRequest is the payload to create or update a user
+type LicenseManagerUserRequest struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// PutUser creates a user, or updates the name of an existing user with the same email
+func (c *VtexClient) PutUser(ctx context.Context, account string, user LicenseManagerUserRequest) (*LicenseManagerUser, error) {
+	var result LicenseManagerUser
+	if err := c.Put(ctx, "/api/license-manager/users"+accountQuery(account), user, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AddUserRoles assigns roles (by role ID) to a user
+func (c *VtexClient) AddUserRoles(ctx context.Context, account, userID string, roleIDs []int64) error {
+	endpoint := fmt.Sprintf("/api/license-manager/users/%s/roles%s", url.PathEscape(userID), accountQuery(account))
+	return c.Put(ctx, endpoint, roleIDs, nil)
+}
+
+// RemoveUserRole removes a role (by role ID) from a user
+func (c *VtexClient) RemoveUserRole(ctx context.Context, account, userID string, roleID int64) error {
+	endpoint := fmt.Sprintf("/api/license-manager/users/%s/roles/%d%s", url.PathEscape(userID), roleID, accountQuery(account))
+	return c.Delete(ctx, endpoint, nil)
+}
+
+// CreateRole creates a custom role
+func (c *VtexClient) CreateRole(ctx context.Context, account string, role LicenseManagerRoleRequest) (*LicenseManagerRole, error) {
+	var result LicenseManagerRole
+	if err := c.Post(ctx, "/api/license-manager/roles"+accountQuery(account), role, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetRole gets a role with its resources
+func (c *VtexClient) GetRole(ctx context.Context, account string, roleID int64) (*LicenseManagerRole, error) {
+	var result LicenseManagerRole
+	endpoint := fmt.Sprintf("/api/license-manager/roles/%d%s", roleID, accountQuery(account))
+	if err := c.Get(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateRole replaces the name and resources of a custom role
+func (c *VtexClient) UpdateRole(ctx context.Context, account string, roleID int64, role LicenseManagerRoleRequest) error {
+	return c.Put(ctx, fmt.Sprintf("/api/license-manager/roles/%d%s", roleID, accountQuery(account)), role, nil)
+}
+
+// DeleteRole deletes a custom role
+func (c *VtexClient) DeleteRole(ctx context.Context, account string, roleID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/license-manager/roles/%d%s", roleID, accountQuery(account)), nil)
+}
+
+// rolesPage is one page of the License Manager roles list
+type rolesPage struct {
+	Items  []LicenseManagerRole `json:"items"`
+	Paging struct {
+		Pages int `json:"pages"`
+	} `json:"paging"`
+}
+
+// ListRoles gets all roles of the account, following pagination
+func (c *VtexClient) ListRoles(ctx context.Context, account string) ([]LicenseManagerRole, error) {
+	var roles []LicenseManagerRole
+	for pageNumber := 1; ; pageNumber++ {
+		var page rolesPage
+		endpoint := fmt.Sprintf("/api/license-manager/site/pvt/roles/list/paged?numItems=100&pageNumber=%d", pageNumber)
+		if account != "" {
+			endpoint += "&an=" + url.QueryEscape(account)
+		}
+		if err := c.Get(ctx, endpoint, &page); err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, page.Items...)
+		if pageNumber >= page.Paging.Pages || len(page.Items) == 0 {
+			return roles, nil
+		}
+	}
+}
@@ -0,0 +1,439 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Dock is a Logistics loading dock, where orders leave a warehouse for a shipping policy.
+// Time spans use the .NET format, like 1.00:00:00 for one day.
+type Dock struct {
+	ID              string               `json:"id"`
+	Name            string               `json:"name"`
+	Priority        int64                `json:"priority"`
+	DockTimeFake    string               `json:"dockTimeFake"`
+	SalesChannels   []string             `json:"salesChannels"`
+	FreightTableIDs []string             `json:"freightTableIds"`
+	WMSEndPoint     string               `json:"wmsEndPoint"`
+	PickupStoreInfo *DockPickupStoreInfo `json:"pickupStoreInfo,omitempty"`
+}
+
+// DockPickupStoreInfo marks a dock as a store where shoppers pick up their orders
+type DockPickupStoreInfo struct {
+	IsPickupStore  bool   `json:"isPickupStore"`
+	StoreID        string `json:"storeId,omitempty"`
+	FriendlyName   string `json:"friendlyName,omitempty"`
+	AdditionalInfo string `json:"additionalInfo,omitempty"`
+	DockID         string `json:"dockId,omitempty"`
+}
+
+// GetDock gets a dock by ID
+func (c *VtexClient) GetDock(ctx context.Context, dockID string) (*Dock, error) {
+	var result Dock
+	if err := c.Get(ctx, "/api/logistics/pvt/configuration/docks/"+url.PathEscape(dockID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SaveDock creates a dock, or updates the dock with the same ID
+func (c *VtexClient) SaveDock(ctx context.Context, dock Dock) error {
+	return c.Post(ctx, "/api/logistics/pvt/configuration/docks", dock, nil)
+}
+
+// DeleteDock deletes a dock
+func (c *VtexClient) DeleteDock(ctx context.Context, dockID string) error {
+	return c.Delete(ctx, "/api/logistics/pvt/configuration/docks/"+url.PathEscape(dockID), nil)
+}
+
+// Operations of a freight value update
+const (
+	FreightOperationSave   = 1
+	FreightOperationDelete = 2
+)
+
+// ShippingPolicy is a Logistics shipping policy (carrier)
+type ShippingPolicy struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	ShippingMethod string `json:"shippingMethod"`
+	IsActive       bool   `json:"isActive"`
+}
+
+// FreightValue is a row of the freight table of a shipping policy. Weights are in grams
+// and the time cost uses the .NET time span format.
+type FreightValue struct {
+	ZipCodeStart         string  `json:"zipCodeStart"`
+	ZipCodeEnd           string  `json:"zipCodeEnd"`
+	WeightStart          float64 `json:"weightStart"`
+	WeightEnd            float64 `json:"weightEnd"`
+	AbsoluteMoneyCost    float64 `json:"absoluteMoneyCost"`
+	PricePercent         float64 `json:"pricePercent"`
+	PricePercentByWeight float64 `json:"pricePercentByWeight"`
+	MaxVolume            float64 `json:"maxVolume"`
+	TimeCost             string  `json:"timeCost"`
+	Country              string  `json:"country"`
+	OperationType        int     `json:"operationType"`
+	Polygon              string  `json:"polygon"`
+}
+
+// GetShippingPolicy gets a shipping policy by ID
+func (c *VtexClient) GetShippingPolicy(ctx context.Context, shippingPolicyID string) (*ShippingPolicy, error) {
+	var result ShippingPolicy
+	if err := c.Get(ctx, "/api/logistics/pvt/shipping-policies/"+url.PathEscape(shippingPolicyID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFreightValues gets the freight table rows of a shipping policy that cover a postal code
+func (c *VtexClient) GetFreightValues(ctx context.Context, shippingPolicyID, postalCode string) ([]FreightValue, error) {
+	var result []FreightValue
+	endpoint := "/api/logistics/pvt/configuration/freights/" + url.PathEscape(shippingPolicyID) + "/" + url.PathEscape(postalCode) + "/values"
+	if err := c.Get(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateFreightValues saves or deletes freight table rows of a shipping policy, by their operation type
+func (c *VtexClient) UpdateFreightValues(ctx context.Context, shippingPolicyID string, values []FreightValue) error {
+	return c.Post(ctx, "/api/logistics/pvt/configuration/freights/"+url.PathEscape(shippingPolicyID)+"/values/update", values, nil)
+}
+
+// SKUInventory is the stock of a SKU in every warehouse
+type SKUInventory struct {
+	SKUID   string             `json:"skuId"`
+	Balance []InventoryBalance `json:"balance"`
+}
+
+// InventoryBalance is the stock of a SKU in a warehouse
+type InventoryBalance struct {
+	WarehouseID          string `json:"warehouseId"`
+	WarehouseName        string `json:"warehouseName"`
+	TotalQuantity        int64  `json:"totalQuantity"`
+	ReservedQuantity     int64  `json:"reservedQuantity"`
+	HasUnlimitedQuantity bool   `json:"hasUnlimitedQuantity"`
+}
+
+// InventoryUpdate is the new stock of a SKU in a warehouse
+type InventoryUpdate struct {
+	Quantity          int64 `json:"quantity"`
+	UnlimitedQuantity bool  `json:"unlimitedQuantity"`
+}
+
+// GetSKUInventory gets the stock of a SKU in every warehouse
+func (c *VtexClient) GetSKUInventory(ctx context.Context, skuID int64) (*SKUInventory, error) {
+	var result SKUInventory
+	if err := c.Get(ctx, fmt.Sprintf("/api/logistics/pvt/inventory/skus/%d", skuID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetInventoryBalance gets the stock of a SKU in a warehouse. It is not found when
+// the warehouse has no stock entry for the SKU.
+func (c *VtexClient) GetInventoryBalance(ctx context.Context, skuID int64, warehouseID string) (*InventoryBalance, error) {
+	inventory, err := c.GetSKUInventory(ctx, skuID)
+	if err != nil {
+		return nil, err
+	}
+	for _, balance := range inventory.Balance {
+		if balance.WarehouseID == warehouseID {
+			return &balance, nil
+		}
+	}
+	return nil, &APIError{StatusCode: http.StatusNotFound}
+}
+
+// UpdateInventory sets the stock of a SKU in a warehouse
+func (c *VtexClient) UpdateInventory(ctx context.Context, skuID int64, warehouseID string, update InventoryUpdate) error {
+	endpoint := fmt.Sprintf("/api/logistics/pvt/inventory/skus/%d/warehouses/%s", skuID, url.PathEscape(warehouseID))
+	return c.Put(ctx, endpoint, update, nil)
+}
+
+// Release policies of inventory reservations
+const (
+	ReservationReleaseOnExpiration   = "expiration"
+	ReservationReleaseOnCancellation = "cancellation"
+)
+
+// ReservationSettings are the inventory reservation rules of the account. The lock
+// time to live uses the .NET time span format.
+type ReservationSettings struct {
+	LockTTL       string `json:"lockTTL"`
+	ReleasePolicy string `json:"releasePolicy"`
+}
+
+// GetReservationSettings gets the inventory reservation settings of the account
+func (c *VtexClient) GetReservationSettings(ctx context.Context) (*ReservationSettings, error) {
+	var result ReservationSettings
+	if err := c.Get(ctx, "/api/logistics/pvt/configuration/reservations", &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateReservationSettings replaces the inventory reservation settings of the account
+func (c *VtexClient) UpdateReservationSettings(ctx context.Context, settings ReservationSettings) error {
+	return c.Put(ctx, "/api/logistics/pvt/configuration/reservations", settings, nil)
+}
+
+// PickupPoint is a Logistics pickup point, where shoppers collect their orders
+type PickupPoint struct {
+	ID               string                `json:"id"`
+	Name             string                `json:"name"`
+	Description      string                `json:"description"`
+	Instructions     string                `json:"instructions"`
+	FormattedAddress string                `json:"formatted_address,omitempty"`
+	Address          PickupPointAddress    `json:"address"`
+	IsActive         bool                  `json:"isActive"`
+	BusinessHours    []PickupBusinessHours `json:"businessHours"`
+	TagsLabel        []string              `json:"tagsLabel"`
+}
+
+// PickupPointAddress is the address of a pickup point
+type PickupPointAddress struct {
+	PostalCode   string          `json:"postalCode"`
+	Country      AddressCountry  `json:"country"`
+	City         string          `json:"city"`
+	State        string          `json:"state"`
+	Neighborhood string          `json:"neighborhood"`
+	Street       string          `json:"street"`
+	Number       string          `json:"number"`
+	Complement   string          `json:"complement"`
+	Reference    string          `json:"reference"`
+	Location     AddressLocation `json:"location"`
+}
+
+// AddressCountry is the country of an address, by its ISO 3166 alpha-3 code
+type AddressCountry struct {
+	Acronym string `json:"acronym"`
+	Name    string `json:"name,omitempty"`
+}
+
+// AddressLocation are the geographic coordinates of an address
+type AddressLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// PickupBusinessHours are the opening hours of a pickup point on a day of the week (0 is Sunday)
+type PickupBusinessHours struct {
+	DayOfWeek   int64  `json:"dayOfWeek"`
+	OpeningTime string `json:"openingTime"`
+	ClosingTime string `json:"closingTime"`
+}
+
+// GetPickupPoint gets a pickup point by ID
+func (c *VtexClient) GetPickupPoint(ctx context.Context, pickupPointID string) (*PickupPoint, error) {
+	var result PickupPoint
+	if err := c.Get(ctx, "/api/logistics/pvt/configuration/pickuppoints/"+url.PathEscape(pickupPointID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SavePickupPoint creates a pickup point, or replaces the pickup point with the same ID
+func (c *VtexClient) SavePickupPoint(ctx context.Context, pickupPoint PickupPoint) error {
+	return c.Put(ctx, "/api/logistics/pvt/configuration/pickuppoints/"+url.PathEscape(pickupPoint.ID), pickupPoint, nil)
+}
+
+// DeletePickupPoint deletes a pickup point
+func (c *VtexClient) DeletePickupPoint(ctx context.Context, pickupPointID string) error {
+	return c.Delete(ctx, "/api/logistics/pvt/configuration/pickuppoints/"+url.PathEscape(pickupPointID), nil)
+}
+
+// Holiday is a Logistics holiday, a day without deliveries in shipping estimates
+type Holiday struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	StartDate string `json:"startDate"`
+}
+
+// ListHolidays lists the holidays of the account
+func (c *VtexClient) ListHolidays(ctx context.Context) ([]Holiday, error) {
+	var result []Holiday
+	if err := c.Get(ctx, "/api/logistics/pvt/configuration/holidays", &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SaveHoliday creates a holiday, or replaces the holiday with the same ID
+func (c *VtexClient) SaveHoliday(ctx context.Context, holiday Holiday) error {
+	return c.Put(ctx, "/api/logistics/pvt/configuration/holidays/"+url.PathEscape(holiday.ID), holiday, nil)
+}
+
+// DeleteHoliday deletes a holiday
+func (c *VtexClient) DeleteHoliday(ctx context.Context, holidayID string) error {
+	return c.Delete(ctx, "/api/logistics/pvt/configuration/holidays/"+url.PathEscape(holidayID), nil)
+}
+
+// GeoShape is a named Logistics polygon, used by freight tables to deliver to an area
+// instead of a postal code range
+type GeoShape struct {
+	Name     string           `json:"name"`
+	GeoShape GeoShapeGeometry `json:"geoShape"`
+}
+
+// GeoShapeGeometry is a GeoJSON Polygon or MultiPolygon geometry
+type GeoShapeGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// GetGeoShape gets a polygon by name
+func (c *VtexClient) GetGeoShape(ctx context.Context, name string) (*GeoShape, error) {
+	var result GeoShape
+	if err := c.Get(ctx, "/api/logistics/pvt/configuration/geoshape/"+url.PathEscape(name), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SaveGeoShape creates a polygon, or replaces the polygon with the same name
+func (c *VtexClient) SaveGeoShape(ctx context.Context, geoShape GeoShape) error {
+	return c.Put(ctx, "/api/logistics/pvt/configuration/geoshape", geoShape, nil)
+}
+
+// DeleteGeoShape deletes a polygon
+func (c *VtexClient) DeleteGeoShape(ctx context.Context, name string) error {
+	return c.Delete(ctx, "/api/logistics/pvt/configuration/geoshape/"+url.PathEscape(name), nil)
+}
+
+// Warehouse is a Logistics warehouse, with the docks its orders leave from
+type Warehouse struct {
+	ID             string          `json:"id"`
+	Name           string          `json:"name"`
+	IsActive       bool            `json:"isActive"`
+	WarehouseDocks []WarehouseDock `json:"warehouseDocks"`
+	PickupPointIDs []string        `json:"pickupPointIds"`
+}
+
+// WarehouseDock is a dock of a warehouse, with the time and cost to move orders to it
+type WarehouseDock struct {
+	DockID string  `json:"dockId"`
+	Time   string  `json:"time"`
+	Cost   float64 `json:"cost"`
+}
+
+// GetWarehouse gets a warehouse by ID
+func (c *VtexClient) GetWarehouse(ctx context.Context, warehouseID string) (*Warehouse, error) {
+	var result Warehouse
+	if err := c.Get(ctx, "/api/logistics/pvt/configuration/warehouses/"+url.PathEscape(warehouseID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SaveWarehouse creates a warehouse, or updates the warehouse with the same ID
+func (c *VtexClient) SaveWarehouse(ctx context.Context, warehouse Warehouse) error {
+	return c.Post(ctx, "/api/logistics/pvt/configuration/warehouses", warehouse, nil)
+}
+
+// DeleteWarehouse deletes a warehouse
+func (c *VtexClient) DeleteWarehouse(ctx context.Context, warehouseID string) error {
+	return c.Delete(ctx, "/api/logistics/pvt/configuration/warehouses/"+url.PathEscape(warehouseID), nil)
+}
+
+// logisticsPage is one page of a Logistics list
+type logisticsPage struct {
+	Items  json.RawMessage `json:"items"`
+	Paging struct {
+		Pages int `json:"pages"`
+	} `json:"paging"`
+}
+
+// listLogisticsPages gets every page of a Logistics list, passing the items of each page to decode.
+// Accounts on older Logistics versions return the whole list as an array, which is used as is.
+func (c *VtexClient) listLogisticsPages(ctx context.Context, endpoint string, decode func(items json.RawMessage) (int, error)) error {
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+
+	for page := 1; ; page++ {
+		var body json.RawMessage
+		if err := c.Get(ctx, fmt.Sprintf("%s%spage=%d&perPage=100", endpoint, separator, page), &body); err != nil {
+			return err
+		}
+
+		trimmed := bytes.TrimSpace(body)
+		if len(trimmed) == 0 {
+			return nil
+		}
+		if trimmed[0] == '[' {
+			_, err := decode(trimmed)
+			return err
+		}
+
+		var result logisticsPage
+		if err := json.Unmarshal(trimmed, &result); err != nil {
+			return err
+		}
+		count, err := decode(result.Items)
+		if err != nil {
+			return err
+		}
+		if page >= result.Paging.Pages || count == 0 {
+			return nil
+		}
+	}
+}
+
+// ListWarehouses gets the warehouses of the account, following pagination
+func (c *VtexClient) ListWarehouses(ctx context.Context) ([]Warehouse, error) {
+	var warehouses []Warehouse
+	err := c.listLogisticsPages(ctx, "/api/logistics/pvt/configuration/warehouses", func(items json.RawMessage) (int, error) {
+		var page []Warehouse
+		if err := json.Unmarshal(items, &page); err != nil {
+			return 0, err
+		}
+		warehouses = append(warehouses, page...)
+		return len(page), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return warehouses, nil
+}
+
+// ListDocks gets the docks of the account, following pagination
+func (c *VtexClient) ListDocks(ctx context.Context) ([]Dock, error) {
+	var docks []Dock
+	err := c.listLogisticsPages(ctx, "/api/logistics/pvt/configuration/docks", func(items json.RawMessage) (int, error) {
+		var page []Dock
+		if err := json.Unmarshal(items, &page); err != nil {
+			return 0, err
+		}
+		docks = append(docks, page...)
+		return len(page), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return docks, nil
+}
+
+// ListPickupPoints gets the pickup points of the account, following pagination
+func (c *VtexClient) ListPickupPoints(ctx context.Context) ([]PickupPoint, error) {
+	var pickupPoints []PickupPoint
+	err := c.listLogisticsPages(ctx, "/api/logistics/pvt/configuration/pickuppoints", func(items json.RawMessage) (int, error) {
+		var page []PickupPoint
+		if err := json.Unmarshal(items, &page); err != nil {
+			return 0, err
+		}
+		pickupPoints = append(pickupPoints, page...)
+		return len(page), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pickupPoints, nil
+}
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Master Data v2 keywords of a schema that are not JSON Schema: the fields indexed for
+// searches, whether documents are cached and what can be accessed without credentials
+const (
+	MasterDataIndexedKey  = "v-indexed"
+	MasterDataCacheKey    = "v-cache"
+	MasterDataSecurityKey = "v-security"
+)
+
+// MasterDataSecurity is the v-security keyword of a schema: the fields anyone can read,
+// write or filter by without credentials, and whether documents can be listed without a filter
+type MasterDataSecurity struct {
+	AllowGetAll      bool     `json:"allowGetAll"`
+	PublicRead       []string `json:"publicRead"`
+	PublicWrite      []string `json:"publicWrite"`
+	PublicFilter     []string `json:"publicFilter"`
+	PublicJSONSchema bool     `json:"publicJsonSchema"`
+}
+
+// MasterDataSchema is a Master Data v2 JSON schema, by keyword
+type MasterDataSchema map[string]json.RawMessage
+
+// masterDataSchemaEndpoint is the endpoint of a schema of a data entity
+func masterDataSchemaEndpoint(dataEntity, schemaName string) string {
+	return "/api/dataentities/" + url.PathEscape(dataEntity) + "/schemas/" + url.PathEscape(schemaName)
+}
+
+// GetMasterDataSchema gets a schema of a data entity
+func (c *VtexClient) GetMasterDataSchema(ctx context.Context, dataEntity, schemaName string) (MasterDataSchema, error) {
+	var result MasterDataSchema
+	if err := c.Get(ctx, masterDataSchemaEndpoint(dataEntity, schemaName), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SaveMasterDataSchema creates or replaces a schema of a data entity and reads it back
+func (c *VtexClient) SaveMasterDataSchema(ctx context.Context, dataEntity, schemaName string, schema MasterDataSchema) (MasterDataSchema, error) {
+	if err := c.Put(ctx, masterDataSchemaEndpoint(dataEntity, schemaName), schema, nil); err != nil {
+		return nil, err
+	}
+	return c.GetMasterDataSchema(ctx, dataEntity, schemaName)
+}
+
+// DeleteMasterDataSchema removes a schema of a data entity. Its documents are kept.
+func (c *VtexClient) DeleteMasterDataSchema(ctx context.Context, dataEntity, schemaName string) error {
+	return c.Delete(ctx, masterDataSchemaEndpoint(dataEntity, schemaName), nil)
+}
+
+// MasterDataSystemFields are the fields Master Data sets on every document
+var MasterDataSystemFields = []string{
+	"id", "accountId", "accountName", "dataEntityId", "followers", "schemas", "tags", "auto_filter",
+	"createdBy", "createdIn", "updatedBy", "updatedIn", "lastInteractionBy", "lastInteractionIn",
+}
+
+// MasterDataDocument is a Master Data v2 document, by field
+type MasterDataDocument map[string]json.RawMessage
+
+// masterDataDocumentCreated is the response of a document creation
+type masterDataDocumentCreated struct {
+	DocumentID string `json:"DocumentId"`
+}
+
+// masterDataDocumentsEndpoint is the endpoint of the documents of a data entity, or of one of them,
+// validated against a schema when one is given
+func masterDataDocumentsEndpoint(dataEntity, documentID, schemaName string, query url.Values) string {
+	endpoint := "/api/dataentities/" + url.PathEscape(dataEntity) + "/documents"
+	if documentID != "" {
+		endpoint += "/" + url.PathEscape(documentID)
+	}
+	if query == nil {
+		query = url.Values{}
+	}
+	if schemaName != "" {
+		query.Set("_schema", schemaName)
+	}
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	return endpoint
+}
+
+// GetMasterDataDocument gets the given fields of a document, or all of them when fields is empty
+func (c *VtexClient) GetMasterDataDocument(ctx context.Context, dataEntity, documentID, schemaName string, fields []string) (MasterDataDocument, error) {
+	query := url.Values{"_fields": {"_all"}}
+	if len(fields) > 0 {
+		query.Set("_fields", strings.Join(fields, ","))
+	}
+
+	var result MasterDataDocument
+	if err := c.Get(ctx, masterDataDocumentsEndpoint(dataEntity, documentID, schemaName, query), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateMasterDataDocument creates a document with a generated ID and returns the ID
+func (c *VtexClient) CreateMasterDataDocument(ctx context.Context, dataEntity, schemaName string, document MasterDataDocument) (string, error) {
+	var result masterDataDocumentCreated
+	if err := c.Post(ctx, masterDataDocumentsEndpoint(dataEntity, "", schemaName, nil), document, &result); err != nil {
+		return "", err
+	}
+	return result.DocumentID, nil
+}
+
+// SaveMasterDataDocument creates or replaces the document with the given ID
+func (c *VtexClient) SaveMasterDataDocument(ctx context.Context, dataEntity, documentID, schemaName string, document MasterDataDocument) error {
+	return c.Put(ctx, masterDataDocumentsEndpoint(dataEntity, documentID, schemaName, nil), document, nil)
+}
+
+// UpdateMasterDataDocument changes the given fields of a document, keeping the others.
+// Fields set to null are removed.
+func (c *VtexClient) UpdateMasterDataDocument(ctx context.Context, dataEntity, documentID, schemaName string, fields MasterDataDocument) error {
+	return c.Patch(ctx, masterDataDocumentsEndpoint(dataEntity, documentID, schemaName, nil), fields, nil)
+}
+
+// DeleteMasterDataDocument removes a document
+func (c *VtexClient) DeleteMasterDataDocument(ctx context.Context, dataEntity, documentID string) error {
+	return c.Delete(ctx, masterDataDocumentsEndpoint(dataEntity, documentID, "", nil), nil)
+}
+
+// MasterDataSearch selects and sorts the documents SearchMasterDataDocuments returns.
+// Zero values do not filter.
+type MasterDataSearch struct {
+	Schema string
+	Where  string
+	Fields []string
+	Sort   string
+}
+
+// masterDataPageSize is the largest number of documents a search returns per page
+const masterDataPageSize = 100
+
+// MasterDataSearchMaxResults is the largest number of documents a search can page through
+const MasterDataSearchMaxResults = 10000
+
+// SearchMasterDataDocuments searches the documents of a data entity and returns up to limit of
+// them with the total number of matches, paging with the REST-Range header
+func (c *VtexClient) SearchMasterDataDocuments(ctx context.Context, dataEntity string, search MasterDataSearch, limit int) ([]MasterDataDocument, int64, error) {
+	query := url.Values{"_fields": {"_all"}}
+	if len(search.Fields) > 0 {
+		query.Set("_fields", strings.Join(search.Fields, ","))
+	}
+	if search.Where != "" {
+		query.Set("_where", search.Where)
+	}
+	if search.Sort != "" {
+		query.Set("_sort", search.Sort)
+	}
+	endpoint := "/api/dataentities/" + url.PathEscape(dataEntity) + "/search?" + query.Encode()
+	if search.Schema != "" {
+		endpoint += "&_schema=" + url.QueryEscape(search.Schema)
+	}
+
+	var documents []MasterDataDocument
+	var total int64
+	for from := 0; from < limit; from += masterDataPageSize {
+		to := min(from+masterDataPageSize, limit) - 1
+		headers := http.Header{"REST-Range": {fmt.Sprintf("resources=%d-%d", from, to)}}
+
+		var result []MasterDataDocument
+		responseHeaders, err := c.GetWithHeaders(ctx, endpoint, headers, &result)
+		if err != nil {
+			return nil, 0, err
+		}
+		documents = append(documents, result...)
+
+		total = masterDataTotal(responseHeaders.Get("REST-Content-Range"), len(documents))
+		if len(result) < to-from+1 || int64(len(documents)) >= total {
+			return documents, total, nil
+		}
+	}
+	return documents, total, nil
+}
+
+// masterDataTotal reads the total number of matches from a REST-Content-Range header like
+// "resources 0-99/1234", or returns the number of documents read when it is missing
+func masterDataTotal(contentRange string, read int) int64 {
+	if i := strings.LastIndex(contentRange, "/"); i >= 0 {
+		if total, err := strconv.ParseInt(strings.TrimSpace(contentRange[i+1:]), 10, 64); err == nil {
+			return total
+		}
+	}
+	return int64(read)
+}
@@ -0,0 +1,269 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Filter types of the orders hook and feed
+const (
+	OrderFilterFromWorkflow = "FromWorkflow"
+	OrderFilterFromOrders   = "FromOrders"
+)
+
+// OrderStatuses are the workflow statuses an order goes through
+var OrderStatuses = []string{
+	"order-created",
+	"order-completed",
+	"on-order-completed",
+	"payment-pending",
+	"waiting-for-order-authorization",
+	"approve-payment",
+	"payment-approved",
+	"payment-denied",
+	"request-cancel",
+	"waiting-for-seller-decision",
+	"authorize-fulfillment",
+	"order-create-error",
+	"order-creation-error",
+	"window-to-cancel",
+	"ready-for-handling",
+	"start-handling",
+	"handling",
+	"invoice-after-cancellation-deny",
+	"order-accepted",
+	"invoiced",
+	"cancel",
+	"canceled",
+}
+
+// OrdersHook is the orders hook of the app key: the endpoint the OMS notifies of order changes
+type OrdersHook struct {
+	Filter OrdersFilter     `json:"filter"`
+	Hook   OrdersHookTarget `json:"hook"`
+	Queue  *OrdersQueue     `json:"queue,omitempty"`
+}
+
+// OrdersFilter selects the order changes that are notified: status changes (FromWorkflow)
+// or orders matching a JSONata expression (FromOrders)
+type OrdersFilter struct {
+	Type              string   `json:"type"`
+	Status            []string `json:"status,omitempty"`
+	Expression        string   `json:"expression,omitempty"`
+	DisableSingleFire bool     `json:"disableSingleFire"`
+}
+
+// OrdersHookTarget is the endpoint of the orders hook and the headers sent to it
+type OrdersHookTarget struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// OrdersQueue are the settings of the queue behind the orders hook or feed
+type OrdersQueue struct {
+	VisibilityTimeoutInSeconds      int64 `json:"visibilityTimeoutInSeconds"`
+	MessageRetentionPeriodInSeconds int64 `json:"MessageRetentionPeriodInSeconds"`
+}
+
+// GetOrdersHook gets the orders hook of the app key
+func (c *VtexClient) GetOrdersHook(ctx context.Context) (*OrdersHook, error) {
+	var result OrdersHook
+	if err := c.Get(ctx, "/api/orders/hook/config", &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SaveOrdersHook creates or replaces the orders hook of the app key and reads it back.
+// The OMS sends a ping to the endpoint and fails if it does not answer 200.
+func (c *VtexClient) SaveOrdersHook(ctx context.Context, hook OrdersHook) (*OrdersHook, error) {
+	if err := c.Post(ctx, "/api/orders/hook/config", hook, nil); err != nil {
+		return nil, err
+	}
+	return c.GetOrdersHook(ctx)
+}
+
+// DeleteOrdersHook removes the orders hook of the app key
+func (c *VtexClient) DeleteOrdersHook(ctx context.Context) error {
+	return c.Delete(ctx, "/api/orders/hook/config", nil)
+}
+
+// Order is an order of the OMS. Values are in cents.
+type Order struct {
+	OrderID           string              `json:"orderId"`
+	Sequence          string              `json:"sequence"`
+	Status            string              `json:"status"`
+	StatusDescription string              `json:"statusDescription"`
+	Value             int64               `json:"value"`
+	CreationDate      string              `json:"creationDate"`
+	LastChange        string              `json:"lastChange"`
+	SalesChannel      string              `json:"salesChannel"`
+	Origin            string              `json:"origin"`
+	Items             []OrderItem         `json:"items"`
+	Totals            []OrderTotal        `json:"totals"`
+	ClientProfileData *OrderClientProfile `json:"clientProfileData"`
+}
+
+// OrderItem is an item of an order
+type OrderItem struct {
+	ID           string `json:"id"`
+	ProductID    string `json:"productId"`
+	RefID        string `json:"refId"`
+	Name         string `json:"name"`
+	Quantity     int64  `json:"quantity"`
+	Seller       string `json:"seller"`
+	Price        int64  `json:"price"`
+	SellingPrice int64  `json:"sellingPrice"`
+}
+
+// OrderTotal is a total of an order, such as Items or Shipping
+type OrderTotal struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Value int64  `json:"value"`
+}
+
+// OrderClientProfile is the shopper of an order
+type OrderClientProfile struct {
+	Email         string `json:"email"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	IsCorporate   bool   `json:"isCorporate"`
+	CorporateName string `json:"corporateName"`
+}
+
+// GetOrder gets an order by ID
+func (c *VtexClient) GetOrder(ctx context.Context, orderID string) (*Order, error) {
+	var result Order
+	if err := c.Get(ctx, "/api/oms/pvt/orders/"+url.PathEscape(orderID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// OrderListFilter selects the orders ListOrders returns. Zero values do not filter.
+type OrderListFilter struct {
+	CreatedFrom  time.Time
+	CreatedTo    time.Time
+	Statuses     []string
+	SalesChannel string
+}
+
+// OrderSummary is an order as the OMS lists it. Values are in cents.
+type OrderSummary struct {
+	OrderID           string `json:"orderId"`
+	Sequence          string `json:"sequence"`
+	Status            string `json:"status"`
+	StatusDescription string `json:"statusDescription"`
+	TotalValue        int64  `json:"totalValue"`
+	CreationDate      string `json:"creationDate"`
+	LastChange        string `json:"lastChange"`
+	SalesChannel      string `json:"salesChannel"`
+	Origin            string `json:"origin"`
+	ClientName        string `json:"clientName"`
+}
+
+// ordersMaxPages is the last page the OMS lists, whatever the number of orders
+const ordersMaxPages = 30
+
+// ListOrders lists up to limit orders matching the filter, newest first, and returns the number
+// of orders that match. The OMS lists at most 30 pages of 100 orders.
+func (c *VtexClient) ListOrders(ctx context.Context, filter OrderListFilter, limit int) ([]OrderSummary, int64, error) {
+	query := url.Values{}
+	query.Set("orderBy", "creationDate,desc")
+	if !filter.CreatedFrom.IsZero() || !filter.CreatedTo.IsZero() {
+		from, to := "*", "*"
+		if !filter.CreatedFrom.IsZero() {
+			from = filter.CreatedFrom.UTC().Format("2006-01-02T15:04:05.000Z")
+		}
+		if !filter.CreatedTo.IsZero() {
+			to = filter.CreatedTo.UTC().Format("2006-01-02T15:04:05.000Z")
+		}
+		query.Set("f_creationDate", fmt.Sprintf("creationDate:[%s TO %s]", from, to))
+	}
+	if len(filter.Statuses) > 0 {
+		query.Set("f_status", strings.Join(filter.Statuses, ","))
+	}
+	if filter.SalesChannel != "" {
+		query.Set("f_salesChannel", filter.SalesChannel)
+	}
+
+	perPage := 100
+	if limit < perPage {
+		perPage = limit
+	}
+	query.Set("per_page", strconv.Itoa(perPage))
+
+	var orders []OrderSummary
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		var result struct {
+			List   []OrderSummary `json:"list"`
+			Paging struct {
+				Total int64 `json:"total"`
+				Pages int   `json:"pages"`
+			} `json:"paging"`
+		}
+		if err := c.Get(ctx, "/api/oms/pvt/orders?"+query.Encode(), &result); err != nil {
+			return nil, 0, err
+		}
+		orders = append(orders, result.List...)
+
+		if len(orders) >= limit {
+			return orders[:limit], result.Paging.Total, nil
+		}
+		if page >= result.Paging.Pages || page >= ordersMaxPages || len(result.List) == 0 {
+			return orders, result.Paging.Total, nil
+		}
+	}
+}
+
+// AffiliateSearchVersion is the version of the search protocol the affiliate implements
+const AffiliateSearchVersion = "1.x.x"
+
+// Affiliate is an order integration affiliate: an external marketplace connector that sends
+// orders to the account under a trade policy
+type Affiliate struct {
+	ID                                 string   `json:"id"`
+	Name                               string   `json:"name"`
+	SalesChannel                       string   `json:"salesChannel"`
+	FollowUpEmail                      string   `json:"followUpEmail"`
+	SearchURIEndpoint                  string   `json:"searchURIEndpoint"`
+	SearchURIEndpointVersion           string   `json:"searchURIEndpointVersion"`
+	SearchURIEndpointAvailableVersions []string `json:"searchURIEndpointAvailableVersions"`
+	UseSellerPaymentMethod             bool     `json:"useSellerPaymentMethod"`
+}
+
+// affiliateEndpoint is the endpoint of an affiliate
+func affiliateEndpoint(affiliateID string) string {
+	return "/api/fulfillment/pvt/affiliates/" + url.PathEscape(affiliateID)
+}
+
+// GetAffiliate gets an affiliate by ID
+func (c *VtexClient) GetAffiliate(ctx context.Context, affiliateID string) (*Affiliate, error) {
+	var result Affiliate
+	if err := c.Get(ctx, affiliateEndpoint(affiliateID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SaveAffiliate creates or replaces an affiliate and reads it back
+func (c *VtexClient) SaveAffiliate(ctx context.Context, affiliate Affiliate) (*Affiliate, error) {
+	affiliate.SearchURIEndpointVersion = AffiliateSearchVersion
+	affiliate.SearchURIEndpointAvailableVersions = []string{AffiliateSearchVersion}
+	if err := c.Put(ctx, affiliateEndpoint(affiliate.ID), affiliate, nil); err != nil {
+		return nil, err
+	}
+	return c.GetAffiliate(ctx, affiliate.ID)
+}
+
+// DeleteAffiliate removes an affiliate
+func (c *VtexClient) DeleteAffiliate(ctx context.Context, affiliateID string) error {
+	return c.Delete(ctx, affiliateEndpoint(affiliateID), nil)
+}
@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// PaymentRule is a payment condition: a payment system offered through a connector in some sales channels.
+// The raw fields are kept as read, so saving a rule does not drop the settings the provider does not manage.
+type PaymentRule struct {
+	ID                 string                    `json:"id,omitempty"`
+	Name               string                    `json:"name"`
+	Enabled            bool                      `json:"enabled"`
+	IsDefault          bool                      `json:"isDefault"`
+	SalesChannels      []PaymentRuleSalesChannel `json:"salesChannels"`
+	PaymentSystem      PaymentRuleSystem         `json:"paymentSystem"`
+	Connector          PaymentRuleAffiliation    `json:"connector"`
+	Antifraud          PaymentRuleAffiliation    `json:"antifraud"`
+	MinimumValue       *float64                  `json:"minimumValue"`
+	BeginDate          *string                   `json:"beginDate"`
+	EndDate            *string                   `json:"endDate"`
+	InstallmentOptions json.RawMessage           `json:"installmentOptions,omitempty"`
+	Issuer             json.RawMessage           `json:"issuer,omitempty"`
+	Condition          json.RawMessage           `json:"condition,omitempty"`
+}
+
+// PaymentRuleSalesChannel is a sales channel a payment rule applies to
+type PaymentRuleSalesChannel struct {
+	ID string `json:"id"`
+}
+
+// PaymentRuleSystem is the payment system (method) of a payment rule
+type PaymentRuleSystem struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// PaymentRuleAffiliation is the affiliation (configured provider) that processes or analyzes the payments of a rule
+type PaymentRuleAffiliation struct {
+	Implementation *string `json:"implementation"`
+	AffiliationID  *string `json:"affiliationId"`
+}
+
+// ListPaymentRules lists the payment rules of the account
+func (c *VtexClient) ListPaymentRules(ctx context.Context) ([]PaymentRule, error) {
+	var result []PaymentRule
+	if err := c.Get(ctx, "/api/payments/pvt/rules", &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPaymentRule gets a payment rule by ID
+func (c *VtexClient) GetPaymentRule(ctx context.Context, id string) (*PaymentRule, error) {
+	var result PaymentRule
+	if err := c.Get(ctx, "/api/payments/pvt/rules/"+url.PathEscape(id), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreatePaymentRule creates a payment rule and returns it with its ID
+func (c *VtexClient) CreatePaymentRule(ctx context.Context, rule PaymentRule) (*PaymentRule, error) {
+	var result PaymentRule
+	if err := c.Post(ctx, "/api/payments/pvt/rules", rule, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdatePaymentRule replaces a payment rule and reads it back
+func (c *VtexClient) UpdatePaymentRule(ctx context.Context, id string, rule PaymentRule) (*PaymentRule, error) {
+	rule.ID = id
+	if err := c.Put(ctx, "/api/payments/pvt/rules/"+url.PathEscape(id), rule, nil); err != nil {
+		return nil, err
+	}
+	return c.GetPaymentRule(ctx, id)
+}
+
+// DeletePaymentRule removes a payment rule
+func (c *VtexClient) DeletePaymentRule(ctx context.Context, id string) error {
+	return c.Delete(ctx, "/api/payments/pvt/rules/"+url.PathEscape(id), nil)
+}
+
+// PaymentSystem is a payment method the gateway makes available to the account, like a card brand or a bank slip
+type PaymentSystem struct {
+	ID                int64  `json:"id"`
+	Name              string `json:"name"`
+	GroupName         string `json:"groupName"`
+	Implementation    string `json:"implementation"`
+	IsCustom          bool   `json:"isCustom"`
+	AllowInstallments bool   `json:"allowInstallments"`
+}
+
+// ListPaymentSystems lists the payment systems available to the account
+func (c *VtexClient) ListPaymentSystems(ctx context.Context) ([]PaymentSystem, error) {
+	var result []PaymentSystem
+	if err := c.Get(ctx, "/api/payments/pvt/merchants/payment-systems", &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Affiliation is a configured payment or anti-fraud provider of the gateway
+type Affiliation struct {
+	ID             string                     `json:"id,omitempty"`
+	Implementation string                     `json:"implementation"`
+	Name           string                     `json:"name"`
+	Configuration  []AffiliationConfiguration `json:"configuration"`
+	IsDelivered    bool                       `json:"isdelivered"`
+	IsConfigured   bool                       `json:"isConfigured"`
+}
+
+// AffiliationConfiguration is one setting of an affiliation, like an API key of the provider
+type AffiliationConfiguration struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GetAffiliation gets an affiliation by ID
+func (c *VtexClient) GetAffiliation(ctx context.Context, id string) (*Affiliation, error) {
+	var result Affiliation
+	if err := c.Get(ctx, "/api/payments/pvt/affiliations/"+url.PathEscape(id), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateAffiliation creates an affiliation and returns it with its ID
+func (c *VtexClient) CreateAffiliation(ctx context.Context, affiliation Affiliation) (*Affiliation, error) {
+	var result Affiliation
+	if err := c.Post(ctx, "/api/payments/pvt/affiliations", affiliation, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateAffiliation replaces an affiliation and reads it back
+func (c *VtexClient) UpdateAffiliation(ctx context.Context, id string, affiliation Affiliation) (*Affiliation, error) {
+	affiliation.ID = id
+	if err := c.Put(ctx, "/api/payments/pvt/affiliations/"+url.PathEscape(id), affiliation, nil); err != nil {
+		return nil, err
+	}
+	return c.GetAffiliation(ctx, id)
+}
+
+// DeleteAffiliation removes an affiliation
+func (c *VtexClient) DeleteAffiliation(ctx context.Context, id string) error {
+	return c.Delete(ctx, "/api/payments/pvt/affiliations/"+url.PathEscape(id), nil)
+}
+
+// Custom payment types of the Payments Gateway
+const (
+	CustomPaymentPromissory   = "promissory"
+	CustomPaymentPrivateLabel = "privateLabel"
+	CustomPaymentCobranded    = "cobranded"
+)
+
+// CustomPayment is a payment method defined by the store, like a promissory note for B2B buyers
+// who pay on account, or a private label card
+type CustomPayment struct {
+	ID                    string `json:"id,omitempty"`
+	Name                  string `json:"name"`
+	Description           string `json:"description"`
+	Type                  string `json:"type"`
+	DaysToExpire          int64  `json:"daysToExpire"`
+	RequiresAuthorization bool   `json:"requiresAuthorization"`
+	PaymentSystemID       int64  `json:"paymentSystemId,omitempty"`
+}
+
+// GetCustomPayment gets a custom payment by ID
+func (c *VtexClient) GetCustomPayment(ctx context.Context, id string) (*CustomPayment, error) {
+	var result CustomPayment
+	if err := c.Get(ctx, "/api/payments/pvt/custompayments/"+url.PathEscape(id), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateCustomPayment creates a custom payment and returns it with its ID and payment system
+func (c *VtexClient) CreateCustomPayment(ctx context.Context, payment CustomPayment) (*CustomPayment, error) {
+	var result CustomPayment
+	if err := c.Post(ctx, "/api/payments/pvt/custompayments", payment, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateCustomPayment replaces a custom payment and reads it back
+func (c *VtexClient) UpdateCustomPayment(ctx context.Context, id string, payment CustomPayment) (*CustomPayment, error) {
+	payment.ID = id
+	if err := c.Put(ctx, "/api/payments/pvt/custompayments/"+url.PathEscape(id), payment, nil); err != nil {
+		return nil, err
+	}
+	return c.GetCustomPayment(ctx, id)
+}
+
+// DeleteCustomPayment removes a custom payment
+func (c *VtexClient) DeleteCustomPayment(ctx context.Context, id string) error {
+	return c.Delete(ctx, "/api/payments/pvt/custompayments/"+url.PathEscape(id), nil)
+}
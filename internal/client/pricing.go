@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Price is the price of a SKU in the Pricing API. Nil values are left for the
+// pricing module to compute (e.g. basePrice from costPrice and markup).
+type Price struct {
+	ItemID      string       `json:"itemId,omitempty"`
+	ListPrice   *float64     `json:"listPrice"`
+	CostPrice   *float64     `json:"costPrice"`
+	Markup      *int64       `json:"markup"`
+	BasePrice   *float64     `json:"basePrice"`
+	FixedPrices []FixedPrice `json:"fixedPrices"`
+}
+
+// FixedPrice is a price of a SKU fixed for a trade policy (price table)
+type FixedPrice struct {
+	TradePolicyID string          `json:"tradePolicyId,omitempty"`
+	Value         float64         `json:"value"`
+	ListPrice     *float64        `json:"listPrice"`
+	MinQuantity   int64           `json:"minQuantity"`
+	DateRange     *PriceDateRange `json:"dateRange,omitempty"`
+}
+
+// PriceDateRange is the window in which a fixed price applies
+type PriceDateRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// GetPrice gets the price of a SKU
+func (c *VtexClient) GetPrice(ctx context.Context, skuID int64) (*Price, error) {
+	var result Price
+	if err := c.Get(ctx, fmt.Sprintf("/api/pricing/prices/%d", skuID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PutPrice creates or replaces the price of a SKU, including its fixed prices
+func (c *VtexClient) PutPrice(ctx context.Context, skuID int64, price Price) error {
+	return c.Put(ctx, fmt.Sprintf("/api/pricing/prices/%d", skuID), price, nil)
+}
+
+// DeletePrice deletes the price of a SKU, including its fixed prices
+func (c *VtexClient) DeletePrice(ctx context.Context, skuID int64) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/pricing/prices/%d", skuID), nil)
+}
+
+// ListFixedPrices gets the fixed prices of a SKU in a trade policy
+func (c *VtexClient) ListFixedPrices(ctx context.Context, skuID int64, tradePolicyID string) ([]FixedPrice, error) {
+	var result []FixedPrice
+	if err := c.Get(ctx, fmt.Sprintf("/api/pricing/prices/%d/fixed/%s", skuID, url.PathEscape(tradePolicyID)), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PutFixedPrices creates or updates fixed prices of a SKU in a trade policy.
+// Prices are matched by minimum quantity and date range; other prices of the
+// trade policy are kept.
+func (c *VtexClient) PutFixedPrices(ctx context.Context, skuID int64, tradePolicyID string, prices []FixedPrice) error {
+	return c.Post(ctx, fmt.Sprintf("/api/pricing/prices/%d/fixed/%s", skuID, url.PathEscape(tradePolicyID)), prices, nil)
+}
+
+// DeleteFixedPrices deletes all fixed prices of a SKU in a trade policy
+func (c *VtexClient) DeleteFixedPrices(ctx context.Context, skuID int64, tradePolicyID string) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/pricing/prices/%d/fixed/%s", skuID, url.PathEscape(tradePolicyID)), nil)
+}
+
+// PricingConfig is the account-level configuration of the Pricing module
+type PricingConfig struct {
+	PricingVersion             string                `json:"pricingVersion,omitempty"`
+	DefaultMarkup              int64                 `json:"defaultMarkup"`
+	MinimumMarkup              int64                 `json:"minimumMarkup"`
+	HasMinimumMarkupProtection bool                  `json:"hasMinimumMarkupProtection"`
+	RoundingMode               string                `json:"roundingMode"`
+	PriceVariation             PricingPriceVariation `json:"priceVariation"`
+}
+
+// PricingPriceVariation limits, in percent, how much a price may change in one update
+type PricingPriceVariation struct {
+	UpperLimit float64 `json:"upperLimit"`
+	LowerLimit float64 `json:"lowerLimit"`
+}
+
+// GetPricingConfig gets the pricing configuration of the account
+func (c *VtexClient) GetPricingConfig(ctx context.Context) (*PricingConfig, error) {
+	var result PricingConfig
+	if err := c.Get(ctx, "/api/pricing/config", &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdatePricingConfig replaces the pricing configuration of the account
+func (c *VtexClient) UpdatePricingConfig(ctx context.Context, config PricingConfig) error {
+	return c.Put(ctx, "/api/pricing/config", config, nil)
+}
+
+// PriceRules are the rules that compute the prices of a trade policy (price table) from the base price
+type PriceRules struct {
+	TradePolicyID string      `json:"tradePolicyId,omitempty"`
+	Rules         []PriceRule `json:"rules"`
+}
+
+// PriceRule applies a markup to the base price of the SKUs matching its context
+type PriceRule struct {
+	ID         int64            `json:"id"`
+	Context    PriceRuleContext `json:"context"`
+	Percentual float64          `json:"percentual"`
+}
+
+// PriceRuleContext selects the SKUs a price rule applies to. Categories and
+// brands map IDs to names; empty maps match everything.
+type PriceRuleContext struct {
+	Categories  map[string]string `json:"categories"`
+	Brands      map[string]string `json:"brands"`
+	MarkupRange *PriceRuleRange   `json:"markupRange,omitempty"`
+	DateRange   *PriceDateRange   `json:"dateRange,omitempty"`
+}
+
+// PriceRuleRange is a range of base price markups, in percent
+type PriceRuleRange struct {
+	From float64 `json:"from"`
+	To   float64 `json:"to"`
+}
+
+// GetPriceRules gets the price rules of a trade policy
+func (c *VtexClient) GetPriceRules(ctx context.Context, tradePolicyID string) (*PriceRules, error) {
+	var result PriceRules
+	if err := c.Get(ctx, "/api/pricing/pipeline/catalog/"+url.PathEscape(tradePolicyID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PutPriceRules replaces the price rules of a trade policy
+func (c *VtexClient) PutPriceRules(ctx context.Context, tradePolicyID string, rules PriceRules) error {
+	return c.Put(ctx, "/api/pricing/pipeline/catalog/"+url.PathEscape(tradePolicyID), rules, nil)
+}
+
+// ComputedPrice is the price of a SKU in a trade policy after fixed prices and rules
+type ComputedPrice struct {
+	TradePolicyID   string   `json:"tradePolicyId"`
+	ListPrice       *float64 `json:"listPrice"`
+	CostPrice       *float64 `json:"costPrice"`
+	SellingPrice    float64  `json:"sellingPrice"`
+	PriceValidUntil string   `json:"priceValidUntil"`
+}
+
+// GetComputedPrice computes the price of a SKU in a trade policy for a quantity.
+// The category path and brand of the SKU select the price rules that apply.
+func (c *VtexClient) GetComputedPrice(ctx context.Context, skuID int64, tradePolicyID string, categoryIDs []string, brandID string, quantity int64) (*ComputedPrice, error) {
+	query := url.Values{}
+	for _, categoryID := range categoryIDs {
+		query.Add("categoryIds", categoryID)
+	}
+	if brandID != "" {
+		query.Set("brandId", brandID)
+	}
+	query.Set("quantity", strconv.FormatInt(quantity, 10))
+
+	var result ComputedPrice
+	endpoint := fmt.Sprintf("/api/pricing/prices/%d/computed/%s?%s", skuID, url.PathEscape(tradePolicyID), query.Encode())
+	if err := c.Get(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
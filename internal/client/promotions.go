@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Coupon is a coupon code of the Promotions & Taxes API. Promotions are triggered
+// by its UTM source and campaign.
+type Coupon struct {
+	CouponCode               string `json:"couponCode"`
+	UtmSource                string `json:"utmSource"`
+	UtmCampaign              string `json:"utmCampaign"`
+	IsArchived               bool   `json:"isArchived"`
+	MaxItemsPerClient        int64  `json:"maxItemsPerClient"`
+	ExpirationIntervalPerUse string `json:"expirationIntervalPerUse"`
+}
+
+// couponGenerationRequest asks for coupons with random codes that share a configuration
+type couponGenerationRequest struct {
+	Quantity            int64  `json:"quantity"`
+	CouponConfiguration Coupon `json:"couponConfiguration"`
+}
+
+// GenerateCoupons creates quantity coupons whose codes start with the configured code
+// and returns the generated codes. The request is not retried after a server or network error,
+// since it may have generated a batch nobody would know the codes of.
+func (c *VtexClient) GenerateCoupons(ctx context.Context, quantity int64, configuration Coupon) ([]string, error) {
+	var result []string
+	request := couponGenerationRequest{Quantity: quantity, CouponConfiguration: configuration}
+	if err := c.PostOnce(ctx, "/api/rnb/pvt/coupons", request, &result); err != nil {
+		return nil, err
+	}
+	if int64(len(result)) != quantity {
+		return result, fmt.Errorf("could not generate coupons: asked for %d, got %d", quantity, len(result))
+	}
+	return result, nil
+}
+
+// SaveCoupons creates or updates coupons by code in one call
+func (c *VtexClient) SaveCoupons(ctx context.Context, coupons []Coupon) error {
+	return c.Post(ctx, "/api/rnb/pvt/multiple-coupons", coupons, nil)
+}
+
+// GetCoupon gets a coupon by code
+func (c *VtexClient) GetCoupon(ctx context.Context, code string) (*Coupon, error) {
+	var result Coupon
+	if err := c.Get(ctx, "/api/rnb/pvt/coupon/"+url.PathEscape(code), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CouponUsage is how many orders used a coupon
+type CouponUsage struct {
+	CouponCode string `json:"couponCode"`
+	Usage      int64  `json:"usage"`
+}
+
+// GetCouponUsage gets how many times a coupon was used
+func (c *VtexClient) GetCouponUsage(ctx context.Context, code string) (*CouponUsage, error) {
+	var result CouponUsage
+	if err := c.Get(ctx, "/api/rnb/pvt/coupon/usage/"+url.PathEscape(code), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListCoupons lists the coupons of the account, archived ones included
+func (c *VtexClient) ListCoupons(ctx context.Context) ([]Coupon, error) {
+	var result []Coupon
+	if err := c.Get(ctx, "/api/rnb/pvt/coupon/", &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ArchiveCoupon archives a coupon so it can no longer be used. Coupons cannot be deleted.
+func (c *VtexClient) ArchiveCoupon(ctx context.Context, code string) error {
+	return c.Post(ctx, "/api/rnb/pvt/archive/coupon/"+url.PathEscape(code), nil, nil)
+}
+
+// Tax is a tax of the Promotions & Taxes API, added on top of the price of the items it applies to
+type Tax struct {
+	ID                          string            `json:"idCalculatorConfiguration,omitempty"`
+	Name                        string            `json:"name"`
+	Description                 string            `json:"description"`
+	BeginDateUTC                string            `json:"beginDateUtc"`
+	EndDateUTC                  string            `json:"endDateUtc,omitempty"`
+	IsActive                    bool              `json:"isActive"`
+	IsArchived                  bool              `json:"isArchived"`
+	NominalTax                  float64           `json:"nominalTax"`
+	PercentualTax               float64           `json:"percentualTax"`
+	IDsSalesChannel             []string          `json:"idsSalesChannel"`
+	AreSalesChannelIdsExclusive bool              `json:"areSalesChannelIdsExclusive"`
+	ZipCodeRanges               []TaxZipCodeRange `json:"zipCodeRanges"`
+	Categories                  []PromotionItem   `json:"categories"`
+	CategoriesAreInclusive      bool              `json:"categoriesAreInclusive"`
+}
+
+// TaxZipCodeRange is a range of postal codes a tax applies to (or not, when not inclusive)
+type TaxZipCodeRange struct {
+	ZipCodeFrom string `json:"zipCodeFrom"`
+	ZipCodeTo   string `json:"zipCodeTo"`
+	Inclusive   bool   `json:"inclusive"`
+}
+
+// PromotionItem is a catalog item (category, brand, collection) a promotion or tax is scoped to
+type PromotionItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// GetTax gets a tax by ID
+func (c *VtexClient) GetTax(ctx context.Context, taxID string) (*Tax, error) {
+	var result Tax
+	if err := c.Get(ctx, "/api/rnb/pvt/calculatorconfiguration/"+url.PathEscape(taxID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SaveTax creates a tax, or updates it when it has an ID, and returns it
+func (c *VtexClient) SaveTax(ctx context.Context, tax Tax) (*Tax, error) {
+	var result Tax
+	if err := c.Post(ctx, "/api/rnb/pvt/taxes/calculatorconfiguration", tax, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ArchiveCalculatorConfiguration archives a promotion or tax. They cannot be deleted.
+func (c *VtexClient) ArchiveCalculatorConfiguration(ctx context.Context, id string) error {
+	return c.Post(ctx, "/api/rnb/pvt/archive/calculatorConfiguration/"+url.PathEscape(id), nil, nil)
+}
+
+// Promotion types of the Promotions & Taxes API
+var PromotionTypes = []string{"regular", "combo", "forThePriceOf", "progressive", "buyAndWin", "maxPricesPerItems", "campaign"}
+
+// Promotion is a promotion (benefit) in the promotion list
+type Promotion struct {
+	ID           string `json:"idCalculatorConfiguration"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Type         string `json:"type"`
+	IsActive     bool   `json:"isActive"`
+	IsArchived   bool   `json:"isArchived"`
+	BeginDateUTC string `json:"beginDateUtc"`
+	EndDateUTC   string `json:"endDateUtc"`
+	UtmSource    string `json:"utmSource"`
+	UtmCampaign  string `json:"utmCampaign"`
+}
+
+// promotionsPage is one page of the promotion list
+type promotionsPage struct {
+	Items  []Promotion `json:"items"`
+	Paging struct {
+		Pages int `json:"pages"`
+	} `json:"paging"`
+}
+
+// ListPromotions gets the promotions of the account that are not archived, following pagination
+func (c *VtexClient) ListPromotions(ctx context.Context) ([]Promotion, error) {
+	var promotions []Promotion
+	for page := 1; ; page++ {
+		var result promotionsPage
+		endpoint := fmt.Sprintf("/api/rnb/pvt/benefits/calculatorconfiguration?page=%d&pageSize=100", page)
+		if err := c.Get(ctx, endpoint, &result); err != nil {
+			return nil, err
+		}
+
+		promotions = append(promotions, result.Items...)
+		if page >= result.Paging.Pages || len(result.Items) == 0 {
+			return promotions, nil
+		}
+	}
+}
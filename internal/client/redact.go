@@ -0,0 +1,24 @@
+package client
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Patterns for sensitive data that VTEX and Okta may echo back in response bodies
+var (
+	emailPattern      = regexp.MustCompile(`([A-Za-z0-9._%+\-])[A-Za-z0-9._%+\-]*@([A-Za-z0-9.\-]+\.[A-Za-z]{2,})`)
+	bearerPattern     = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9\-._~+/]+=*`)
+	secretPattern     = regexp.MustCompile(`(?i)("?(?:x-vtex-api-apptoken|x-vtex-api-appkey|apptoken|appkey|app_token|app_key|access_token|client_secret|password|redemptioncode|redemptiontoken)"?\s*[:=]\s*"?)[^"\s,}&]+`)
+	vtexAppKeyPattern = regexp.MustCompile(`vtexappkey-[A-Za-z0-9\-]+`)
+)
+
+// redact masks emails, bearer tokens and app keys so response bodies can go into diagnostics.
+// Emails keep the first letter and the domain (j***@example.com) to help debugging.
+func redact(s string) string {
+	s = bearerPattern.ReplaceAllString(s, "${1}***")
+	s = secretPattern.ReplaceAllString(s, "${1}***")
+	s = vtexAppKeyPattern.ReplaceAllString(s, "vtexappkey-***")
+	s = emailPattern.ReplaceAllString(s, "${1}***@${2}")
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// InternalRoute is an internal route of the rewriter: a storefront path that renders a page or
+// an entity (product, brand, category...) of the store
+type InternalRoute struct {
+	From      string `json:"from"`
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Declarer  string `json:"declarer,omitempty"`
+	ResolveAs string `json:"resolveAs,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+}
+
+// internalRouteFields are the fields of internal routes the GraphQL queries read
+const internalRouteFields = "from type id declarer resolveAs endDate"
+
+// rewriterURL is the address of the rewriter app of an account's workspace
+func rewriterURL(account, workspace string) string {
+	return ioAppURL("vtex.rewriter", 1, account, workspace)
+}
+
+// GetInternalRoute gets the internal route of a path
+func (c *VtexClient) GetInternalRoute(ctx context.Context, account, workspace, path string) (*InternalRoute, error) {
+	var result struct {
+		Internal struct {
+			Get *InternalRoute `json:"get"`
+		} `json:"internal"`
+	}
+	query := "query($path: String!) { internal { get(path: $path) { " + internalRouteFields + " } } }"
+	if err := c.ioGraphQL(ctx, rewriterURL(account, workspace), query, map[string]interface{}{"path": path}, &result); err != nil {
+		return nil, err
+	}
+	if result.Internal.Get == nil {
+		return nil, &APIError{StatusCode: http.StatusNotFound}
+	}
+	return result.Internal.Get, nil
+}
+
+// SaveInternalRoute creates or replaces the internal route of a path and reads it back
+func (c *VtexClient) SaveInternalRoute(ctx context.Context, account, workspace string, route InternalRoute) (*InternalRoute, error) {
+	query := "mutation($route: InternalInput!) { internal { save(route: $route) { " + internalRouteFields + " } } }"
+	if err := c.ioGraphQL(ctx, rewriterURL(account, workspace), query, map[string]interface{}{"route": route}, nil); err != nil {
+		return nil, err
+	}
+	return c.GetInternalRoute(ctx, account, workspace, route.From)
+}
+
+// DeleteInternalRoute removes the internal route of a path
+func (c *VtexClient) DeleteInternalRoute(ctx context.Context, account, workspace, path string) error {
+	query := "mutation($path: String!) { internal { delete(path: $path) { from } } }"
+	return c.ioGraphQL(ctx, rewriterURL(account, workspace), query, map[string]interface{}{"path": path}, nil)
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// intelligentSearchEndpoint is the path of the Intelligent Search API
+const intelligentSearchEndpoint = "/api/io/_v/api/intelligent-search"
+
+// MerchandisingRule changes the results of the searches for some terms: it pins products at the
+// top, hides others and narrows the results with filters, optionally only between two dates
+type MerchandisingRule struct {
+	ID             string                    `json:"id,omitempty"`
+	Name           string                    `json:"name"`
+	Active         bool                      `json:"active"`
+	Terms          []string                  `json:"terms"`
+	PinnedProducts []string                  `json:"pinnedProducts"`
+	HiddenProducts []string                  `json:"hiddenProducts"`
+	Filters        []MerchandisingRuleFilter `json:"filters"`
+	StartDate      string                    `json:"startDate,omitempty"`
+	EndDate        string                    `json:"endDate,omitempty"`
+}
+
+// MerchandisingRuleFilter keeps the results with a value of a facet, e.g. brand acme
+type MerchandisingRuleFilter struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// merchandisingRulesEndpoint is the Intelligent Search API path of the merchandising rules
+func merchandisingRulesEndpoint() string {
+	return intelligentSearchEndpoint + "/merchandising-rules"
+}
+
+// GetMerchandisingRule gets a merchandising rule by ID
+func (c *VtexClient) GetMerchandisingRule(ctx context.Context, ruleID string) (*MerchandisingRule, error) {
+	var result MerchandisingRule
+	if err := c.Get(ctx, merchandisingRulesEndpoint()+"/"+url.PathEscape(ruleID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateMerchandisingRule creates a merchandising rule and reads it back with its ID
+func (c *VtexClient) CreateMerchandisingRule(ctx context.Context, rule MerchandisingRule) (*MerchandisingRule, error) {
+	var created MerchandisingRule
+	if err := c.Post(ctx, merchandisingRulesEndpoint(), rule, &created); err != nil {
+		return nil, err
+	}
+	if created.ID == "" {
+		return nil, fmt.Errorf("could not create merchandising rule: the response has no rule ID")
+	}
+	return c.GetMerchandisingRule(ctx, created.ID)
+}
+
+// UpdateMerchandisingRule replaces a merchandising rule and reads it back
+func (c *VtexClient) UpdateMerchandisingRule(ctx context.Context, ruleID string, rule MerchandisingRule) (*MerchandisingRule, error) {
+	rule.ID = ruleID
+	if err := c.Put(ctx, merchandisingRulesEndpoint()+"/"+url.PathEscape(ruleID), rule, nil); err != nil {
+		return nil, err
+	}
+	return c.GetMerchandisingRule(ctx, ruleID)
+}
+
+// DeleteMerchandisingRule deletes a merchandising rule
+func (c *VtexClient) DeleteMerchandisingRule(ctx context.Context, ruleID string) error {
+	return c.Delete(ctx, merchandisingRulesEndpoint()+"/"+url.PathEscape(ruleID), nil)
+}
+
+// SearchRedirect sends the searches for a query to a page instead of the search results
+type SearchRedirect struct {
+	ID     string `json:"id,omitempty"`
+	Query  string `json:"query"`
+	URL    string `json:"url"`
+	Active bool   `json:"active"`
+}
+
+// searchRedirectsEndpoint is the Intelligent Search API path of the redirects
+func searchRedirectsEndpoint() string {
+	return intelligentSearchEndpoint + "/redirects"
+}
+
+// GetSearchRedirect gets a search redirect by ID
+func (c *VtexClient) GetSearchRedirect(ctx context.Context, redirectID string) (*SearchRedirect, error) {
+	var result SearchRedirect
+	if err := c.Get(ctx, searchRedirectsEndpoint()+"/"+url.PathEscape(redirectID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateSearchRedirect creates a search redirect and reads it back with its ID
+func (c *VtexClient) CreateSearchRedirect(ctx context.Context, redirect SearchRedirect) (*SearchRedirect, error) {
+	var created SearchRedirect
+	if err := c.Post(ctx, searchRedirectsEndpoint(), redirect, &created); err != nil {
+		return nil, err
+	}
+	if created.ID == "" {
+		return nil, fmt.Errorf("could not create search redirect: the response has no redirect ID")
+	}
+	return c.GetSearchRedirect(ctx, created.ID)
+}
+
+// UpdateSearchRedirect replaces a search redirect and reads it back
+func (c *VtexClient) UpdateSearchRedirect(ctx context.Context, redirectID string, redirect SearchRedirect) (*SearchRedirect, error) {
+	redirect.ID = redirectID
+	if err := c.Put(ctx, searchRedirectsEndpoint()+"/"+url.PathEscape(redirectID), redirect, nil); err != nil {
+		return nil, err
+	}
+	return c.GetSearchRedirect(ctx, redirectID)
+}
+
+// DeleteSearchRedirect deletes a search redirect
+func (c *VtexClient) DeleteSearchRedirect(ctx context.Context, redirectID string) error {
+	return c.Delete(ctx, searchRedirectsEndpoint()+"/"+url.PathEscape(redirectID), nil)
+}
+
+// Types of search synonyms
+const (
+	// SearchSynonymBidirectional synonyms are all equivalent: searching for any finds the others
+	SearchSynonymBidirectional = "bidirectional"
+	// SearchSynonymUnidirectional synonyms are found when searching for the main term, not the other way around
+	SearchSynonymUnidirectional = "unidirectional"
+)
+
+// SearchSynonym is a set of search terms Intelligent Search treats as the same
+type SearchSynonym struct {
+	ID       string   `json:"id"`
+	Type     string   `json:"type"`
+	Term     string   `json:"term,omitempty"`
+	Synonyms []string `json:"synonyms"`
+}
+
+// searchSynonymsPage is one page of the synonyms list
+type searchSynonymsPage struct {
+	Items  []SearchSynonym `json:"items"`
+	Paging struct {
+		Pages int `json:"pages"`
+	} `json:"paging"`
+}
+
+// ListSearchSynonyms gets every synonym set, following pagination
+func (c *VtexClient) ListSearchSynonyms(ctx context.Context) ([]SearchSynonym, error) {
+	var synonyms []SearchSynonym
+	for page := 1; ; page++ {
+		var result searchSynonymsPage
+		if err := c.Get(ctx, fmt.Sprintf("%s/synonyms?page=%d&pageSize=100", intelligentSearchEndpoint, page), &result); err != nil {
+			return nil, err
+		}
+
+		synonyms = append(synonyms, result.Items...)
+		if page >= result.Paging.Pages || len(result.Items) == 0 {
+			return synonyms, nil
+		}
+	}
+}
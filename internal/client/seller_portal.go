@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// SellerPortalProduct is a product of the Seller Portal catalog (Catalog API v2),
+// which keeps SKUs, specifications and images inside the product
+type SellerPortalProduct struct {
+	ID          string                  `json:"id,omitempty"`
+	ExternalID  string                  `json:"externalId,omitempty"`
+	Status      string                  `json:"status"`
+	Name        string                  `json:"name"`
+	BrandID     string                  `json:"brandId"`
+	CategoryIDs []string                `json:"categoryIds"`
+	Slug        string                  `json:"slug,omitempty"`
+	Specs       []SellerPortalSpec      `json:"specs"`
+	Attributes  []SellerPortalAttribute `json:"attributes"`
+	Images      []SellerPortalImage     `json:"images"`
+	SKUs        []SellerPortalSKU       `json:"skus"`
+}
+
+// SellerPortalSpec is a product specification with its allowed SKU values
+type SellerPortalSpec struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// SellerPortalAttribute is a free text product attribute
+type SellerPortalAttribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SellerPortalImage is a product image that SKUs refer to by ID
+type SellerPortalImage struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	Alt string `json:"alt,omitempty"`
+}
+
+// SellerPortalSKU is a SKU of a Seller Portal product
+type SellerPortalSKU struct {
+	ID         string                 `json:"id,omitempty"`
+	ExternalID string                 `json:"externalId"`
+	Name       string                 `json:"name"`
+	EAN        string                 `json:"ean,omitempty"`
+	IsActive   bool                   `json:"isActive"`
+	Weight     float64                `json:"weight"`
+	Dimensions SellerPortalDimensions `json:"dimensions"`
+	Specs      []SellerPortalSKUSpec  `json:"specs"`
+	Images     []string               `json:"images"`
+}
+
+// SellerPortalDimensions are the package dimensions of a SKU
+type SellerPortalDimensions struct {
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Length float64 `json:"length"`
+}
+
+// SellerPortalSKUSpec is the value of a product specification for a SKU
+type SellerPortalSKUSpec struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CreateSellerPortalProduct creates a product with its SKUs
+func (c *VtexClient) CreateSellerPortalProduct(ctx context.Context, product SellerPortalProduct) (*SellerPortalProduct, error) {
+	var result SellerPortalProduct
+	if err := c.Post(ctx, "/api/catalog-seller-portal/products", product, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSellerPortalProduct gets a product with its SKUs
+func (c *VtexClient) GetSellerPortalProduct(ctx context.Context, productID string) (*SellerPortalProduct, error) {
+	var result SellerPortalProduct
+	if err := c.Get(ctx, "/api/catalog-seller-portal/products/"+url.PathEscape(productID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSellerPortalProduct replaces a product with its SKUs
+func (c *VtexClient) UpdateSellerPortalProduct(ctx context.Context, productID string, product SellerPortalProduct) (*SellerPortalProduct, error) {
+	var result SellerPortalProduct
+	if err := c.Put(ctx, "/api/catalog-seller-portal/products/"+url.PathEscape(productID), product, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+)
+
+func TestSKUSpreadsheet(t *testing.T) {
+	content, err := skuSpreadsheet([]int64{1001, 42})
+	if err != nil {
+		t.Fatalf("skuSpreadsheet returned error: %s", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("spreadsheet is not a zip archive: %s", err)
+	}
+
+	parts := map[string][]byte{}
+	for _, file := range archive.File {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts[file.Name], err = io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml"} {
+		data, ok := parts[name]
+		if !ok {
+			t.Errorf("spreadsheet has no %s", name)
+			continue
+		}
+		if err := xml.Unmarshal(data, new(interface{})); err != nil {
+			t.Errorf("%s is not valid XML: %s", name, err)
+		}
+	}
+
+	var sheet struct {
+		Rows []struct {
+			Cells []struct {
+				Ref    string `xml:"r,attr"`
+				Value  string `xml:"v"`
+				Inline string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := xml.Unmarshal(parts["xl/worksheets/sheet1.xml"], &sheet); err != nil {
+		t.Fatalf("could not read the sheet: %s", err)
+	}
+
+	want := [][2]string{{"A1", "SKU"}, {"A2", "1001"}, {"A3", "42"}}
+	if len(sheet.Rows) != len(want) {
+		t.Fatalf("sheet has %d rows, want %d", len(sheet.Rows), len(want))
+	}
+	for i, row := range sheet.Rows {
+		if len(row.Cells) != 1 {
+			t.Fatalf("row %d has %d cells, want 1", i+1, len(row.Cells))
+		}
+		cell := row.Cells[0]
+		if got := cell.Value + cell.Inline; cell.Ref != want[i][0] || got != want[i][1] {
+			t.Errorf("row %d = %s %q, want %s %q", i+1, cell.Ref, got, want[i][0], want[i][1])
+		}
+	}
+}
+
+func TestFilePayloadEncode(t *testing.T) {
+	body, contentType, err := filePayload{field: "file", fileName: "skus.xlsx", content: []byte("data")}.encode()
+	if err != nil {
+		t.Fatalf("encode returned error: %s", err)
+	}
+	if !bytes.HasPrefix([]byte(contentType), []byte("multipart/form-data; boundary=")) {
+		t.Errorf("content type = %q, want multipart/form-data", contentType)
+	}
+	for _, want := range []string{`name="file"`, `filename="skus.xlsx"`, "data"} {
+		if !bytes.Contains(body, []byte(want)) {
+			t.Errorf("body has no %s:\n%s", want, body)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// SLA options of subscription orders
+const (
+	SubscriptionSLANone           = "NONE"
+	SubscriptionSLACheapest       = "CHEAPEST"
+	SubscriptionSLACustomerChoice = "CUSTOMER_CHOICE"
+)
+
+// SubscriptionSettings are the Subscriptions settings of the account. Every field is listed,
+// so saving the settings does not reset the ones the provider does not manage.
+type SubscriptionSettings struct {
+	SLAOption                               string          `json:"slaOption"`
+	DefaultSLA                              *string         `json:"defaultSla"`
+	IsUsingV3                               bool            `json:"isUsingV3"`
+	OnMigrationProcess                      bool            `json:"onMigrationProcess"`
+	ExecutionHourInUTC                      int64           `json:"executionHourInUtc"`
+	WorkflowVersion                         string          `json:"workflowVersion,omitempty"`
+	DeliveryChannels                        []string        `json:"deliveryChannels"`
+	RandomIDGeneration                      bool            `json:"randomIdGeneration"`
+	IsMultipleInstallmentsEnabledOnCreation bool            `json:"isMultipleInstallmentsEnabledOnCreation"`
+	IsMultipleInstallmentsEnabledOnUpdate   bool            `json:"isMultipleInstallmentsEnabledOnUpdate"`
+	AttachmentPreferences                   json.RawMessage `json:"attachmentPreferences,omitempty"`
+	OrderCustomDataAppID                    *string         `json:"orderCustomDataAppId"`
+	PostponeExpiration                      bool            `json:"postponeExpiration"`
+	ManualPriceAllowed                      bool            `json:"manualPriceAllowed"`
+	UseItemPriceFromOriginalOrder           bool            `json:"useItemPriceFromOriginalOrder"`
+}
+
+// Attachment of a subscription plan: its name prefix and the fields Subscriptions reads
+const (
+	SubscriptionPlanPrefix         = "vtex.subscription."
+	SubscriptionFrequencyField     = "vtex.subscription.key.frequency"
+	SubscriptionPurchaseDayField   = "vtex.subscription.key.purchaseday"
+	SubscriptionFieldMaxCharacters = "15"
+)
+
+// GetSubscriptionSettings gets the Subscriptions settings of the account
+func (c *VtexClient) GetSubscriptionSettings(ctx context.Context) (*SubscriptionSettings, error) {
+	var result SubscriptionSettings
+	if err := c.Get(ctx, "/api/rns/pvt/settings", &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSubscriptionSettings replaces the Subscriptions settings of the account and reads them back
+func (c *VtexClient) UpdateSubscriptionSettings(ctx context.Context, settings SubscriptionSettings) (*SubscriptionSettings, error) {
+	if err := c.Post(ctx, "/api/rns/pvt/settings", settings, nil); err != nil {
+		return nil, err
+	}
+	return c.GetSubscriptionSettings(ctx)
+}
+
+// Statuses of a subscription
+var SubscriptionStatuses = []string{"ACTIVE", "PAUSED", "CANCELED", "EXPIRED", "MISSING"}
+
+// Subscription is a subscription (v3) of a shopper
+type Subscription struct {
+	ID               string               `json:"id"`
+	CustomerEmail    string               `json:"customerEmail"`
+	Title            string               `json:"title"`
+	Status           string               `json:"status"`
+	IsSkipped        bool                 `json:"isSkipped"`
+	NextPurchaseDate string               `json:"nextPurchaseDate"`
+	LastPurchaseDate string               `json:"lastPurchaseDate"`
+	CreatedAt        string               `json:"createdAt"`
+	Plan             SubscriptionPlan     `json:"plan"`
+	PurchaseSettings SubscriptionPurchase `json:"purchaseSettings"`
+	Items            []SubscriptionItem   `json:"items"`
+}
+
+// SubscriptionPlan is the plan of a subscription: how often and on which day it is purchased
+type SubscriptionPlan struct {
+	ID          string                `json:"id"`
+	Frequency   SubscriptionFrequency `json:"frequency"`
+	PurchaseDay string                `json:"purchaseDay"`
+}
+
+// SubscriptionFrequency is the frequency of a subscription, e.g. interval 2 and periodicity WEEKLY
+type SubscriptionFrequency struct {
+	Periodicity string `json:"periodicity"`
+	Interval    int64  `json:"interval"`
+}
+
+// SubscriptionPurchase are the purchase settings of a subscription
+type SubscriptionPurchase struct {
+	SalesChannel string `json:"salesChannel"`
+}
+
+// SubscriptionItem is a SKU of a subscription
+type SubscriptionItem struct {
+	SKUID    string `json:"skuId"`
+	Quantity int64  `json:"quantity"`
+	Status   string `json:"status"`
+}
+
+// SubscriptionSearch selects the subscriptions ListSubscriptions returns. Zero values do not filter.
+type SubscriptionSearch struct {
+	CustomerEmail string
+	Status        string
+}
+
+// ListSubscriptions lists up to limit subscriptions matching the search, following pagination
+func (c *VtexClient) ListSubscriptions(ctx context.Context, search SubscriptionSearch, limit int) ([]Subscription, error) {
+	query := url.Values{}
+	if search.CustomerEmail != "" {
+		query.Set("customerEmail", search.CustomerEmail)
+	}
+	if search.Status != "" {
+		query.Set("status", search.Status)
+	}
+
+	size := 100
+	if limit < size {
+		size = limit
+	}
+	query.Set("size", strconv.Itoa(size))
+
+	var subscriptions []Subscription
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		var result []Subscription
+		if err := c.Get(ctx, "/api/rns/pub/subscriptions?"+query.Encode(), &result); err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, result...)
+
+		if len(subscriptions) >= limit {
+			return subscriptions[:limit], nil
+		}
+		if len(result) < size {
+			return subscriptions, nil
+		}
+	}
+}
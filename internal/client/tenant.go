@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// BindingTargetStorefront is the target product of store bindings
+const BindingTargetStorefront = "vtex-storefront"
+
+// Binding ties an address (host and optional path) of the account to a storefront,
+// with the locales and currencies it serves and the sales channel it sells in
+type Binding struct {
+	ID                     string              `json:"id,omitempty"`
+	CanonicalBaseAddress   string              `json:"canonicalBaseAddress"`
+	AlternateBaseAddresses []string            `json:"alternateBaseAddresses"`
+	DefaultLocale          string              `json:"defaultLocale"`
+	SupportedLocales       []string            `json:"supportedLocales"`
+	DefaultCurrency        string              `json:"defaultCurrency"`
+	SupportedCurrencies    []string            `json:"supportedCurrencies"`
+	TargetProduct          string              `json:"targetProduct"`
+	ExtraContext           BindingExtraContext `json:"extraContext"`
+}
+
+// BindingExtraContext holds the product specific settings of a binding
+type BindingExtraContext struct {
+	Portal *BindingPortal `json:"portal,omitempty"`
+}
+
+// BindingPortal are the storefront settings of a binding
+type BindingPortal struct {
+	SalesChannel string `json:"salesChannel"`
+}
+
+// bindingsEndpoint is the Tenant API path of the bindings of an account
+func bindingsEndpoint(account string) string {
+	return fmt.Sprintf("/api/tenant/tenants/%s/bindings", url.PathEscape(account))
+}
+
+// GetBinding gets a binding of an account
+func (c *VtexClient) GetBinding(ctx context.Context, account, bindingID string) (*Binding, error) {
+	var result Binding
+	if err := c.Get(ctx, bindingsEndpoint(account)+"/"+url.PathEscape(bindingID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateBinding creates a binding in an account and reads it back with its ID
+func (c *VtexClient) CreateBinding(ctx context.Context, account string, binding Binding) (*Binding, error) {
+	var created Binding
+	if err := c.Post(ctx, bindingsEndpoint(account), binding, &created); err != nil {
+		return nil, err
+	}
+	if created.ID == "" {
+		return nil, fmt.Errorf("could not create binding: the response has no binding ID")
+	}
+	return c.GetBinding(ctx, account, created.ID)
+}
+
+// UpdateBinding replaces a binding of an account
+func (c *VtexClient) UpdateBinding(ctx context.Context, account, bindingID string, binding Binding) (*Binding, error) {
+	binding.ID = bindingID
+	if err := c.Put(ctx, bindingsEndpoint(account)+"/"+url.PathEscape(bindingID), binding, nil); err != nil {
+		return nil, err
+	}
+	return c.GetBinding(ctx, account, bindingID)
+}
+
+// DeleteBinding removes a binding from an account
+func (c *VtexClient) DeleteBinding(ctx context.Context, account, bindingID string) error {
+	return c.Delete(ctx, bindingsEndpoint(account)+"/"+url.PathEscape(bindingID), nil)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"sync"
+)
+
+// apiCallConcurrency is how many calls forEachConcurrently runs at the same time.
+// The client already waits and retries on 429, so this only bounds the burst.
+const apiCallConcurrency = 8
+
+// forEachConcurrently calls fn for every ID with at most apiCallConcurrency calls at
+// the same time, and returns the first error as fn returned it. The ctx passed to fn
+// is canceled after that error, so the calls still running stop early.
+func forEachConcurrently(ctx context.Context, ids []int64, fn func(context.Context, int64) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	slots := make(chan struct{}, apiCallConcurrency)
+
+	for _, id := range ids {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(id int64) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if err := fn(ctx, id); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(id)
+	}
+
+	wg.Wait()
+	if firstErr == nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return firstErr
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	ids := make([]int64, 50)
+	for i := range ids {
+		ids[i] = int64(i)
+	}
+
+	var (
+		mu      sync.Mutex
+		seen    = map[int64]bool{}
+		running int32
+		peak    int32
+	)
+	err := forEachConcurrently(context.Background(), ids, func(ctx context.Context, id int64) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		mu.Lock()
+		defer mu.Unlock()
+		seen[id] = true
+		if current > peak {
+			peak = current
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("forEachConcurrently returned error: %s", err)
+	}
+	if len(seen) != len(ids) {
+		t.Errorf("fn was called for %d IDs, want %d", len(seen), len(ids))
+	}
+	if peak > apiCallConcurrency {
+		t.Errorf("%d calls ran at the same time, want at most %d", peak, apiCallConcurrency)
+	}
+}
+
+func TestForEachConcurrentlyReturnsFirstError(t *testing.T) {
+	failure := errors.New("SKU 3: failed")
+
+	err := forEachConcurrently(context.Background(), []int64{1, 2, 3, 4}, func(ctx context.Context, id int64) error {
+		if id == 3 {
+			return failure
+		}
+		if id == 4 {
+			// Only returns once the error cancels the ctx of fn
+			<-ctx.Done()
+		}
+		return nil
+	})
+	if err != failure {
+		t.Errorf("forEachConcurrently error = %v, want %v unchanged", err, failure)
+	}
+}
+
+func TestForEachConcurrentlyCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int32
+	err := forEachConcurrently(ctx, []int64{1, 2, 3}, func(ctx context.Context, id int64) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("forEachConcurrently error = %v, want context.Canceled", err)
+	}
+	if calls != 0 {
+		t.Errorf("fn was called %d times, want 0", calls)
+	}
+}
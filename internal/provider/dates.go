@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vtexDateTimeLayout is the date format of the VTEX APIs (no time zone, UTC)
+const vtexDateTimeLayout = "2006-01-02T15:04:05"
+
+// dateTimeLayouts are the date formats accepted by dateTimeValidator
+var dateTimeLayouts = []string{time.RFC3339, vtexDateTimeLayout}
+
+// parseDateTime parses a date in one of dateTimeLayouts
+func parseDateTime(value string) (time.Time, error) {
+	var err error
+	for _, layout := range dateTimeLayouts {
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// formatDateTime converts a configured date into the VTEX API format
+func formatDateTime(value string) string {
+	parsed, err := parseDateTime(value)
+	if err != nil {
+		return value
+	}
+	return parsed.UTC().Format(vtexDateTimeLayout)
+}
+
+// formatDateTimeRFC3339 converts a configured date into RFC 3339 in UTC, the format of the Pricing API
+func formatDateTimeRFC3339(value string) string {
+	if value == "" {
+		return ""
+	}
+	parsed, err := parseDateTime(value)
+	if err != nil {
+		return value
+	}
+	return parsed.UTC().Format(time.RFC3339)
+}
+
+// dateTimeValue returns the API date, or the current value when both are the same instant,
+// so the format written in the configuration does not show as a diff
+func dateTimeValue(apiValue string, current types.String) types.String {
+	if apiValue == "" {
+		return types.StringNull()
+	}
+
+	if !current.IsNull() && !current.IsUnknown() {
+		a, errA := parseDateTime(apiValue)
+		b, errB := parseDateTime(current.ValueString())
+		if errA == nil && errB == nil && a.Equal(b) {
+			return current
+		}
+	}
+	return types.StringValue(apiValue)
+}
+
+// formatTimeSpan converts a duration into the .NET time span format of the
+// Logistics API, like 1.02:30:00 for one day, two hours and a half
+func formatTimeSpan(d time.Duration) string {
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	value := fmt.Sprintf("%02d:%02d:%02d", d/time.Hour, d%time.Hour/time.Minute, d%time.Minute/time.Second)
+	if days > 0 {
+		value = fmt.Sprintf("%d.%s", days, value)
+	}
+	return value
+}
+
+// parseTimeSpan parses a .NET time span like 1.02:30:00 or 02:30:00
+func parseTimeSpan(value string) (time.Duration, error) {
+	var days int64
+	clock := value
+	if dot := strings.Index(value, "."); dot >= 0 && dot < strings.Index(value, ":") {
+		var err error
+		if days, err = strconv.ParseInt(value[:dot], 10, 64); err != nil {
+			return 0, fmt.Errorf("invalid time span %q", value)
+		}
+		clock = value[dot+1:]
+	}
+
+	parts := strings.Split(clock, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time span %q", value)
+	}
+	hours, errH := strconv.ParseInt(parts[0], 10, 64)
+	minutes, errM := strconv.ParseInt(parts[1], 10, 64)
+	seconds, errS := strconv.ParseFloat(parts[2], 64)
+	if errH != nil || errM != nil || errS != nil {
+		return 0, fmt.Errorf("invalid time span %q", value)
+	}
+
+	return time.Duration(days)*24*time.Hour + time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute + time.Duration(seconds*float64(time.Second)), nil
+}
+
+// timeSpanValue returns the API time span as a Go duration, or the current value
+// when both are the same length, so the notation in the configuration does not show as a diff
+func timeSpanValue(apiValue string, current types.String) types.String {
+	parsed, err := parseTimeSpan(apiValue)
+	if err != nil {
+		return types.StringValue(apiValue)
+	}
+
+	if !current.IsNull() && !current.IsUnknown() {
+		if configured, err := time.ParseDuration(current.ValueString()); err == nil && configured == parsed {
+			return current
+		}
+	}
+	return types.StringValue(parsed.String())
+}
+
+// timeOfDayLayouts are the clock formats accepted by timeOfDayValidator
+var timeOfDayLayouts = []string{"15:04", "15:04:05"}
+
+// parseTimeOfDay parses a clock time like 08:30 or 08:30:00
+func parseTimeOfDay(value string) (time.Time, error) {
+	var err error
+	for _, layout := range timeOfDayLayouts {
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// timeOfDayValue returns the API clock time, or the current value when both
+// are the same time, so 08:30 in the configuration does not diff with 08:30:00
+func timeOfDayValue(apiValue string, current types.String) types.String {
+	if !current.IsNull() && !current.IsUnknown() {
+		configured, errConfigured := parseTimeOfDay(current.ValueString())
+		parsed, errParsed := parseTimeOfDay(apiValue)
+		if errConfigured == nil && errParsed == nil && configured.Equal(parsed) {
+			return current
+		}
+	}
+	return types.StringValue(apiValue)
+}
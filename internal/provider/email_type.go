@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Check that email types satisfy framework interfaces
+var _ basetypes.StringTypable = EmailType{}
+var _ basetypes.StringValuableWithSemanticEquals = EmailValue{}
+
+// EmailType is a string type where emails that only differ in case or spaces are equal
+type EmailType struct {
+	basetypes.StringType
+}
+
+func (t EmailType) String() string {
+	return "EmailType"
+}
+
+func (t EmailType) ValueType(ctx context.Context) attr.Value {
+	return EmailValue{}
+}
+
+func (t EmailType) Equal(o attr.Type) bool {
+	other, ok := o.(EmailType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t EmailType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return EmailValue{StringValue: in}, nil
+}
+
+func (t EmailType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// EmailValue is an email attribute value, see EmailType
+type EmailValue struct {
+	basetypes.StringValue
+}
+
+func (v EmailValue) Type(ctx context.Context) attr.Type {
+	return EmailType{}
+}
+
+func (v EmailValue) Equal(o attr.Value) bool {
+	other, ok := o.(EmailValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals keeps the configured email in state when the API returns it in another case
+func (v EmailValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(EmailValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return normalizeEmail(v.ValueString()) == normalizeEmail(newValue.ValueString()), diags
+}
+
+// NormalizedValue returns the lowercased and trimmed email sent to the API
+func (v EmailValue) NormalizedValue() string {
+	return normalizeEmail(v.ValueString())
+}
+
+// NewEmailValue creates a known EmailValue
+func NewEmailValue(value string) EmailValue {
+	return EmailValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// normalizeEmail lowercases and trims an email
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// emailRequiresReplace replaces the resource when the email changes, but not for case or spaces changes
+func emailRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = normalizeEmail(req.StateValue.ValueString()) != normalizeEmail(req.PlanValue.ValueString())
+		},
+		"Changing the email (ignoring case and spaces) requires replacing the resource.",
+		"Changing the email (ignoring case and spaces) requires replacing the resource.",
+	)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats of the files read by the bulk resources
+const (
+	fileFormatCSV  = "csv"
+	fileFormatJSON = "json"
+	fileFormatYAML = "yaml"
+)
+
+// fileSourceFormats are the formats fileSource accepts
+var fileSourceFormats = []string{fileFormatCSV, fileFormatJSON, fileFormatYAML}
+
+// fileSource returns the content of a path or inline content attribute and its format.
+// Without an explicit format it is taken from the path extension, else csv. YAML is
+// converted to JSON, so callers only parse csv and json.
+func fileSource(filePath, content, format types.String) ([]byte, string, error) {
+	fileFormat := format.ValueString()
+
+	var data []byte
+	if filePath.IsNull() {
+		if fileFormat == "" {
+			fileFormat = fileFormatCSV
+		}
+		data = []byte(content.ValueString())
+	} else {
+		name := filePath.ValueString()
+		if fileFormat == "" {
+			switch strings.ToLower(filepath.Ext(name)) {
+			case ".json":
+				fileFormat = fileFormatJSON
+			case ".yaml", ".yml":
+				fileFormat = fileFormatYAML
+			default:
+				fileFormat = fileFormatCSV
+			}
+		}
+
+		var err error
+		data, err = os.ReadFile(name)
+		if err != nil {
+			return nil, "", fmt.Errorf("could not read %s: %w", name, err)
+		}
+	}
+
+	if fileFormat == fileFormatYAML {
+		converted, err := yamlToJSON(data)
+		if err != nil {
+			return nil, "", err
+		}
+		return converted, fileFormatJSON, nil
+	}
+	return data, fileFormat, nil
+}
+
+// yamlToJSON converts a YAML document to JSON
+func yamlToJSON(content []byte) ([]byte, error) {
+	var document interface{}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("could not read YAML: %w", err)
+	}
+
+	return json.Marshal(jsonCompatible(document))
+}
+
+// jsonCompatible turns the YAML maps with non-string keys (like 1: or true:) into
+// maps with string keys, which is all JSON objects can have
+func jsonCompatible(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = jsonCompatible(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[fmt.Sprint(key)] = jsonCompatible(item)
+		}
+		return result
+	case []interface{}:
+		for i, item := range v {
+			v[i] = jsonCompatible(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// csvColumnName normalizes a CSV header cell, dropping the byte order mark spreadsheet exports add
+func csvColumnName(column string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+}
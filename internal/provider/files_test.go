@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFileSource(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) types.String {
+		t.Helper()
+		filePath := filepath.Join(dir, name)
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return types.StringValue(filePath)
+	}
+
+	tests := []struct {
+		name       string
+		path       types.String
+		content    types.String
+		format     types.String
+		wantData   string
+		wantFormat string
+	}{
+		{
+			name:       "inline content defaults to csv",
+			path:       types.StringNull(),
+			content:    types.StringValue("email,role_name\n"),
+			format:     types.StringNull(),
+			wantData:   "email,role_name\n",
+			wantFormat: fileFormatCSV,
+		},
+		{
+			name:       "json extension",
+			path:       write("users.json", `[]`),
+			content:    types.StringNull(),
+			format:     types.StringNull(),
+			wantData:   `[]`,
+			wantFormat: fileFormatJSON,
+		},
+		{
+			name:       "yaml extension is converted to json",
+			path:       write("users.yaml", "- email: a@example.com\n  roles: [Owner]\n"),
+			content:    types.StringNull(),
+			format:     types.StringNull(),
+			wantData:   `[{"email":"a@example.com","roles":["Owner"]}]`,
+			wantFormat: fileFormatJSON,
+		},
+		{
+			name:       "yml extension is converted to json",
+			path:       write("users.YML", "- email: a@example.com\n"),
+			content:    types.StringNull(),
+			format:     types.StringNull(),
+			wantData:   `[{"email":"a@example.com"}]`,
+			wantFormat: fileFormatJSON,
+		},
+		{
+			name:       "inline yaml with explicit format",
+			path:       types.StringNull(),
+			content:    types.StringValue("- email: a@example.com\n"),
+			format:     types.StringValue(fileFormatYAML),
+			wantData:   `[{"email":"a@example.com"}]`,
+			wantFormat: fileFormatJSON,
+		},
+		{
+			name:       "explicit format wins over the extension",
+			path:       write("users.txt", `[]`),
+			content:    types.StringNull(),
+			format:     types.StringValue(fileFormatJSON),
+			wantData:   `[]`,
+			wantFormat: fileFormatJSON,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, format, err := fileSource(test.path, test.content, test.format)
+			if err != nil {
+				t.Fatalf("fileSource returned error: %s", err)
+			}
+			if string(data) != test.wantData {
+				t.Errorf("data = %s, want %s", data, test.wantData)
+			}
+			if format != test.wantFormat {
+				t.Errorf("format = %q, want %q", format, test.wantFormat)
+			}
+		})
+	}
+}
+
+func TestFileSourceMissingFile(t *testing.T) {
+	missing := types.StringValue(filepath.Join(t.TempDir(), "missing.csv"))
+	if _, _, err := fileSource(missing, types.StringNull(), types.StringNull()); err == nil {
+		t.Error("fileSource returned no error for a missing file")
+	}
+}
+
+func TestYAMLToJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "list of objects",
+			input: "- email: a@example.com\n  role_name: Owner\n",
+			want:  `[{"email":"a@example.com","role_name":"Owner"}]`,
+		},
+		{
+			name:  "numbers and booleans keep their type",
+			input: "- price: 10.5\n  active: true\n",
+			want:  `[{"active":true,"price":10.5}]`,
+		},
+		{
+			name:  "non-string keys become strings",
+			input: "1: one\ntrue: yes\nnested:\n  2: two\n",
+			want:  `{"1":"one","nested":{"2":"two"},"true":"yes"}`,
+		},
+		{
+			name:  "empty document",
+			input: "",
+			want:  `null`,
+		},
+		{
+			name:    "invalid yaml",
+			input:   "- email: [a@example.com\n",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := yamlToJSON([]byte(test.input))
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("yamlToJSON(%q) = %s, want an error", test.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("yamlToJSON(%q) returned error: %s", test.input, err)
+			}
+			if string(got) != test.want {
+				t.Errorf("yamlToJSON(%q) = %s, want %s", test.input, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// idEscaper escapes the separator (and the escape char itself) inside ID parts.
+// IDs whose parts have no ":" or "%" are the same as the old plain format.
+var idEscaper = strings.NewReplacer("%", "%25", ":", "%3A")
+
+// encodeID joins parts into a composite ID like email:account:role_name
+func encodeID(parts ...string) string {
+	escaped := make([]string, len(parts))
+	for i, part := range parts {
+		escaped[i] = idEscaper.Replace(part)
+	}
+	return strings.Join(escaped, ":")
+}
+
+// decodeID splits a composite ID made by encodeID back into its parts
+func decodeID(id string) ([]string, error) {
+	parts := strings.Split(id, ":")
+	for i, part := range parts {
+		unescaped, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid escaping in ID part %q: %w", part, err)
+		}
+		parts[i] = unescaped
+	}
+	return parts, nil
+}
+
+// parseNumericID parses a numeric VTEX ID kept as a string in state or given on import
+func parseNumericID(id string) (int64, error) {
+	value, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected a numeric ID, got: %q", id)
+	}
+	return value, nil
+}
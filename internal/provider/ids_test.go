@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEncodeID(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []string
+		want  string
+	}{
+		{
+			name:  "plain parts keep the old format",
+			parts: []string{"john@example.com", "vendor", "Owner"},
+			want:  "john@example.com:vendor:Owner",
+		},
+		{
+			name:  "colon in a part is escaped",
+			parts: []string{"john@example.com", "vendor", "Sales: Admin"},
+			want:  "john@example.com:vendor:Sales%3A Admin",
+		},
+		{
+			name:  "percent in a part is escaped",
+			parts: []string{"vendor", "100% off"},
+			want:  "vendor:100%25 off",
+		},
+		{
+			name:  "escaped sequence in a part is escaped again",
+			parts: []string{"a%3Ab"},
+			want:  "a%253Ab",
+		},
+		{
+			name:  "empty parts are kept",
+			parts: []string{"", "master", ""},
+			want:  ":master:",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := encodeID(test.parts...); got != test.want {
+				t.Errorf("encodeID(%q) = %q, want %q", test.parts, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "old plain format",
+			id:   "john@example.com:vendor:Owner",
+			want: []string{"john@example.com", "vendor", "Owner"},
+		},
+		{
+			name: "escaped colon",
+			id:   "john@example.com:vendor:Sales%3A Admin",
+			want: []string{"john@example.com", "vendor", "Sales: Admin"},
+		},
+		{
+			name: "escaped percent",
+			id:   "vendor:100%25 off",
+			want: []string{"vendor", "100% off"},
+		},
+		{
+			name: "single part",
+			id:   "123",
+			want: []string{"123"},
+		},
+		{
+			name:    "invalid escaping",
+			id:      "vendor:100% off",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := decodeID(test.id)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("decodeID(%q) = %q, want an error", test.id, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodeID(%q) returned error: %s", test.id, err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("decodeID(%q) = %q, want %q", test.id, got, test.want)
+			}
+		})
+	}
+}
+
+func TestEncodeDecodeIDRoundTrip(t *testing.T) {
+	for _, parts := range [][]string{
+		{"john@example.com", "vendor", "Owner"},
+		{"a:b", "c%d", "e%3Af", "::", "%%"},
+		{"vendor", "master", "/black-friday?x=1:2"},
+	} {
+		got, err := decodeID(encodeID(parts...))
+		if err != nil {
+			t.Fatalf("decodeID(encodeID(%q)) returned error: %s", parts, err)
+		}
+		if !reflect.DeepEqual(got, parts) {
+			t.Errorf("decodeID(encodeID(%q)) = %q", parts, got)
+		}
+	}
+}
+
+func TestParseNumericID(t *testing.T) {
+	if got, err := parseNumericID("42"); err != nil || got != 42 {
+		t.Errorf("parseNumericID(\"42\") = %d, %v, want 42, nil", got, err)
+	}
+	for _, id := range []string{"", "abc", "4.2", "1:2"} {
+		if _, err := parseNumericID(id); err == nil {
+			t.Errorf("parseNumericID(%q) returned no error", id)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// semanticJSONModifier keeps the state value of a JSON attribute when the configured document
+// only differs in formatting or key order, so reformatting it does not show as a diff
+type semanticJSONModifier struct{}
+
+func (m semanticJSONModifier) Description(ctx context.Context) string {
+	return "ignores formatting and key order changes of the JSON document"
+}
+
+func (m semanticJSONModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m semanticJSONModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planned, err := normalizeJSON(req.PlanValue.ValueString())
+	if err != nil {
+		return
+	}
+	current, err := normalizeJSON(req.StateValue.ValueString())
+	if err != nil {
+		return
+	}
+	if planned == current {
+		resp.PlanValue = req.StateValue
+	}
+}
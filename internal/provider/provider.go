@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/davispalomino/terraform-provider-vtex/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,12 +23,20 @@ type VtexProvider struct {
 
 // VtexProviderModel is the provider data model
 type VtexProviderModel struct {
-	VtexBaseURL    types.String `tfsdk:"vtex_base_url"`
-	OktaURL        types.String `tfsdk:"okta_url"`
-	OktaClientID   types.String `tfsdk:"okta_client_id"`
-	OktaSecret     types.String `tfsdk:"okta_secret"`
-	OktaGrantType  types.String `tfsdk:"okta_grant_type"`
-	OktaScope      types.String `tfsdk:"okta_scope"`
+	VtexBaseURL   types.String `tfsdk:"vtex_base_url"`
+	OktaURL       types.String `tfsdk:"okta_url"`
+	OktaClientID  types.String `tfsdk:"okta_client_id"`
+	OktaSecret    types.String `tfsdk:"okta_secret"`
+	OktaGrantType types.String `tfsdk:"okta_grant_type"`
+	OktaScope     types.String `tfsdk:"okta_scope"`
+	VtexAppKey    types.String `tfsdk:"vtex_app_key"`
+	VtexAppToken  types.String `tfsdk:"vtex_app_token"`
+
+	HTTPMaxIdleConns        types.Int64  `tfsdk:"http_max_idle_conns"`
+	HTTPMaxIdleConnsPerHost types.Int64  `tfsdk:"http_max_idle_conns_per_host"`
+	HTTPIdleConnTimeout     types.String `tfsdk:"http_idle_conn_timeout"`
+	HTTPDialTimeout         types.String `tfsdk:"http_dial_timeout"`
+	HTTP2Enabled            types.Bool   `tfsdk:"http2_enabled"`
 }
 
 func New(version string) func() provider.Provider {
@@ -44,33 +54,63 @@ func (p *VtexProvider) Metadata(ctx context.Context, req provider.MetadataReques
 
 func (p *VtexProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Provider to manage users and roles in VTEX. IMPORTANT: You must install a VTEX Apps Service to use this provider. Without it, the provider will not work.",
+		Description: "Provider to manage VTEX accounts. IMPORTANT: vtex_user_role and vtex_user_roles need a VTEX Apps Service installed in the account and Okta settings. Other resources use the native VTEX APIs with an app key and token.",
 		Attributes: map[string]schema.Attribute{
 			"vtex_base_url": schema.StringAttribute{
 				Description: "VTEX base URL (e.g. https://vendor.myvtex.com)",
 				Required:    true,
 			},
 			"okta_url": schema.StringAttribute{
-				Description: "Okta OAuth2 endpoint URL to get tokens",
-				Required:    true,
+				Description: "Okta OAuth2 endpoint URL to get tokens. Required for the Apps Service resources (vtex_user_role, vtex_user_roles)",
+				Optional:    true,
 			},
 			"okta_client_id": schema.StringAttribute{
 				Description: "Okta Client ID (ACCESS_KEY)",
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 			},
 			"okta_secret": schema.StringAttribute{
 				Description: "Okta Client Secret (SECRET_KEY)",
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 			},
 			"okta_grant_type": schema.StringAttribute{
 				Description: "OAuth2 grant type (e.g. authorization_code)",
-				Required:    true,
+				Optional:    true,
 			},
 			"okta_scope": schema.StringAttribute{
 				Description: "OAuth2 scope (e.g. scope_vendor)",
-				Required:    true,
+				Optional:    true,
+			},
+			"vtex_app_key": schema.StringAttribute{
+				Description: "VTEX app key for the native VTEX APIs (X-VTEX-API-AppKey)",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"vtex_app_token": schema.StringAttribute{
+				Description: "VTEX app token for the native VTEX APIs (X-VTEX-API-AppToken)",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"http_max_idle_conns": schema.Int64Attribute{
+				Description: "Maximum number of idle (keep-alive) connections (default 100)",
+				Optional:    true,
+			},
+			"http_max_idle_conns_per_host": schema.Int64Attribute{
+				Description: "Maximum number of idle (keep-alive) connections per host (default 100)",
+				Optional:    true,
+			},
+			"http_idle_conn_timeout": schema.StringAttribute{
+				Description: "How long an idle connection is kept open, as a Go duration (default 90s)",
+				Optional:    true,
+			},
+			"http_dial_timeout": schema.StringAttribute{
+				Description: "Timeout to open new connections, as a Go duration (default 30s)",
+				Optional:    true,
+			},
+			"http2_enabled": schema.BoolAttribute{
+				Description: "Use HTTP/2 when the server supports it (default true)",
+				Optional:    true,
 			},
 		},
 	}
@@ -85,6 +125,84 @@ func (p *VtexProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	// Okta settings go together, and without them an app key and token are needed
+	oktaAttributes := map[string]types.String{
+		"okta_url":        config.OktaURL,
+		"okta_client_id":  config.OktaClientID,
+		"okta_secret":     config.OktaSecret,
+		"okta_grant_type": config.OktaGrantType,
+		"okta_scope":      config.OktaScope,
+	}
+	oktaSet := 0
+	for _, value := range oktaAttributes {
+		if value.ValueString() != "" {
+			oktaSet++
+		}
+	}
+	if oktaSet > 0 && oktaSet < len(oktaAttributes) {
+		resp.Diagnostics.AddError(
+			"Incomplete Okta Configuration",
+			"okta_url, okta_client_id, okta_secret, okta_grant_type and okta_scope must be set together.",
+		)
+	}
+
+	if (config.VtexAppKey.ValueString() == "") != (config.VtexAppToken.ValueString() == "") {
+		resp.Diagnostics.AddError(
+			"Incomplete VTEX App Key Configuration",
+			"vtex_app_key and vtex_app_token must be set together.",
+		)
+	}
+
+	if oktaSet == 0 && config.VtexAppKey.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Missing VTEX Credentials",
+			"Set the okta_* attributes (Apps Service resources) or vtex_app_key and vtex_app_token (native VTEX APIs), or both.",
+		)
+	}
+
+	// HTTP transport settings, unset values keep the defaults
+	transport := client.DefaultTransportConfig()
+
+	if !config.HTTPMaxIdleConns.IsNull() {
+		transport.MaxIdleConns = int(config.HTTPMaxIdleConns.ValueInt64())
+	}
+
+	if !config.HTTPMaxIdleConnsPerHost.IsNull() {
+		transport.MaxIdleConnsPerHost = int(config.HTTPMaxIdleConnsPerHost.ValueInt64())
+	}
+
+	if !config.HTTPIdleConnTimeout.IsNull() {
+		timeout, err := time.ParseDuration(config.HTTPIdleConnTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_idle_conn_timeout"),
+				"Invalid HTTP Idle Connection Timeout",
+				"Expected a duration like 90s or 2m, got: "+config.HTTPIdleConnTimeout.ValueString(),
+			)
+		}
+		transport.IdleConnTimeout = timeout
+	}
+
+	if !config.HTTPDialTimeout.IsNull() {
+		timeout, err := time.ParseDuration(config.HTTPDialTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_dial_timeout"),
+				"Invalid HTTP Dial Timeout",
+				"Expected a duration like 30s or 1m, got: "+config.HTTPDialTimeout.ValueString(),
+			)
+		}
+		transport.DialTimeout = timeout
+	}
+
+	if !config.HTTP2Enabled.IsNull() {
+		transport.DisableHTTP2 = !config.HTTP2Enabled.ValueBool()
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create VTEX client
 	vtexClient, err := client.NewVtexClient(
 		config.VtexBaseURL.ValueString(),
@@ -93,6 +211,9 @@ func (p *VtexProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		config.OktaSecret.ValueString(),
 		config.OktaGrantType.ValueString(),
 		config.OktaScope.ValueString(),
+		config.VtexAppKey.ValueString(),
+		config.VtexAppToken.ValueString(),
+		transport,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -111,11 +232,93 @@ func (p *VtexProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 func (p *VtexProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewVtexUserRoleResource,
+		NewVtexUserRolesResource,
+		NewVtexUserResource,
+		NewVtexRoleResource,
+		NewVtexUserRoleFileResource,
+		NewVtexCategoryResource,
+		NewVtexSpecificationGroupResource,
+		NewVtexSpecificationFieldResource,
+		NewVtexProductSpecificationResource,
+		NewVtexCollectionResource,
+		NewVtexCollectionItemsResource,
+		NewVtexSKUAttachmentResource,
+		NewVtexSKUServiceTypeResource,
+		NewVtexSKUServiceValueResource,
+		NewVtexSKUServiceResource,
+		NewVtexCatalogImportResource,
+		NewVtexSellerPortalProductResource,
+		NewVtexPriceResource,
+		NewVtexFixedPriceResource,
+		NewVtexPricingConfigResource,
+		NewVtexPriceRuleResource,
+		NewVtexDockResource,
+		NewVtexFreightTableResource,
+		NewVtexInventoryResource,
+		NewVtexPickupPointResource,
+		NewVtexHolidayResource,
+		NewVtexGeoShapeResource,
+		NewVtexShippingStrategyResource,
+		NewVtexInventoryReservationSettingsResource,
+		NewVtexSalesChannelResource,
+		NewVtexStoreBindingResource,
+		NewVtexCouponBatchResource,
+		NewVtexTaxRuleResource,
+		NewVtexCustomPaymentResource,
+		NewVtexPaymentRuleResource,
+		NewVtexAntifraudAffiliationResource,
+		NewVtexOrdersHookResource,
+		NewVtexGiftCardProviderResource,
+		NewVtexSubscriptionSettingsResource,
+		NewVtexAffiliateResource,
+		NewVtexMasterDataSchemaResource,
+		NewVtexMasterDataDocumentResource,
+		NewVtexMasterDataEntityACLResource,
+		NewVtexCheckoutSettingsResource,
+		NewVtexCheckoutCustomFieldResource,
+		NewVtexIOAppResource,
+		NewVtexIOAppSettingsResource,
+		NewVtexIOWorkspaceResource,
+		NewVtexIOEditionResource,
+		NewVtexRouteResource,
+		NewVtexSearchMerchandisingRuleResource,
+		NewVtexSearchRedirectResource,
 	}
 }
 
 func (p *VtexProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// Add data sources here in the future
+		NewVtexRoleDataSource,
+		NewVtexUserDataSource,
+		NewVtexUserPermissionsDataSource,
+		NewVtexProductDataSource,
+		NewVtexSKUByEANDataSource,
+		NewVtexCollectionsDataSource,
+		NewVtexSpecificationsDataSource,
+		NewVtexCatalogExportDataSource,
+		NewVtexSKUFilesDataSource,
+		NewVtexPriceDataSource,
+		NewVtexPriceSimulationDataSource,
+		NewVtexWarehousesDataSource,
+		NewVtexDocksDataSource,
+		NewVtexPickupPointsDataSource,
+		NewVtexShippingSimulationDataSource,
+		NewVtexHolidaysDataSource,
+		NewVtexFreightRatesDataSource,
+		NewVtexSalesChannelsDataSource,
+		NewVtexTradePolicyDataSource,
+		NewVtexPromotionsDataSource,
+		NewVtexCouponDataSource,
+		NewVtexPaymentMethodsDataSource,
+		NewVtexOrderDataSource,
+		NewVtexOrdersDataSource,
+		NewVtexGiftCardsDataSource,
+		NewVtexSubscriptionsDataSource,
+		NewVtexMasterDataSearchDataSource,
+		NewVtexMasterDataDocumentDataSource,
+		NewVtexIOAppsDataSource,
+		NewVtexIOWorkspacesDataSource,
+		NewVtexIOAppSettingsDataSource,
+		NewVtexSearchSynonymsDataSource,
 	}
 }
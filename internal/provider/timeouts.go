@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// defaultTimeout is used when the timeouts block does not set an operation.
+// It is longer than the full retry sequence, so only stuck backends hit it.
+const defaultTimeout = 20 * time.Minute
+
+// TimeoutsModel is the data model of the timeouts block
+type TimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// timeoutsAttrTypes are the attribute types of the timeouts block, for null values
+var timeoutsAttrTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
+
+// timeoutsBlock returns the standard timeouts { create read update delete } block
+func timeoutsBlock() schema.Block {
+	attribute := func(operation string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Time limit for %s, as a Go duration like 2m or 30s (default %s)", operation, defaultTimeout),
+			Validators: []validator.String{
+				durationValidator{},
+			},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "Operation time limits. Retries stop when the limit is reached",
+		Attributes: map[string]schema.Attribute{
+			"create": attribute("create"),
+			"read":   attribute("read"),
+			"update": attribute("update"),
+			"delete": attribute("delete"),
+		},
+	}
+}
+
+// withTimeout returns a context with the deadline configured for the operation
+func withTimeout(ctx context.Context, timeouts types.Object, operation string) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	timeout := defaultTimeout
+	var diags diag.Diagnostics
+
+	if !timeouts.IsNull() && !timeouts.IsUnknown() {
+		var model TimeoutsModel
+		diags.Append(timeouts.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+		value := map[string]types.String{
+			"create": model.Create,
+			"read":   model.Read,
+			"update": model.Update,
+			"delete": model.Delete,
+		}[operation]
+
+		if !value.IsNull() && !value.IsUnknown() {
+			parsed, err := time.ParseDuration(value.ValueString())
+			if err != nil {
+				diags.AddError(
+					"Invalid Timeout",
+					fmt.Sprintf("Could not parse %s timeout %q: %s", operation, value.ValueString(), err.Error()),
+				)
+			} else {
+				timeout = parsed
+			}
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, diags
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// VTEX account names are lowercase letters and digits, starting with a letter
+var accountNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// Codes used by trade policies
+var (
+	countryCodePattern  = regexp.MustCompile(`^[A-Z]{3}$`)
+	currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	cultureInfoPattern  = regexp.MustCompile(`^[a-z]{2,3}-[A-Z]{2}$`)
+)
+
+// Coupon codes are letters, digits, dashes and underscores
+var couponCodePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// Store addresses are a lowercase host with an optional path, without scheme
+var storeAddressPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+(/[A-Za-z0-9._~-]+)*$`)
+
+// emailValidator checks that a string is a plain email address (no display name)
+type emailValidator struct{}
+
+func (v emailValidator) Description(ctx context.Context) string {
+	return "value must be a valid email address"
+}
+
+func (v emailValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v emailValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !validEmail(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Email",
+			fmt.Sprintf("Expected a valid email address like user@example.com, got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// validEmail reports whether the value (ignoring surrounding spaces) is a bare email address
+func validEmail(value string) bool {
+	value = strings.TrimSpace(value)
+	addr, err := mail.ParseAddress(value)
+	return err == nil && addr.Address == value
+}
+
+// accountNameValidator checks the VTEX account name character rules
+type accountNameValidator struct{}
+
+func (v accountNameValidator) Description(ctx context.Context) string {
+	return "value must be a VTEX account name (lowercase letters and digits, starting with a letter)"
+}
+
+func (v accountNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v accountNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !accountNamePattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid VTEX Account Name",
+			fmt.Sprintf("Account names only have lowercase letters and digits and start with a letter (e.g. vendor), got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// stringPatternValidator checks that a string matches a pattern, such as a country code
+type stringPatternValidator struct {
+	pattern *regexp.Regexp
+	name    string
+	example string
+}
+
+func (v stringPatternValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a %s like %s", v.name, v.example)
+}
+
+func (v stringPatternValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringPatternValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !v.pattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("Expected a %s like %s, got: %q", v.name, v.example, req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// stringLengthValidator checks that a trimmed string is not blank and has at most max characters
+type stringLengthValidator struct {
+	max int
+}
+
+func (v stringLengthValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must not be blank and have at most %d characters", v.max)
+}
+
+func (v stringLengthValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringLengthValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := strings.TrimSpace(req.ConfigValue.ValueString())
+	if value == "" || utf8.RuneCountInString(value) > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value Length",
+			fmt.Sprintf("Expected a non-blank value with at most %d characters, got: %q", v.max, req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// setElementsValidator runs a string validator on every element of a set of strings
+type setElementsValidator struct {
+	inner validator.String
+}
+
+func (v setElementsValidator) Description(ctx context.Context) string {
+	return "every element: " + v.inner.Description(ctx)
+}
+
+func (v setElementsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v setElementsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, elem := range req.ConfigValue.Elements() {
+		value, ok := elem.(types.String)
+		if !ok {
+			continue
+		}
+
+		elemResp := &validator.StringResponse{}
+		v.inner.ValidateString(ctx, validator.StringRequest{
+			Path:           req.Path.AtSetValue(elem),
+			PathExpression: req.PathExpression,
+			Config:         req.Config,
+			ConfigValue:    value,
+		}, elemResp)
+		resp.Diagnostics.Append(elemResp.Diagnostics...)
+	}
+}
+
+// stringOneOfValidator checks that a string is one of the allowed values
+type stringOneOfValidator struct {
+	values []string
+}
+
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, value := range v.values {
+		if req.ConfigValue.ValueString() == value {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Value",
+		fmt.Sprintf("Expected one of %s, got: %q", strings.Join(v.values, ", "), req.ConfigValue.ValueString()),
+	)
+}
+
+// durationValidator checks that a string is a Go duration
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a duration like 2m or 30s"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a duration like 2m or 30s, got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// dateTimeValidator checks that a string is an RFC 3339 date like 2024-11-29T00:00:00Z
+type dateTimeValidator struct{}
+
+func (v dateTimeValidator) Description(ctx context.Context) string {
+	return "value must be an RFC 3339 date like 2024-11-29T00:00:00Z"
+}
+
+func (v dateTimeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dateTimeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseDateTime(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Date",
+			fmt.Sprintf("Expected an RFC 3339 date like 2024-11-29T00:00:00Z, got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// timeOfDayValidator checks that a string is a clock time like 08:30 or 08:30:00
+type timeOfDayValidator struct{}
+
+func (v timeOfDayValidator) Description(ctx context.Context) string {
+	return "value must be a clock time like 08:30 or 08:30:00"
+}
+
+func (v timeOfDayValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeOfDayValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseTimeOfDay(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time",
+			fmt.Sprintf("Expected a clock time like 08:30 or 08:30:00, got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// geoJSONValidator checks that a string is a GeoJSON Polygon or MultiPolygon with closed rings
+type geoJSONValidator struct{}
+
+func (v geoJSONValidator) Description(ctx context.Context) string {
+	return "value must be a GeoJSON Polygon or MultiPolygon"
+}
+
+func (v geoJSONValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v geoJSONValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseGeoShape(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid GeoJSON",
+			err.Error(),
+		)
+	}
+}
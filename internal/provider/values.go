@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionalString returns the API value, or null when the API value is empty and the current value is null
+func optionalString(apiValue string, current types.String) types.String {
+	if apiValue == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(apiValue)
+}
+
+// optionalStringSet returns the API values as a set, or null when there are none and the current value is null
+func optionalStringSet(ctx context.Context, apiValues []string, current types.Set) (types.Set, diag.Diagnostics) {
+	if len(apiValues) == 0 && current.IsNull() {
+		return types.SetNull(types.StringType), nil
+	}
+	if apiValues == nil {
+		apiValues = []string{}
+	}
+	return types.SetValueFrom(ctx, types.StringType, apiValues)
+}
+
+// stringValues converts API strings into a list of string values, empty when there are none
+func stringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
+
+// normalizeJSON re-encodes a JSON document with sorted keys and no whitespace, so documents
+// that differ only in formatting or key order compare equal
+func normalizeJSON(value string) (string, error) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(value), &document); err != nil {
+		return "", err
+	}
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "keys are sorted",
+			input: `{"b":1,"a":2}`,
+			want:  `{"a":2,"b":1}`,
+		},
+		{
+			name:  "whitespace is removed",
+			input: "{\n  \"name\": \"John\",\n  \"tags\": [ \"a\", \"b\" ]\n}\n",
+			want:  `{"name":"John","tags":["a","b"]}`,
+		},
+		{
+			name:  "nested objects are sorted",
+			input: `{"z":{"y":true,"x":null}}`,
+			want:  `{"z":{"x":null,"y":true}}`,
+		},
+		{
+			name:  "list order is kept",
+			input: `[3, 1, 2]`,
+			want:  `[3,1,2]`,
+		},
+		{
+			name:  "equivalent numbers are the same",
+			input: `{"price":1.50,"stock":1e2}`,
+			want:  `{"price":1.5,"stock":100}`,
+		},
+		{
+			name:  "scalar document",
+			input: ` "text" `,
+			want:  `"text"`,
+		},
+		{
+			name:    "invalid json",
+			input:   `{"name":`,
+			wantErr: true,
+		},
+		{
+			name:    "empty string",
+			input:   ``,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := normalizeJSON(test.input)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeJSON(%q) = %q, want an error", test.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeJSON(%q) returned error: %s", test.input, err)
+			}
+			if got != test.want {
+				t.Errorf("normalizeJSON(%q) = %q, want %q", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestOptionalString(t *testing.T) {
+	tests := []struct {
+		name     string
+		apiValue string
+		current  types.String
+		want     types.String
+	}{
+		{
+			name:     "empty API value keeps null",
+			apiValue: "",
+			current:  types.StringNull(),
+			want:     types.StringNull(),
+		},
+		{
+			name:     "empty API value clears a set value",
+			apiValue: "",
+			current:  types.StringValue("old"),
+			want:     types.StringValue(""),
+		},
+		{
+			name:     "API value wins over null",
+			apiValue: "new",
+			current:  types.StringNull(),
+			want:     types.StringValue("new"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := optionalString(test.apiValue, test.current); !got.Equal(test.want) {
+				t.Errorf("optionalString(%q, %s) = %s, want %s", test.apiValue, test.current, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexAffiliateResource{}
+var _ resource.ResourceWithImportState = &VtexAffiliateResource{}
+var _ resource.ResourceWithValidateConfig = &VtexAffiliateResource{}
+
+// affiliateIDPattern matches an affiliate ID, three uppercase consonants like MKP
+var affiliateIDPattern = regexp.MustCompile(`^[BCDFGHJKLMNPQRSTVWXYZ]{3}$`)
+
+func NewVtexAffiliateResource() resource.Resource {
+	return &VtexAffiliateResource{}
+}
+
+// VtexAffiliateResource is the resource implementation
+type VtexAffiliateResource struct {
+	client *client.VtexClient
+}
+
+// VtexAffiliateResourceModel is the resource data model
+type VtexAffiliateResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	AffiliateID            types.String `tfsdk:"affiliate_id"`
+	Name                   types.String `tfsdk:"name"`
+	SalesChannel           types.String `tfsdk:"sales_channel"`
+	FollowUpEmail          types.String `tfsdk:"follow_up_email"`
+	SearchEndpoint         types.String `tfsdk:"search_endpoint"`
+	UseSellerPaymentMethod types.Bool   `tfsdk:"use_seller_payment_method"`
+}
+
+func (r *VtexAffiliateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_affiliate"
+}
+
+func (r *VtexAffiliateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an order integration affiliate: an external marketplace connector that sends orders to the account under a trade policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Affiliate ID, same as affiliate_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"affiliate_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Three uppercase consonants that identify the affiliate in orders, e.g. MKP. Changing it creates a new affiliate",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringPatternValidator{pattern: affiliateIDPattern, name: "three uppercase consonants affiliate ID", example: "MKP"},
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the affiliate",
+			},
+			"sales_channel": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the trade policy of the affiliate's orders",
+			},
+			"follow_up_email": schema.StringAttribute{
+				Required:    true,
+				Description: "Email notified of the affiliate's orders",
+				Validators: []validator.String{
+					emailValidator{},
+				},
+			},
+			"search_endpoint": schema.StringAttribute{
+				Required:    true,
+				Description: "HTTPS URL of the affiliate the catalog notifies of price and inventory changes",
+			},
+			"use_seller_payment_method": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the account processes the payments of the affiliate's orders, instead of the affiliate",
+			},
+		},
+	}
+}
+
+func (r *VtexAffiliateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexAffiliateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexAffiliateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.SearchEndpoint) {
+		if u, err := url.Parse(data.SearchEndpoint.ValueString()); err != nil || u.Scheme != "https" || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("search_endpoint"),
+				"Invalid Search Endpoint",
+				fmt.Sprintf("Expected an https:// URL, got: %q", data.SearchEndpoint.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *VtexAffiliateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexAffiliateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX affiliate", map[string]interface{}{
+		"affiliate_id":  data.AffiliateID.ValueString(),
+		"sales_channel": data.SalesChannel.ValueString(),
+	})
+
+	result, err := r.client.SaveAffiliate(ctx, affiliateFromModel(data, data.AffiliateID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Affiliate",
+			"Could not create affiliate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	affiliateToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX affiliate", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexAffiliateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexAffiliateResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX affiliate", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	affiliate, err := r.client.GetAffiliate(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX affiliate not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Affiliate",
+			"Could not read affiliate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	affiliateToModel(affiliate, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexAffiliateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexAffiliateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX affiliate", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.SaveAffiliate(ctx, affiliateFromModel(data, data.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Affiliate",
+			"Could not update affiliate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	affiliateToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexAffiliateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexAffiliateResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX affiliate", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteAffiliate(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Affiliate",
+			"Could not delete affiliate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX affiliate", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexAffiliateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: affiliate ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// affiliateFromModel builds the API payload from the resource model
+func affiliateFromModel(data VtexAffiliateResourceModel, affiliateID string) client.Affiliate {
+	return client.Affiliate{
+		ID:                     affiliateID,
+		Name:                   data.Name.ValueString(),
+		SalesChannel:           data.SalesChannel.ValueString(),
+		FollowUpEmail:          data.FollowUpEmail.ValueString(),
+		SearchURIEndpoint:      data.SearchEndpoint.ValueString(),
+		UseSellerPaymentMethod: data.UseSellerPaymentMethod.ValueBool(),
+	}
+}
+
+// affiliateToModel copies an API affiliate into the resource model
+func affiliateToModel(affiliate *client.Affiliate, data *VtexAffiliateResourceModel) {
+	data.ID = types.StringValue(affiliate.ID)
+	data.AffiliateID = types.StringValue(affiliate.ID)
+	data.Name = types.StringValue(affiliate.Name)
+	data.SalesChannel = types.StringValue(affiliate.SalesChannel)
+	data.FollowUpEmail = types.StringValue(affiliate.FollowUpEmail)
+	data.SearchEndpoint = types.StringValue(affiliate.SearchURIEndpoint)
+	data.UseSellerPaymentMethod = types.BoolValue(affiliate.UseSellerPaymentMethod)
+}
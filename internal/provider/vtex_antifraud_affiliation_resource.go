@@ -0,0 +1,453 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexAntifraudAffiliationResource{}
+var _ resource.ResourceWithImportState = &VtexAntifraudAffiliationResource{}
+var _ resource.ResourceWithValidateConfig = &VtexAntifraudAffiliationResource{}
+
+func NewVtexAntifraudAffiliationResource() resource.Resource {
+	return &VtexAntifraudAffiliationResource{}
+}
+
+// VtexAntifraudAffiliationResource is the resource implementation
+type VtexAntifraudAffiliationResource struct {
+	client *client.VtexClient
+}
+
+// VtexAntifraudAffiliationResourceModel is the resource data model
+type VtexAntifraudAffiliationResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Implementation      types.String `tfsdk:"implementation"`
+	Configuration       types.Map    `tfsdk:"configuration"`
+	SecretConfiguration types.Map    `tfsdk:"secret_configuration"`
+	PaymentRuleIDs      types.Set    `tfsdk:"payment_rule_ids"`
+}
+
+func (r *VtexAntifraudAffiliationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_antifraud_affiliation"
+}
+
+func (r *VtexAntifraudAffiliationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an anti-fraud affiliation of the Payments Gateway and the payment rules whose payments it analyzes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Affiliation ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Affiliation name shown in the gateway admin",
+			},
+			"implementation": schema.StringAttribute{
+				Required:    true,
+				Description: "Connector of the anti-fraud provider, e.g. Vtex.PaymentGateway.Connectors.PaymentProvider.PaymentProviderConnector. Changing it creates a new affiliation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configuration": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Settings of the provider by name, read back from the gateway",
+			},
+			"secret_configuration": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Secret settings of the provider by name, like API keys. The gateway masks them, so they are not read back",
+			},
+			"payment_rule_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the payment rules whose payments the affiliation analyzes. Do not also set antifraud_affiliation_id on those vtex_payment_rule resources",
+			},
+		},
+	}
+}
+
+func (r *VtexAntifraudAffiliationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexAntifraudAffiliationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexAntifraudAffiliationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Unknown maps are checked again at apply time
+	if resp.Diagnostics.HasError() || !isKnown(data.Configuration) || !isKnown(data.SecretConfiguration) {
+		return
+	}
+
+	for name := range data.Configuration.Elements() {
+		if _, ok := data.SecretConfiguration.Elements()[name]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("secret_configuration"),
+				"Duplicate Affiliation Setting",
+				fmt.Sprintf("Setting %q is in both configuration and secret_configuration.", name),
+			)
+		}
+	}
+}
+
+func (r *VtexAntifraudAffiliationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexAntifraudAffiliationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	affiliation, diags := affiliationFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	ruleIDs, diags := paymentRuleIDs(ctx, data.PaymentRuleIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX anti-fraud affiliation", map[string]interface{}{
+		"name":           affiliation.Name,
+		"implementation": affiliation.Implementation,
+	})
+
+	result, err := r.client.CreateAffiliation(ctx, affiliation)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Anti-fraud Affiliation",
+			"Could not create anti-fraud affiliation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(affiliationToModel(ctx, result, &data)...)
+
+	// Save the affiliation before linking the rules, so a failed link does not orphan it
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.linkPaymentRules(ctx, result, ruleIDs, nil); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("payment_rule_ids"),
+			"Error Creating VTEX Anti-fraud Affiliation",
+			"Could not link payment rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created VTEX anti-fraud affiliation", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexAntifraudAffiliationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexAntifraudAffiliationResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX anti-fraud affiliation", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	affiliation, err := r.client.GetAffiliation(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX anti-fraud affiliation not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Anti-fraud Affiliation",
+			"Could not read anti-fraud affiliation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(affiliationToModel(ctx, affiliation, &data)...)
+
+	// The linked rules are only tracked when the configuration manages them
+	if !data.PaymentRuleIDs.IsNull() {
+		rules, err := r.client.ListPaymentRules(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX Anti-fraud Affiliation",
+				"Could not list payment rules, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		linked := []string{}
+		for _, rule := range rules {
+			if rule.Antifraud.AffiliationID != nil && *rule.Antifraud.AffiliationID == affiliation.ID {
+				linked = append(linked, rule.ID)
+			}
+		}
+		set, diags := types.SetValueFrom(ctx, types.StringType, linked)
+		resp.Diagnostics.Append(diags...)
+		data.PaymentRuleIDs = set
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexAntifraudAffiliationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexAntifraudAffiliationResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	affiliation, diags := affiliationFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	ruleIDs, diags := paymentRuleIDs(ctx, plan.PaymentRuleIDs)
+	resp.Diagnostics.Append(diags...)
+	previousRuleIDs, diags := paymentRuleIDs(ctx, state.PaymentRuleIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX anti-fraud affiliation", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	result, err := r.client.UpdateAffiliation(ctx, state.ID.ValueString(), affiliation)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Anti-fraud Affiliation",
+			"Could not update anti-fraud affiliation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if err := r.linkPaymentRules(ctx, result, ruleIDs, previousRuleIDs); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("payment_rule_ids"),
+			"Error Updating VTEX Anti-fraud Affiliation",
+			"Could not link payment rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(affiliationToModel(ctx, result, &plan)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexAntifraudAffiliationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexAntifraudAffiliationResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousRuleIDs, diags := paymentRuleIDs(ctx, data.PaymentRuleIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX anti-fraud affiliation", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Unlink the rules first, the gateway does not delete affiliations that rules still use
+	affiliation := &client.Affiliation{ID: data.ID.ValueString()}
+	if err := r.linkPaymentRules(ctx, affiliation, nil, previousRuleIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Anti-fraud Affiliation",
+			"Could not unlink payment rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err := r.client.DeleteAffiliation(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Anti-fraud Affiliation",
+			"Could not delete anti-fraud affiliation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX anti-fraud affiliation", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexAntifraudAffiliationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: affiliation ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// linkPaymentRules points the anti-fraud of the wanted rules to the affiliation, and clears it
+// on the previous rules that are no longer wanted and still point to it
+func (r *VtexAntifraudAffiliationResource) linkPaymentRules(ctx context.Context, affiliation *client.Affiliation, wanted, previous []string) error {
+	for _, ruleID := range wanted {
+		rule, err := r.client.GetPaymentRule(ctx, ruleID)
+		if err != nil {
+			return fmt.Errorf("could not read payment rule %s: %w", ruleID, err)
+		}
+		if rule.Antifraud.AffiliationID != nil && *rule.Antifraud.AffiliationID == affiliation.ID {
+			continue
+		}
+
+		id, implementation := affiliation.ID, affiliation.Implementation
+		rule.Antifraud = client.PaymentRuleAffiliation{Implementation: &implementation, AffiliationID: &id}
+		if _, err := r.client.UpdatePaymentRule(ctx, ruleID, *rule); err != nil {
+			return fmt.Errorf("could not link payment rule %s: %w", ruleID, err)
+		}
+	}
+
+	for _, ruleID := range previous {
+		if slices.Contains(wanted, ruleID) {
+			continue
+		}
+
+		rule, err := r.client.GetPaymentRule(ctx, ruleID)
+		if client.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not read payment rule %s: %w", ruleID, err)
+		}
+		if rule.Antifraud.AffiliationID == nil || *rule.Antifraud.AffiliationID != affiliation.ID {
+			continue
+		}
+
+		rule.Antifraud = client.PaymentRuleAffiliation{}
+		if _, err := r.client.UpdatePaymentRule(ctx, ruleID, *rule); err != nil {
+			return fmt.Errorf("could not unlink payment rule %s: %w", ruleID, err)
+		}
+	}
+	return nil
+}
+
+// paymentRuleIDs returns the IDs of a payment_rule_ids set, none when it is null
+func paymentRuleIDs(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var ids []string
+	if set.IsNull() || set.IsUnknown() {
+		return ids, nil
+	}
+	diags := set.ElementsAs(ctx, &ids, false)
+	return ids, diags
+}
+
+// affiliationFromModel builds the API payload from the resource model, with the settings sorted by name
+func affiliationFromModel(ctx context.Context, data VtexAntifraudAffiliationResourceModel) (client.Affiliation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings := map[string]string{}
+	if !data.Configuration.IsNull() {
+		diags.Append(data.Configuration.ElementsAs(ctx, &settings, false)...)
+	}
+	secrets := map[string]string{}
+	if !data.SecretConfiguration.IsNull() {
+		diags.Append(data.SecretConfiguration.ElementsAs(ctx, &secrets, false)...)
+	}
+	for name, value := range secrets {
+		settings[name] = value
+	}
+
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	configuration := make([]client.AffiliationConfiguration, 0, len(names))
+	for _, name := range names {
+		configuration = append(configuration, client.AffiliationConfiguration{Name: name, Value: settings[name]})
+	}
+
+	affiliation := client.Affiliation{
+		Name:           data.Name.ValueString(),
+		Implementation: data.Implementation.ValueString(),
+		Configuration:  configuration,
+	}
+	return affiliation, diags
+}
+
+// affiliationToModel copies an API affiliation into the resource model. Secret settings are kept
+// as configured, and settings the configuration does not set are left out.
+func affiliationToModel(ctx context.Context, affiliation *client.Affiliation, data *VtexAntifraudAffiliationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(affiliation.ID)
+	data.Name = types.StringValue(affiliation.Name)
+	data.Implementation = types.StringValue(affiliation.Implementation)
+
+	if data.Configuration.IsNull() || data.Configuration.IsUnknown() {
+		data.Configuration = types.MapNull(types.StringType)
+		return diags
+	}
+
+	configured := data.Configuration.Elements()
+	settings := map[string]string{}
+	for _, setting := range affiliation.Configuration {
+		if _, ok := configured[setting.Name]; ok {
+			settings[setting.Name] = setting.Value
+		}
+	}
+	configuration, mapDiags := types.MapValueFrom(ctx, types.StringType, settings)
+	diags.Append(mapDiags...)
+	data.Configuration = configuration
+	return diags
+}
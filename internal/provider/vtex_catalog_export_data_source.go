@@ -0,0 +1,400 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexCatalogExportDataSource{}
+
+// catalogExportPageSize is the most products the ID listing returns per call
+const catalogExportPageSize = 250
+
+// catalogExportColumns are the CSV columns, the same vtex_catalog_import reads plus the IDs
+var catalogExportColumns = []string{
+	"product_id", "product_ref_id", "product_name", "category_id", "brand_id", "link_id",
+	"title", "description", "product_active", "visible", "sku_id", "sku_ref_id", "sku_name", "sku_active",
+}
+
+func NewVtexCatalogExportDataSource() datasource.DataSource {
+	return &VtexCatalogExportDataSource{}
+}
+
+// VtexCatalogExportDataSource is the data source implementation
+type VtexCatalogExportDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexCatalogExportDataSourceModel is the data source data model
+type VtexCatalogExportDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	OutputPath    types.String `tfsdk:"output_path"`
+	Format        types.String `tfsdk:"format"`
+	CategoryID    types.String `tfsdk:"category_id"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	ProductCount  types.Int64  `tfsdk:"product_count"`
+	SKUCount      types.Int64  `tfsdk:"sku_count"`
+}
+
+// catalogExportProduct is an exported product, in the vtex_catalog_import JSON layout
+type catalogExportProduct struct {
+	ID          int64              `json:"id"`
+	RefID       string             `json:"ref_id"`
+	Name        string             `json:"name"`
+	CategoryID  int64              `json:"category_id"`
+	BrandID     int64              `json:"brand_id"`
+	LinkID      string             `json:"link_id"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	Active      bool               `json:"active"`
+	Visible     bool               `json:"visible"`
+	SKUs        []catalogExportSKU `json:"skus"`
+}
+
+// catalogExportSKU is an exported SKU
+type catalogExportSKU struct {
+	ID     int64  `json:"id"`
+	RefID  string `json:"ref_id"`
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+// catalogExportWriter writes exported products as they are read
+type catalogExportWriter interface {
+	Write(product catalogExportProduct) error
+	Close() error
+}
+
+func (d *VtexCatalogExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_export"
+}
+
+func (d *VtexCatalogExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports the products and SKUs of the catalog to a local CSV or JSON file.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Output path",
+			},
+			"output_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path of the file to write. It is replaced on every read",
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Description: "File format, csv or json (default: from the path extension, else csv)",
+				Validators: []validator.String{
+					stringOneOfValidator{values: []string{fileFormatCSV, fileFormatJSON}},
+				},
+			},
+			"category_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only export the products of this category",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the written file",
+			},
+			"product_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of exported products",
+			},
+			"sku_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of exported SKUs",
+			},
+		},
+	}
+}
+
+func (d *VtexCatalogExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexCatalogExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexCatalogExportDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var categoryID int64
+	if !data.CategoryID.IsNull() {
+		id, err := parseNumericID(data.CategoryID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("category_id"), "Invalid Category ID", err.Error())
+			return
+		}
+		categoryID = id
+	}
+
+	outputPath := data.OutputPath.ValueString()
+	format := data.Format.ValueString()
+	if format == "" {
+		format = fileFormatCSV
+		if filepath.Ext(outputPath) == ".json" {
+			format = fileFormatJSON
+		}
+	}
+
+	tflog.Debug(ctx, "Exporting VTEX catalog", map[string]interface{}{
+		"output_path": outputPath,
+		"format":      format,
+		"category_id": categoryID,
+	})
+
+	// Write to a temporary file next to the output, so a failed export never leaves a partial file
+	file, err := os.CreateTemp(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".*")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("output_path"), "Error Writing Catalog Export", err.Error())
+		return
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	hash := sha256.New()
+	out := io.MultiWriter(file, hash)
+
+	var writer catalogExportWriter
+	if format == fileFormatJSON {
+		writer = newCatalogExportJSONWriter(out)
+	} else {
+		writer = newCatalogExportCSVWriter(out)
+	}
+
+	products, skus, err := d.export(ctx, categoryID, writer)
+	if err == nil {
+		err = writer.Close()
+	}
+	if err == nil {
+		err = file.Chmod(0o644)
+	}
+	if err == nil {
+		err = file.Close()
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), outputPath)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Exporting VTEX Catalog",
+			"Could not export catalog, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = data.OutputPath
+	data.ContentSHA256 = types.StringValue(hex.EncodeToString(hash.Sum(nil)))
+	data.ProductCount = types.Int64Value(int64(products))
+	data.SKUCount = types.Int64Value(int64(skus))
+
+	tflog.Trace(ctx, "Exported VTEX catalog", map[string]interface{}{
+		"products": products,
+		"skus":     skus,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// export reads the catalog one page of products at a time and writes each page,
+// sorted by product ID, before reading the next, so memory stays bounded to one page
+func (d *VtexCatalogExportDataSource) export(ctx context.Context, categoryID int64, writer catalogExportWriter) (int, int, error) {
+	products, skus := 0, 0
+	for from := 1; ; from += catalogExportPageSize {
+		page, total, err := d.client.ListProductSKUIDs(ctx, categoryID, from, from+catalogExportPageSize-1)
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not list products: %w", err)
+		}
+
+		productIDs := make([]int64, 0, len(page))
+		for productID := range page {
+			productIDs = append(productIDs, productID)
+		}
+		sort.Slice(productIDs, func(i, j int) bool { return productIDs[i] < productIDs[j] })
+
+		var mu sync.Mutex
+		exported := make(map[int64]catalogExportProduct, len(productIDs))
+		err = forEachConcurrently(ctx, productIDs, func(ctx context.Context, productID int64) error {
+			product, err := d.exportProduct(ctx, productID)
+			if err != nil {
+				return fmt.Errorf("product %d: %w", productID, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			exported[productID] = product
+			return nil
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, productID := range productIDs {
+			product := exported[productID]
+			if err := writer.Write(product); err != nil {
+				return 0, 0, err
+			}
+			products++
+			skus += len(product.SKUs)
+		}
+
+		if len(page) == 0 || from+catalogExportPageSize > total {
+			return products, skus, nil
+		}
+	}
+}
+
+// exportProduct reads a product and its SKUs
+func (d *VtexCatalogExportDataSource) exportProduct(ctx context.Context, productID int64) (catalogExportProduct, error) {
+	product, err := d.client.GetProduct(ctx, productID)
+	if err != nil {
+		return catalogExportProduct{}, err
+	}
+
+	productSKUs, err := d.client.ListProductSKUs(ctx, productID)
+	if err != nil {
+		return catalogExportProduct{}, err
+	}
+	sort.Slice(productSKUs, func(i, j int) bool { return productSKUs[i].ID < productSKUs[j].ID })
+
+	exported := catalogExportProduct{
+		ID:          product.ID,
+		RefID:       product.RefID,
+		Name:        product.Name,
+		CategoryID:  product.CategoryID,
+		BrandID:     product.BrandID,
+		LinkID:      product.LinkID,
+		Title:       product.Title,
+		Description: product.Description,
+		Active:      product.IsActive,
+		Visible:     product.IsVisible,
+		SKUs:        make([]catalogExportSKU, 0, len(productSKUs)),
+	}
+	for _, sku := range productSKUs {
+		exported.SKUs = append(exported.SKUs, catalogExportSKU{
+			ID:     sku.ID,
+			RefID:  sku.RefID,
+			Name:   sku.Name,
+			Active: sku.IsActive,
+		})
+	}
+	return exported, nil
+}
+
+// catalogExportCSVWriter writes one row per SKU, and one row for products without SKUs
+type catalogExportCSVWriter struct {
+	csv    *csv.Writer
+	header bool
+}
+
+func newCatalogExportCSVWriter(out io.Writer) *catalogExportCSVWriter {
+	return &catalogExportCSVWriter{csv: csv.NewWriter(out)}
+}
+
+func (w *catalogExportCSVWriter) Write(product catalogExportProduct) error {
+	if !w.header {
+		if err := w.csv.Write(catalogExportColumns); err != nil {
+			return err
+		}
+		w.header = true
+	}
+
+	columns := []string{
+		strconv.FormatInt(product.ID, 10), product.RefID, product.Name,
+		strconv.FormatInt(product.CategoryID, 10), strconv.FormatInt(product.BrandID, 10), product.LinkID,
+		product.Title, product.Description, strconv.FormatBool(product.Active), strconv.FormatBool(product.Visible),
+	}
+	// WriteAll flushes, so rows reach the file as each product is exported
+	if len(product.SKUs) == 0 {
+		return w.csv.WriteAll([][]string{append(columns, "", "", "", "")})
+	}
+	rows := make([][]string, 0, len(product.SKUs))
+	for _, sku := range product.SKUs {
+		rows = append(rows, append(append([]string{}, columns...),
+			strconv.FormatInt(sku.ID, 10), sku.RefID, sku.Name, strconv.FormatBool(sku.Active)))
+	}
+	return w.csv.WriteAll(rows)
+}
+
+func (w *catalogExportCSVWriter) Close() error {
+	if !w.header {
+		if err := w.csv.Write(catalogExportColumns); err != nil {
+			return err
+		}
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// catalogExportJSONWriter writes a JSON list of products, one product per line
+type catalogExportJSONWriter struct {
+	out   io.Writer
+	count int
+}
+
+func newCatalogExportJSONWriter(out io.Writer) *catalogExportJSONWriter {
+	return &catalogExportJSONWriter{out: out}
+}
+
+func (w *catalogExportJSONWriter) Write(product catalogExportProduct) error {
+	encoded, err := json.Marshal(product)
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n"
+	if w.count == 0 {
+		separator = "[\n"
+	}
+	w.count++
+	_, err = fmt.Fprintf(w.out, "%s%s", separator, encoded)
+	return err
+}
+
+func (w *catalogExportJSONWriter) Close() error {
+	end := "\n]\n"
+	if w.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(w.out, end)
+	return err
+}
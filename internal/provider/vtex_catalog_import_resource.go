@@ -0,0 +1,701 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexCatalogImportResource{}
+var _ resource.ResourceWithValidateConfig = &VtexCatalogImportResource{}
+var _ resource.ResourceWithModifyPlan = &VtexCatalogImportResource{}
+
+// catalogImportMaxErrors is the most failed rows listed in the error diagnostic
+const catalogImportMaxErrors = 20
+
+func NewVtexCatalogImportResource() resource.Resource {
+	return &VtexCatalogImportResource{}
+}
+
+// VtexCatalogImportResource is the file driven bulk resource implementation
+type VtexCatalogImportResource struct {
+	client *client.VtexClient
+}
+
+// VtexCatalogImportResourceModel is the resource data model
+type VtexCatalogImportResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`
+	Format        types.String `tfsdk:"format"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	ProductCount  types.Int64  `tfsdk:"product_count"`
+	SKUCount      types.Int64  `tfsdk:"sku_count"`
+}
+
+// catalogImportProduct is a product of the import file with its SKUs
+type catalogImportProduct struct {
+	Row         int                `json:"-"`
+	RefID       string             `json:"ref_id"`
+	Name        string             `json:"name"`
+	CategoryID  int64              `json:"category_id"`
+	BrandID     int64              `json:"brand_id"`
+	LinkID      string             `json:"link_id"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	Active      *bool              `json:"active"`
+	Visible     *bool              `json:"visible"`
+	SKUs        []catalogImportSKU `json:"skus"`
+}
+
+// catalogImportSKU is a SKU of the import file
+type catalogImportSKU struct {
+	Row      int      `json:"-"`
+	RefID    string   `json:"ref_id"`
+	Name     string   `json:"name"`
+	EAN      string   `json:"ean"`
+	Active   *bool    `json:"active"`
+	Height   *float64 `json:"height"`
+	Length   *float64 `json:"length"`
+	Width    *float64 `json:"width"`
+	WeightKg *float64 `json:"weight_kg"`
+}
+
+func (r *VtexCatalogImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_import"
+}
+
+func (r *VtexCatalogImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates and updates catalog products and SKUs in bulk from a CSV, JSON or YAML file, matched by reference code.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique ID of the resource (SHA-256 of the first imported file)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the import file. Conflicts with content",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Inline import file content. Conflicts with path",
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Description: "File format, csv, json or yaml (default: from the path extension, else csv)",
+				Validators: []validator.String{
+					stringOneOfValidator{values: fileSourceFormats},
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the file content, changes when the file changes",
+			},
+			"product_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of products in the file",
+			},
+			"sku_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of SKUs in the file",
+			},
+		},
+	}
+}
+
+func (r *VtexCatalogImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexCatalogImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexCatalogImportResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if data.Path.IsUnknown() || data.Content.IsUnknown() {
+		return
+	}
+
+	if data.Path.IsNull() == data.Content.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Invalid Import File",
+			"Exactly one of path or content must be set.",
+		)
+	}
+}
+
+func (r *VtexCatalogImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data VtexCatalogImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Path.IsUnknown() || data.Content.IsUnknown() || data.Format.IsUnknown() {
+		return
+	}
+
+	// Parse the file at plan time, so file changes and row errors show up before apply
+	_, diags := catalogImportFile(&data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), data.ContentSHA256)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("product_count"), data.ProductCount)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sku_count"), data.SKUCount)...)
+}
+
+func (r *VtexCatalogImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexCatalogImportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	products, diags := catalogImportFile(&data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX catalog import", map[string]interface{}{
+		"products": len(products),
+		"skus":     data.SKUCount.ValueInt64(),
+	})
+
+	// Failed rows keep the resource out of the state, so the next apply retries the file
+	resp.Diagnostics.Append(r.importProducts(ctx, products)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.ContentSHA256
+
+	tflog.Trace(ctx, "Created VTEX catalog import", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCatalogImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexCatalogImportResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reading every product back would cost one call per row on each refresh,
+	// so changes made outside Terraform are only corrected when the file changes
+
+	tflog.Debug(ctx, "Reading VTEX catalog import", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCatalogImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexCatalogImportResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	products, diags := catalogImportFile(&plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX catalog import", map[string]interface{}{
+		"id":       state.ID.ValueString(),
+		"products": len(products),
+		"skus":     plan.SKUCount.ValueInt64(),
+	})
+
+	// Failed rows keep the prior state, so the next apply retries the file
+	resp.Diagnostics.Append(r.importProducts(ctx, products)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexCatalogImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexCatalogImportResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Catalog API cannot delete products or SKUs, they stay in the account
+	tflog.Info(ctx, "Removing VTEX catalog import from state only, products and SKUs are kept", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// importProducts reconciles the products in parallel and reports every failed row
+func (r *VtexCatalogImportResource) importProducts(ctx context.Context, products []catalogImportProduct) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	indexes := make([]int64, len(products))
+	for i := range products {
+		indexes[i] = int64(i)
+	}
+
+	// Each worker writes only its own slot, and never fails the group, so every row is tried
+	rowErrors := make([]error, len(products))
+	err := forEachConcurrently(ctx, indexes, func(ctx context.Context, i int64) error {
+		rowErrors[i] = r.importProduct(ctx, products[i])
+		return nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Importing VTEX Catalog",
+			"Could not import catalog, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	var failed []string
+	for i, rowErr := range rowErrors {
+		if rowErr != nil {
+			failed = append(failed, fmt.Sprintf("row %d (product %s): %s", products[i].Row, products[i].RefID, rowErr))
+		}
+	}
+	if len(failed) == 0 {
+		return diags
+	}
+
+	detail := strings.Join(failed[:min(len(failed), catalogImportMaxErrors)], "\n")
+	if len(failed) > catalogImportMaxErrors {
+		detail += fmt.Sprintf("\n... and %d more", len(failed)-catalogImportMaxErrors)
+	}
+	diags.AddError(
+		"Error Importing VTEX Catalog",
+		fmt.Sprintf("Could not import %d of %d products. Rows that succeeded were saved in the catalog:\n%s", len(failed), len(products), detail),
+	)
+	return diags
+}
+
+// importProduct creates or updates a product and then its SKUs
+func (r *VtexCatalogImportResource) importProduct(ctx context.Context, row catalogImportProduct) error {
+	existing, err := r.client.GetProductByRefID(ctx, row.RefID)
+	if err != nil && !client.IsNotFound(err) {
+		return fmt.Errorf("could not read product: %w", err)
+	}
+
+	var productID int64
+	if existing == nil {
+		created, err := r.client.CreateProduct(ctx, catalogImportProductPayload(client.Product{}, row))
+		if err != nil {
+			return fmt.Errorf("could not create product: %w", err)
+		}
+		productID = created.ID
+	} else {
+		productID = existing.ID
+		if payload := catalogImportProductPayload(*existing, row); payload != *existing {
+			if _, err := r.client.UpdateProduct(ctx, productID, payload); err != nil {
+				return fmt.Errorf("could not update product: %w", err)
+			}
+		}
+	}
+
+	for _, skuRow := range row.SKUs {
+		if err := r.importSKU(ctx, productID, skuRow); err != nil {
+			return fmt.Errorf("SKU %s (row %d): %w", skuRow.RefID, skuRow.Row, err)
+		}
+	}
+	return nil
+}
+
+// importSKU creates or updates a SKU of the product and adds its EAN
+func (r *VtexCatalogImportResource) importSKU(ctx context.Context, productID int64, row catalogImportSKU) error {
+	existing, err := r.client.GetSKUByRefID(ctx, row.RefID)
+	if err != nil && !client.IsNotFound(err) {
+		return fmt.Errorf("could not read SKU: %w", err)
+	}
+
+	var skuID int64
+	if existing == nil {
+		created, err := r.client.CreateSKU(ctx, catalogImportSKUPayload(client.SKU{}, productID, row))
+		if err != nil {
+			return fmt.Errorf("could not create SKU: %w", err)
+		}
+		skuID = created.ID
+	} else {
+		if existing.ProductID != productID {
+			return fmt.Errorf("reference code is already used by a SKU of product %d", existing.ProductID)
+		}
+		skuID = existing.ID
+		if payload := catalogImportSKUPayload(*existing, productID, row); payload != *existing {
+			if _, err := r.client.UpdateSKU(ctx, skuID, payload); err != nil {
+				return fmt.Errorf("could not update SKU: %w", err)
+			}
+		}
+	}
+
+	if row.EAN == "" {
+		return nil
+	}
+
+	eans, err := r.client.ListSKUEANs(ctx, skuID)
+	if err != nil && !client.IsNotFound(err) {
+		return fmt.Errorf("could not read SKU EANs: %w", err)
+	}
+	for _, ean := range eans {
+		if ean == row.EAN {
+			return nil
+		}
+	}
+	if err := r.client.AddSKUEAN(ctx, skuID, row.EAN); err != nil {
+		return fmt.Errorf("could not add EAN: %w", err)
+	}
+	return nil
+}
+
+// catalogImportProductPayload applies the file columns on top of the current product.
+// Empty optional columns keep the current value.
+func catalogImportProductPayload(product client.Product, row catalogImportProduct) client.Product {
+	product.RefID = row.RefID
+	product.Name = row.Name
+	product.CategoryID = row.CategoryID
+	product.BrandID = row.BrandID
+	if row.LinkID != "" {
+		product.LinkID = row.LinkID
+	}
+	if row.Title != "" {
+		product.Title = row.Title
+	}
+	if row.Description != "" {
+		product.Description = row.Description
+	}
+	if row.Active != nil {
+		product.IsActive = *row.Active
+	}
+	if row.Visible != nil {
+		product.IsVisible = *row.Visible
+	}
+	if product.ID == 0 && row.Visible == nil {
+		product.IsVisible = true
+	}
+	return product
+}
+
+// catalogImportSKUPayload applies the file columns on top of the current SKU.
+// Empty optional columns keep the current value.
+func catalogImportSKUPayload(sku client.SKU, productID int64, row catalogImportSKU) client.SKU {
+	sku.ProductID = productID
+	sku.RefID = row.RefID
+	sku.Name = row.Name
+	if row.Active != nil {
+		sku.IsActive = *row.Active
+	}
+	if row.Height != nil {
+		sku.PackagedHeight = *row.Height
+	}
+	if row.Length != nil {
+		sku.PackagedLength = *row.Length
+	}
+	if row.Width != nil {
+		sku.PackagedWidth = *row.Width
+	}
+	if row.WeightKg != nil {
+		sku.PackagedWeightKg = *row.WeightKg
+	}
+	return sku
+}
+
+// catalogImportFile reads and parses the import file, and sets content_sha256 and the counts
+func catalogImportFile(data *VtexCatalogImportResourceModel) ([]catalogImportProduct, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	content, format, err := fileSource(data.Path, data.Content, data.Format)
+	if err != nil {
+		diags.AddAttributeError(path.Root("path"), "Invalid Import File", err.Error())
+		return nil, diags
+	}
+
+	products, err := parseCatalogImport(content, format)
+	if err != nil {
+		diags.AddAttributeError(path.Root("content"), "Invalid Import File", err.Error())
+		return nil, diags
+	}
+
+	skus := 0
+	for _, product := range products {
+		skus += len(product.SKUs)
+	}
+
+	sum := sha256.Sum256(content)
+	data.ContentSHA256 = types.StringValue(hex.EncodeToString(sum[:]))
+	data.ProductCount = types.Int64Value(int64(len(products)))
+	data.SKUCount = types.Int64Value(int64(skus))
+	return products, diags
+}
+
+// parseCatalogImport parses a JSON list of products with nested skus, or CSV rows
+// with one SKU per row, and checks every row before anything is sent
+func parseCatalogImport(content []byte, format string) ([]catalogImportProduct, error) {
+	var products []catalogImportProduct
+
+	switch format {
+	case fileFormatJSON:
+		if err := json.Unmarshal(content, &products); err != nil {
+			return nil, fmt.Errorf("expected a JSON list of products with ref_id, name, category_id, brand_id and skus: %w", err)
+		}
+		for i := range products {
+			products[i].Row = i + 1
+			for j := range products[i].SKUs {
+				products[i].SKUs[j].Row = i + 1
+			}
+		}
+	default:
+		parsed, err := parseCatalogImportCSV(content)
+		if err != nil {
+			return nil, err
+		}
+		products = parsed
+	}
+
+	var problems []string
+	productRefs := make(map[string]int)
+	skuRefs := make(map[string]int)
+	for _, product := range products {
+		switch {
+		case product.RefID == "":
+			problems = append(problems, fmt.Sprintf("row %d: product ref_id is required", product.Row))
+		case productRefs[product.RefID] != 0:
+			problems = append(problems, fmt.Sprintf("row %d: product %s is already listed in row %d", product.Row, product.RefID, productRefs[product.RefID]))
+		default:
+			productRefs[product.RefID] = product.Row
+		}
+		if product.Name == "" {
+			problems = append(problems, fmt.Sprintf("row %d: product name is required", product.Row))
+		}
+		if product.CategoryID <= 0 || product.BrandID <= 0 {
+			problems = append(problems, fmt.Sprintf("row %d: product category_id and brand_id are required", product.Row))
+		}
+
+		for _, sku := range product.SKUs {
+			switch {
+			case sku.RefID == "":
+				problems = append(problems, fmt.Sprintf("row %d: SKU ref_id is required", sku.Row))
+			case skuRefs[sku.RefID] != 0:
+				problems = append(problems, fmt.Sprintf("row %d: SKU %s is already listed in row %d", sku.Row, sku.RefID, skuRefs[sku.RefID]))
+			default:
+				skuRefs[sku.RefID] = sku.Row
+			}
+			if sku.Name == "" {
+				problems = append(problems, fmt.Sprintf("row %d: SKU name is required", sku.Row))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		detail := strings.Join(problems[:min(len(problems), catalogImportMaxErrors)], "\n")
+		if len(problems) > catalogImportMaxErrors {
+			detail += fmt.Sprintf("\n... and %d more", len(problems)-catalogImportMaxErrors)
+		}
+		return nil, fmt.Errorf("%d invalid rows:\n%s", len(problems), detail)
+	}
+	return products, nil
+}
+
+// parseCatalogImportCSV reads CSV rows by header name. Each row is a SKU; rows with
+// the same product_ref_id form one product, whose columns are taken from its first row.
+// Rows without sku_ref_id only describe the product.
+func parseCatalogImportCSV(content []byte) ([]catalogImportProduct, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[csvColumnName(column)] = i
+	}
+	for _, required := range []string{"product_ref_id", "product_name", "category_id", "brand_id"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV header must have a %s column, got: %s", required, strings.Join(header, ","))
+		}
+	}
+
+	var products []catalogImportProduct
+	byRef := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		// Skip blank lines left by spreadsheet exports
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		var rowErr error
+		field := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		number := func(column string) int64 {
+			value := field(column)
+			if value == "" {
+				return 0
+			}
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil && rowErr == nil {
+				rowErr = fmt.Errorf("row %d: %s must be a number, got %q", line, column, value)
+			}
+			return parsed
+		}
+		decimal := func(column string) *float64 {
+			value := field(column)
+			if value == "" {
+				return nil
+			}
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil && rowErr == nil {
+				rowErr = fmt.Errorf("row %d: %s must be a number, got %q", line, column, value)
+			}
+			return &parsed
+		}
+		flag := func(column string) *bool {
+			value := field(column)
+			if value == "" {
+				return nil
+			}
+			parsed, err := strconv.ParseBool(value)
+			if err != nil && rowErr == nil {
+				rowErr = fmt.Errorf("row %d: %s must be true or false, got %q", line, column, value)
+			}
+			return &parsed
+		}
+
+		product := catalogImportProduct{
+			Row:         line,
+			RefID:       field("product_ref_id"),
+			Name:        field("product_name"),
+			CategoryID:  number("category_id"),
+			BrandID:     number("brand_id"),
+			LinkID:      field("link_id"),
+			Title:       field("title"),
+			Description: field("description"),
+			Active:      flag("product_active"),
+			Visible:     flag("visible"),
+		}
+		sku := catalogImportSKU{
+			Row:      line,
+			RefID:    field("sku_ref_id"),
+			Name:     field("sku_name"),
+			EAN:      field("ean"),
+			Active:   flag("sku_active"),
+			Height:   decimal("height"),
+			Length:   decimal("length"),
+			Width:    decimal("width"),
+			WeightKg: decimal("weight_kg"),
+		}
+		if rowErr != nil {
+			return nil, rowErr
+		}
+
+		i, ok := byRef[product.RefID]
+		if !ok || product.RefID == "" {
+			i = len(products)
+			byRef[product.RefID] = i
+			products = append(products, product)
+		}
+		if sku.RefID != "" || sku.Name != "" {
+			products[i].SKUs = append(products[i].SKUs, sku)
+		}
+	}
+	return products, nil
+}
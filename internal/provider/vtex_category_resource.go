@@ -0,0 +1,488 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexCategoryResource{}
+var _ resource.ResourceWithImportState = &VtexCategoryResource{}
+var _ resource.ResourceWithModifyPlan = &VtexCategoryResource{}
+
+// SKU selection modes of a category
+const (
+	skuSelectionSpecification = "SPECIFICATION"
+	skuSelectionList          = "LIST"
+)
+
+func NewVtexCategoryResource() resource.Resource {
+	return &VtexCategoryResource{}
+}
+
+// VtexCategoryResource is the resource implementation
+type VtexCategoryResource struct {
+	client *client.VtexClient
+}
+
+// VtexCategoryResourceModel is the resource data model
+type VtexCategoryResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	ParentID             types.String `tfsdk:"parent_id"`
+	Title                types.String `tfsdk:"title"`
+	Description          types.String `tfsdk:"description"`
+	Keywords             types.Set    `tfsdk:"keywords"`
+	Active               types.Bool   `tfsdk:"active"`
+	Visible              types.Bool   `tfsdk:"visible"`
+	ActiveStoreFrontLink types.Bool   `tfsdk:"active_store_front_link"`
+	ShowBrandFilter      types.Bool   `tfsdk:"show_brand_filter"`
+	GlobalCategoryID     types.Int64  `tfsdk:"global_category_id"`
+	Score                types.Int64  `tfsdk:"score"`
+	SKUSelectionMode     types.String `tfsdk:"sku_selection_mode"`
+	LinkID               types.String `tfsdk:"link_id"`
+}
+
+func (r *VtexCategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_category"
+}
+
+func (r *VtexCategoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a catalog category. A category without parent_id is a department.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Category ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Category name",
+			},
+			"parent_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the parent category. Changing it moves the category (and its children) in place; unset it to make a department",
+			},
+			"title": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Page title for search engines (default: name)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Meta description of the category page",
+			},
+			"keywords": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Alternative search terms for the category",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the category is active",
+			},
+			"visible": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the category is shown in the store menu",
+			},
+			"active_store_front_link": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the category page link is active in the store",
+			},
+			"show_brand_filter": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the brand filter is shown on the category page",
+			},
+			"global_category_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Google global category ID, used by marketplace integrations",
+			},
+			"score": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Sort score of the category in the menu",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"sku_selection_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(skuSelectionSpecification),
+				Description: "How shoppers pick SKUs on the product page, SPECIFICATION or LIST",
+				Validators: []validator.String{
+					stringOneOfValidator{values: []string{skuSelectionSpecification, skuSelectionList}},
+				},
+			},
+			"link_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL slug of the category, set by VTEX",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexCategoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexCategoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state VtexCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ParentID.IsUnknown() && plan.ParentID.Equal(state.ID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent_id"),
+			"Invalid Category Parent",
+			"A category cannot be its own parent.",
+		)
+		return
+	}
+
+	// VTEX regenerates the slug when the name changes
+	if !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("link_id"), types.StringUnknown())...)
+	} else {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("link_id"), state.LinkID)...)
+	}
+}
+
+func (r *VtexCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexCategoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, diags := categoryFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX category", map[string]interface{}{
+		"name":      data.Name.ValueString(),
+		"parent_id": data.ParentID.ValueString(),
+	})
+
+	result, err := r.client.CreateCategory(ctx, category)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Category",
+			"Could not create category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(categoryToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX category", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexCategoryResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoryID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Category ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX category", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	category, err := r.client.GetCategory(ctx, categoryID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX category not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Category",
+			"Could not read category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(categoryToModel(ctx, category, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexCategoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoryID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Category ID", err.Error())
+		return
+	}
+
+	category, diags := categoryFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX category", map[string]interface{}{
+		"id":        data.ID.ValueString(),
+		"parent_id": data.ParentID.ValueString(),
+	})
+
+	result, err := r.client.UpdateCategory(ctx, categoryID, category)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Category",
+			"Could not update category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(categoryToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexCategoryResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoryID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Category ID", err.Error())
+		return
+	}
+
+	category, diags := categoryFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Catalog API cannot delete categories, so they are deactivated and hidden
+	category.IsActive = false
+	category.ShowInStoreFront = false
+
+	tflog.Debug(ctx, "Deactivating VTEX category", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err = r.client.UpdateCategory(ctx, categoryID, category)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Category",
+			"Could not deactivate category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deactivated VTEX category", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric category ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// categoryFromModel builds the API payload from the resource model
+func categoryFromModel(ctx context.Context, data VtexCategoryResourceModel) (client.Category, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	category := client.Category{
+		Name:                          data.Name.ValueString(),
+		Title:                         data.Title.ValueString(),
+		Description:                   data.Description.ValueString(),
+		IsActive:                      data.Active.ValueBool(),
+		ShowInStoreFront:              data.Visible.ValueBool(),
+		ActiveStoreFrontLink:          data.ActiveStoreFrontLink.ValueBool(),
+		ShowBrandFilter:               data.ShowBrandFilter.ValueBool(),
+		GlobalCategoryID:              data.GlobalCategoryID.ValueInt64(),
+		StockKeepingUnitSelectionMode: data.SKUSelectionMode.ValueString(),
+	}
+
+	// If title is not given, use the name
+	if category.Title == "" {
+		category.Title = category.Name
+	}
+
+	if !data.ParentID.IsNull() {
+		parentID, err := parseNumericID(data.ParentID.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("parent_id"), "Invalid Category Parent", err.Error())
+			return category, diags
+		}
+		category.FatherCategoryID = &parentID
+	}
+
+	if !data.Score.IsNull() && !data.Score.IsUnknown() {
+		score := data.Score.ValueInt64()
+		category.Score = &score
+	}
+
+	if !data.Keywords.IsNull() && !data.Keywords.IsUnknown() {
+		var keywords []string
+		diags.Append(data.Keywords.ElementsAs(ctx, &keywords, false)...)
+		sort.Strings(keywords)
+		category.Keywords = strings.Join(keywords, ", ")
+	}
+
+	return category, diags
+}
+
+// categoryToModel copies an API category into the resource model
+func categoryToModel(ctx context.Context, category *client.Category, data *VtexCategoryResourceModel) diag.Diagnostics {
+	data.ID = types.StringValue(strconv.FormatInt(category.ID, 10))
+	data.Name = types.StringValue(category.Name)
+	data.Title = types.StringValue(category.Title)
+	data.Description = types.StringValue(category.Description)
+	data.Active = types.BoolValue(category.IsActive)
+	data.Visible = types.BoolValue(category.ShowInStoreFront)
+	data.ActiveStoreFrontLink = types.BoolValue(category.ActiveStoreFrontLink)
+	data.ShowBrandFilter = types.BoolValue(category.ShowBrandFilter)
+	data.GlobalCategoryID = types.Int64Value(category.GlobalCategoryID)
+	data.SKUSelectionMode = types.StringValue(category.StockKeepingUnitSelectionMode)
+	data.LinkID = types.StringValue(category.LinkID)
+
+	if category.FatherCategoryID != nil {
+		data.ParentID = types.StringValue(strconv.FormatInt(*category.FatherCategoryID, 10))
+	} else {
+		data.ParentID = types.StringNull()
+	}
+
+	if category.Score != nil {
+		data.Score = types.Int64Value(*category.Score)
+	} else {
+		data.Score = types.Int64Null()
+	}
+
+	keywords := splitKeywords(category.Keywords)
+	set, diags := types.SetValueFrom(ctx, types.StringType, keywords)
+	data.Keywords = set
+	return diags
+}
+
+// splitKeywords splits the comma separated keywords string of the Catalog API
+func splitKeywords(value string) []string {
+	seen := make(map[string]bool)
+	keywords := []string{}
+	for _, keyword := range strings.Split(value, ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" && !seen[keyword] {
+			seen[keyword] = true
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
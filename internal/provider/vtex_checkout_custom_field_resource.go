@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexCheckoutCustomFieldResource{}
+var _ resource.ResourceWithImportState = &VtexCheckoutCustomFieldResource{}
+var _ resource.ResourceWithValidateConfig = &VtexCheckoutCustomFieldResource{}
+
+func NewVtexCheckoutCustomFieldResource() resource.Resource {
+	return &VtexCheckoutCustomFieldResource{}
+}
+
+// VtexCheckoutCustomFieldResource is the resource implementation
+type VtexCheckoutCustomFieldResource struct {
+	client *client.VtexClient
+}
+
+// VtexCheckoutCustomFieldResourceModel is the resource data model
+type VtexCheckoutCustomFieldResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	AppID  types.String `tfsdk:"app_id"`
+	Fields types.Set    `tfsdk:"fields"`
+	Major  types.Int64  `tfsdk:"major"`
+}
+
+func (r *VtexCheckoutCustomFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checkout_custom_field"
+}
+
+func (r *VtexCheckoutCustomFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages one app of the orderForm custom data and its fields, keeping the apps other configurations manage.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "App ID, same as app_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the app the fields are stored under in the orderForm customData. Changing it creates a new app",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fields": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Names of the fields the app stores",
+			},
+			"major": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Description: "Major version of the app's fields (default: 1)",
+			},
+		},
+	}
+}
+
+func (r *VtexCheckoutCustomFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexCheckoutCustomFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexCheckoutCustomFieldResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.AppID) && data.AppID.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("app_id"), "Invalid App ID", "The app ID cannot be empty.")
+	}
+
+	if isKnown(data.Fields) {
+		var fields []types.String
+		resp.Diagnostics.Append(data.Fields.ElementsAs(ctx, &fields, false)...)
+		if len(fields) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("fields"), "Missing Fields", "The app needs at least one field.")
+		}
+		for _, field := range fields {
+			if isKnown(field) && field.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid Field", "Field names cannot be empty.")
+			}
+		}
+	}
+
+	if isKnown(data.Major) && data.Major.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("major"),
+			"Invalid Major Version",
+			fmt.Sprintf("Expected 1 or more, got: %d", data.Major.ValueInt64()),
+		)
+	}
+}
+
+func (r *VtexCheckoutCustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexCheckoutCustomFieldResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, diags := checkoutCustomFieldFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX checkout custom field", map[string]interface{}{
+		"app_id": app.ID,
+		"fields": len(app.Fields),
+	})
+
+	result, err := r.client.SetOrderFormApp(ctx, app, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Checkout Custom Field",
+			"Could not create checkout custom field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkoutCustomFieldToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX checkout custom field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCheckoutCustomFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexCheckoutCustomFieldResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX checkout custom field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	app, err := r.client.GetOrderFormApp(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX checkout custom field not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Checkout Custom Field",
+			"Could not read checkout custom field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkoutCustomFieldToModel(ctx, app, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCheckoutCustomFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexCheckoutCustomFieldResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, diags := checkoutCustomFieldFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX checkout custom field", map[string]interface{}{
+		"id":     data.ID.ValueString(),
+		"fields": len(app.Fields),
+	})
+
+	result, err := r.client.SetOrderFormApp(ctx, app, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Checkout Custom Field",
+			"Could not update checkout custom field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkoutCustomFieldToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCheckoutCustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexCheckoutCustomFieldResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX checkout custom field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	if err := r.client.DeleteOrderFormApp(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Checkout Custom Field",
+			"Could not delete checkout custom field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX checkout custom field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexCheckoutCustomFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: app ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkoutCustomFieldFromModel builds the orderForm app from the resource model
+func checkoutCustomFieldFromModel(ctx context.Context, data VtexCheckoutCustomFieldResourceModel) (client.OrderFormApp, diag.Diagnostics) {
+	app := client.OrderFormApp{
+		ID:    data.AppID.ValueString(),
+		Major: data.Major.ValueInt64(),
+	}
+	diags := data.Fields.ElementsAs(ctx, &app.Fields, false)
+	// Sorted, so the saved configuration does not change with the set order
+	slices.Sort(app.Fields)
+	return app, diags
+}
+
+// checkoutCustomFieldToModel copies an orderForm app into the resource model
+func checkoutCustomFieldToModel(ctx context.Context, app *client.OrderFormApp, data *VtexCheckoutCustomFieldResourceModel) diag.Diagnostics {
+	data.ID = types.StringValue(app.ID)
+	data.AppID = types.StringValue(app.ID)
+	data.Major = types.Int64Value(app.Major)
+
+	names := app.Fields
+	if names == nil {
+		names = []string{}
+	}
+	fields, diags := types.SetValueFrom(ctx, types.StringType, names)
+	data.Fields = fields
+	return diags
+}
@@ -0,0 +1,518 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexCheckoutSettingsResource{}
+var _ resource.ResourceWithImportState = &VtexCheckoutSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &VtexCheckoutSettingsResource{}
+
+// checkoutSettingsID is the ID of the only orderForm configuration of an account
+const checkoutSettingsID = "orderForm"
+
+// checkoutRecaptchaValidations are the values of the reCAPTCHA validation of checkout
+var checkoutRecaptchaValidations = []string{"never", "always", "vtexcriteria"}
+
+func NewVtexCheckoutSettingsResource() resource.Resource {
+	return &VtexCheckoutSettingsResource{}
+}
+
+// VtexCheckoutSettingsResource is the resource implementation
+type VtexCheckoutSettingsResource struct {
+	client *client.VtexClient
+}
+
+// VtexCheckoutSettingsResourceModel is the resource data model
+type VtexCheckoutSettingsResourceModel struct {
+	ID                               types.String  `tfsdk:"id"`
+	Apps                             types.String  `tfsdk:"apps"`
+	RequiresLoginToPlaceOrder        types.Bool    `tfsdk:"requires_login_to_place_order"`
+	RequiresAuthenticationForPreAuth types.Bool    `tfsdk:"requires_authentication_for_preauthorized_payment"`
+	AllowInstallmentsMerge           types.Bool    `tfsdk:"allow_installments_merge"`
+	AllowMultipleDeliveries          types.Bool    `tfsdk:"allow_multiple_deliveries"`
+	AllowManualPrice                 types.Bool    `tfsdk:"allow_manual_price"`
+	MaskFirstPurchaseData            types.Bool    `tfsdk:"mask_first_purchase_data"`
+	RecaptchaValidation              types.String  `tfsdk:"recaptcha_validation"`
+	MinimumCartValue                 types.Float64 `tfsdk:"minimum_cart_value"`
+	MinimumItemQuantity              types.Int64   `tfsdk:"minimum_item_quantity"`
+	DecimalDigitsPrecision           types.Int64   `tfsdk:"decimal_digits_precision"`
+	TaxServiceURL                    types.String  `tfsdk:"tax_service_url"`
+	TaxServiceAuthorization          types.String  `tfsdk:"tax_service_authorization"`
+	TaxAllowExecutionAfterErrors     types.Bool    `tfsdk:"tax_allow_execution_after_errors"`
+	TaxIntegratedAuthentication      types.Bool    `tfsdk:"tax_integrated_authentication"`
+}
+
+func (r *VtexCheckoutSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checkout_settings"
+}
+
+func (r *VtexCheckoutSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the orderForm configuration of the checkout. There is one per account; settings that are not set keep their current value.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always \"" + checkoutSettingsID + "\"",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"apps": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSON list of the orderForm custom data apps, each with id, fields and major. Not managed when not set. Formatting and key order are ignored",
+				PlanModifiers: []planmodifier.String{
+					semanticJSONModifier{},
+				},
+			},
+			"requires_login_to_place_order": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether shoppers must log in to place an order",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"requires_authentication_for_preauthorized_payment": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether shoppers must log in to pay with a saved (pre-authorized) payment option",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_installments_merge": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the installments of items from different sellers can be merged",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_multiple_deliveries": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether an order can be split into several deliveries",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_manual_price": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether operators can change item prices in the cart",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mask_first_purchase_data": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the personal data of first purchases is masked in checkout",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"recaptcha_validation": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When checkout asks for a reCAPTCHA: never, always or vtexcriteria",
+				Validators: []validator.String{
+					stringOneOfValidator{values: checkoutRecaptchaValidations},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"minimum_cart_value": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Smallest cart value an order can be placed with, 0 for no minimum",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"minimum_item_quantity": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Smallest number of items an order can be placed with",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"decimal_digits_precision": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Decimal digits checkout rounds values to",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"tax_service_url": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "HTTPS URL of the external tax service checkout calls to compute taxes, or empty for none",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tax_service_authorization": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Authorization header checkout sends to the tax service. It is kept as configured",
+			},
+			"tax_allow_execution_after_errors": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether orders can be placed when the tax service fails",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tax_integrated_authentication": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether checkout authenticates to the tax service with the account's app key instead of the authorization header",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexCheckoutSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexCheckoutSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexCheckoutSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.Apps) {
+		if _, err := parseOrderFormApps(data.Apps.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("apps"), "Invalid Checkout Apps", err.Error())
+		}
+	}
+
+	if isKnown(data.MinimumCartValue) && data.MinimumCartValue.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("minimum_cart_value"),
+			"Invalid Minimum Cart Value",
+			fmt.Sprintf("Expected 0 or more, got: %g", data.MinimumCartValue.ValueFloat64()),
+		)
+	}
+
+	if isKnown(data.TaxServiceURL) && data.TaxServiceURL.ValueString() != "" {
+		if u, err := url.Parse(data.TaxServiceURL.ValueString()); err != nil || u.Scheme != "https" || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tax_service_url"),
+				"Invalid Tax Service URL",
+				fmt.Sprintf("Expected an https:// URL, got: %q", data.TaxServiceURL.ValueString()),
+			)
+		}
+	}
+
+	// The other tax settings belong to the tax service
+	if data.TaxServiceURL.IsNull() {
+		for name, value := range map[string]interface{ IsNull() bool }{
+			"tax_service_authorization":        data.TaxServiceAuthorization,
+			"tax_allow_execution_after_errors": data.TaxAllowExecutionAfterErrors,
+			"tax_integrated_authentication":    data.TaxIntegratedAuthentication,
+		} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Missing Tax Service URL",
+					name+" can only be set with tax_service_url.",
+				)
+			}
+		}
+	}
+}
+
+func (r *VtexCheckoutSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexCheckoutSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX checkout settings")
+
+	configuration, err := r.updateConfiguration(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Checkout Settings",
+			"Could not update the orderForm configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	checkoutSettingsToModel(configuration, &data)
+
+	tflog.Trace(ctx, "Created VTEX checkout settings")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCheckoutSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexCheckoutSettingsResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX checkout settings")
+
+	configuration, err := r.client.GetOrderFormConfiguration(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Checkout Settings",
+			"Could not read the orderForm configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	checkoutSettingsToModel(configuration, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCheckoutSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexCheckoutSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX checkout settings")
+
+	configuration, err := r.updateConfiguration(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Checkout Settings",
+			"Could not update the orderForm configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	checkoutSettingsToModel(configuration, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCheckoutSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The orderForm configuration cannot be deleted, it is only removed from state
+	tflog.Debug(ctx, "Removing VTEX checkout settings from state, the account keeps its orderForm configuration")
+}
+
+func (r *VtexCheckoutSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: "orderForm"
+	if req.ID != checkoutSettingsID {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("expected %q, got: %q", checkoutSettingsID, req.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateConfiguration applies the configured arguments over the current orderForm configuration
+// and reads the result back
+func (r *VtexCheckoutSettingsResource) updateConfiguration(ctx context.Context, data VtexCheckoutSettingsResourceModel) (*client.OrderFormConfiguration, error) {
+	return r.client.EditOrderFormConfiguration(ctx, func(configuration *client.OrderFormConfiguration) error {
+		applyCheckoutSettings(data, configuration)
+		return nil
+	})
+}
+
+// applyCheckoutSettings sets the known arguments of the model on the orderForm configuration
+func applyCheckoutSettings(data VtexCheckoutSettingsResourceModel, configuration *client.OrderFormConfiguration) {
+	if isKnown(data.Apps) {
+		// ValidateConfig already checked the apps
+		configuration.Apps, _ = parseOrderFormApps(data.Apps.ValueString())
+	}
+	if isKnown(data.RequiresLoginToPlaceOrder) {
+		configuration.RequiresLoginToPlaceOrder = data.RequiresLoginToPlaceOrder.ValueBool()
+	}
+	if isKnown(data.RequiresAuthenticationForPreAuth) {
+		configuration.PaymentConfiguration.RequiresAuthenticationForPreAuthorizedPaymentOption = data.RequiresAuthenticationForPreAuth.ValueBool()
+	}
+	if isKnown(data.AllowInstallmentsMerge) {
+		configuration.PaymentConfiguration.AllowInstallmentsMerge = data.AllowInstallmentsMerge.ValueBoolPointer()
+	}
+	if isKnown(data.AllowMultipleDeliveries) {
+		configuration.AllowMultipleDeliveries = data.AllowMultipleDeliveries.ValueBoolPointer()
+	}
+	if isKnown(data.AllowManualPrice) {
+		configuration.AllowManualPrice = data.AllowManualPrice.ValueBoolPointer()
+	}
+	if isKnown(data.MaskFirstPurchaseData) {
+		configuration.MaskFirstPurchaseData = data.MaskFirstPurchaseData.ValueBoolPointer()
+	}
+	if isKnown(data.RecaptchaValidation) {
+		configuration.RecaptchaValidation = data.RecaptchaValidation.ValueString()
+	}
+	if isKnown(data.MinimumCartValue) {
+		configuration.MinimumValueAccumulated = nil
+		if cents := int64(math.Round(data.MinimumCartValue.ValueFloat64() * 100)); cents > 0 {
+			configuration.MinimumValueAccumulated = &cents
+		}
+	}
+	if isKnown(data.MinimumItemQuantity) {
+		configuration.MinimumQuantityAccumulatedForItems = data.MinimumItemQuantity.ValueInt64()
+	}
+	if isKnown(data.DecimalDigitsPrecision) {
+		configuration.DecimalDigitsPrecision = data.DecimalDigitsPrecision.ValueInt64()
+	}
+
+	if isKnown(data.TaxServiceURL) {
+		if data.TaxServiceURL.ValueString() == "" {
+			configuration.TaxConfiguration = nil
+		} else {
+			if configuration.TaxConfiguration == nil {
+				configuration.TaxConfiguration = &client.OrderFormTaxConfiguration{}
+			}
+			configuration.TaxConfiguration.URL = data.TaxServiceURL.ValueString()
+		}
+	}
+	if configuration.TaxConfiguration != nil {
+		if isKnown(data.TaxServiceAuthorization) {
+			configuration.TaxConfiguration.AuthorizationHeader = data.TaxServiceAuthorization.ValueString()
+		}
+		if isKnown(data.TaxAllowExecutionAfterErrors) {
+			configuration.TaxConfiguration.AllowExecutionAfterErrors = data.TaxAllowExecutionAfterErrors.ValueBool()
+		}
+		if isKnown(data.TaxIntegratedAuthentication) {
+			configuration.TaxConfiguration.IntegratedAuthentication = data.TaxIntegratedAuthentication.ValueBool()
+		}
+	}
+}
+
+// parseOrderFormApps parses a JSON list of orderForm apps, rejecting unknown keys so typos
+// are not silently dropped
+func parseOrderFormApps(value string) ([]client.OrderFormApp, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value)))
+	decoder.DisallowUnknownFields()
+
+	var apps []client.OrderFormApp
+	if err := decoder.Decode(&apps); err != nil || apps == nil {
+		return nil, fmt.Errorf("expected a JSON list of apps with id, fields and major, e.g. from jsonencode()")
+	}
+
+	seen := map[string]bool{}
+	for _, app := range apps {
+		if app.ID == "" {
+			return nil, fmt.Errorf("every app needs an id")
+		}
+		if seen[app.ID] {
+			return nil, fmt.Errorf("app %q is listed more than once", app.ID)
+		}
+		seen[app.ID] = true
+	}
+	return apps, nil
+}
+
+// orderFormAppsJSON encodes orderForm apps in normalized JSON
+func orderFormAppsJSON(apps []client.OrderFormApp) string {
+	if apps == nil {
+		apps = []client.OrderFormApp{}
+	}
+	encoded, _ := json.Marshal(apps)
+	normalized, _ := normalizeJSON(string(encoded))
+	return normalized
+}
+
+// checkoutSettingsToModel copies the orderForm configuration into the resource model. The apps
+// are only read when they are managed, and the configured ones are kept while they are the same.
+// The tax service authorization is kept as configured.
+func checkoutSettingsToModel(configuration *client.OrderFormConfiguration, data *VtexCheckoutSettingsResourceModel) {
+	data.ID = types.StringValue(checkoutSettingsID)
+
+	if !data.Apps.IsNull() {
+		current := orderFormAppsJSON(configuration.Apps)
+		configured, err := parseOrderFormApps(data.Apps.ValueString())
+		if err != nil || orderFormAppsJSON(configured) != current {
+			data.Apps = types.StringValue(current)
+		}
+	}
+
+	data.RequiresLoginToPlaceOrder = types.BoolValue(configuration.RequiresLoginToPlaceOrder)
+	data.RequiresAuthenticationForPreAuth = types.BoolValue(configuration.PaymentConfiguration.RequiresAuthenticationForPreAuthorizedPaymentOption)
+	data.AllowInstallmentsMerge = types.BoolValue(boolValue(configuration.PaymentConfiguration.AllowInstallmentsMerge))
+	data.AllowMultipleDeliveries = types.BoolValue(boolValue(configuration.AllowMultipleDeliveries))
+	data.AllowManualPrice = types.BoolValue(boolValue(configuration.AllowManualPrice))
+	data.MaskFirstPurchaseData = types.BoolValue(boolValue(configuration.MaskFirstPurchaseData))
+	data.RecaptchaValidation = types.StringValue(configuration.RecaptchaValidation)
+	data.MinimumItemQuantity = types.Int64Value(configuration.MinimumQuantityAccumulatedForItems)
+	data.DecimalDigitsPrecision = types.Int64Value(configuration.DecimalDigitsPrecision)
+
+	data.MinimumCartValue = types.Float64Value(0)
+	if configuration.MinimumValueAccumulated != nil {
+		data.MinimumCartValue = types.Float64Value(centsToAmount(*configuration.MinimumValueAccumulated))
+	}
+
+	tax := configuration.TaxConfiguration
+	if tax == nil {
+		tax = &client.OrderFormTaxConfiguration{}
+	}
+	data.TaxServiceURL = types.StringValue(tax.URL)
+	data.TaxAllowExecutionAfterErrors = types.BoolValue(tax.AllowExecutionAfterErrors)
+	data.TaxIntegratedAuthentication = types.BoolValue(tax.IntegratedAuthentication)
+}
+
+// boolValue dereferences an optional API flag, false when it is not set
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
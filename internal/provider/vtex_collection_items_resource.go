@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexCollectionItemsResource{}
+var _ resource.ResourceWithImportState = &VtexCollectionItemsResource{}
+
+// collectionImportChunkSize is the most SKUs sent in one collection import file
+const collectionImportChunkSize = 1000
+
+func NewVtexCollectionItemsResource() resource.Resource {
+	return &VtexCollectionItemsResource{}
+}
+
+// VtexCollectionItemsResource is the resource implementation
+type VtexCollectionItemsResource struct {
+	client *client.VtexClient
+}
+
+// VtexCollectionItemsResourceModel is the resource data model
+type VtexCollectionItemsResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	CollectionID      types.String `tfsdk:"collection_id"`
+	SubcollectionName types.String `tfsdk:"subcollection_name"`
+	SubcollectionID   types.String `tfsdk:"subcollection_id"`
+	SKUIDs            types.Set    `tfsdk:"sku_ids"`
+}
+
+func (r *VtexCollectionItemsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_items"
+}
+
+func (r *VtexCollectionItemsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the set of SKUs inside a collection, through a subcollection owned by this resource. Only membership changes are sent to VTEX, in bulk.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier in the format collection_id:subcollection_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Collection ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subcollection_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("Managed by Terraform"),
+				Description: "Name of the subcollection that holds the SKUs",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subcollection_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the subcollection that holds the SKUs",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sku_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int64Type,
+				Description: "SKU IDs in the collection",
+			},
+		},
+	}
+}
+
+func (r *VtexCollectionItemsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexCollectionItemsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexCollectionItemsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID, err := parseNumericID(data.CollectionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("collection_id"), "Invalid VTEX Collection ID", err.Error())
+		return
+	}
+
+	var skuIDs []int64
+	resp.Diagnostics.Append(data.SKUIDs.ElementsAs(ctx, &skuIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX collection items", map[string]interface{}{
+		"collection_id": collectionID,
+		"count":         len(skuIDs),
+	})
+
+	subcollection, err := r.client.CreateSubcollection(ctx, client.Subcollection{
+		CollectionID: collectionID,
+		Name:         data.SubcollectionName.ValueString(),
+		Type:         "Inclusive",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Collection Items",
+			"Could not create subcollection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.SubcollectionID = types.StringValue(strconv.FormatInt(subcollection.ID, 10))
+	data.ID = types.StringValue(encodeID(data.CollectionID.ValueString(), data.SubcollectionID.ValueString()))
+
+	err = inCollectionImportChunks(skuIDs, func(chunk []int64) error {
+		return r.client.ImportCollectionSKUs(ctx, collectionID, chunk)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Collection Items",
+			"Could not add SKUs to the collection, unexpected error: "+err.Error(),
+		)
+		// Keep the subcollection in state so it is not orphaned, the next apply adds the rest
+		data.SKUIDs = types.SetValueMust(types.Int64Type, nil)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Trace(ctx, "Created VTEX collection items", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCollectionItemsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexCollectionItemsResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subcollectionID, err := parseNumericID(data.SubcollectionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Subcollection ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX collection items", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// The Catalog API does not list the SKUs of a subcollection,
+	// so only its existence is checked and the SKUs are kept from the state
+	subcollection, err := r.client.GetSubcollection(ctx, subcollectionID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX subcollection not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Collection Items",
+			"Could not read subcollection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.CollectionID = types.StringValue(strconv.FormatInt(subcollection.CollectionID, 10))
+	data.SubcollectionName = types.StringValue(subcollection.Name)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCollectionItemsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexCollectionItemsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID, err := parseNumericID(state.CollectionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("collection_id"), "Invalid VTEX Collection ID", err.Error())
+		return
+	}
+
+	var planned, current []int64
+	resp.Diagnostics.Append(plan.SKUIDs.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.SKUIDs.ElementsAs(ctx, &current, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toAdd := subtractInt64s(planned, current)
+	toRemove := subtractInt64s(current, planned)
+
+	tflog.Debug(ctx, "Updating VTEX collection items", map[string]interface{}{
+		"id":     state.ID.ValueString(),
+		"add":    len(toAdd),
+		"remove": len(toRemove),
+	})
+
+	err = inCollectionImportChunks(toAdd, func(chunk []int64) error {
+		return r.client.ImportCollectionSKUs(ctx, collectionID, chunk)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Collection Items",
+			"Could not add SKUs to the collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = inCollectionImportChunks(toRemove, func(chunk []int64) error {
+		err := r.client.ExcludeCollectionSKUs(ctx, collectionID, chunk)
+		if client.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Collection Items",
+			"Could not remove SKUs from the collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.SubcollectionID = state.SubcollectionID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexCollectionItemsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexCollectionItemsResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID, err := parseNumericID(data.CollectionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("collection_id"), "Invalid VTEX Collection ID", err.Error())
+		return
+	}
+
+	subcollectionID, err := parseNumericID(data.SubcollectionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Subcollection ID", err.Error())
+		return
+	}
+
+	var skuIDs []int64
+	resp.Diagnostics.Append(data.SKUIDs.ElementsAs(ctx, &skuIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX collection items", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Imported SKUs are not always kept in the subcollection, so they are excluded first
+	err = inCollectionImportChunks(skuIDs, func(chunk []int64) error {
+		err := r.client.ExcludeCollectionSKUs(ctx, collectionID, chunk)
+		if client.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Collection Items",
+			"Could not remove SKUs from the collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteSubcollection(ctx, subcollectionID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Collection Items",
+			"Could not delete subcollection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX collection items", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexCollectionItemsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: collection_id:subcollection_id
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 2 {
+		err = fmt.Errorf("expected collection_id:subcollection_id, got: %q", req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subcollection_id"), parts[1])...)
+	// SKUs cannot be listed, so the next apply adds every configured SKU again
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sku_ids"), types.SetValueMust(types.Int64Type, nil))...)
+}
+
+// inCollectionImportChunks calls fn with the SKU IDs in chunks of collectionImportChunkSize
+func inCollectionImportChunks(skuIDs []int64, fn func([]int64) error) error {
+	for start := 0; start < len(skuIDs); start += collectionImportChunkSize {
+		end := min(start+collectionImportChunkSize, len(skuIDs))
+		if err := fn(skuIDs[start:end]); err != nil {
+			return fmt.Errorf("SKUs %d to %d: %w", start+1, end, err)
+		}
+	}
+	return nil
+}
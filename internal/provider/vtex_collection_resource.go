@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexCollectionResource{}
+var _ resource.ResourceWithImportState = &VtexCollectionResource{}
+var _ resource.ResourceWithValidateConfig = &VtexCollectionResource{}
+
+func NewVtexCollectionResource() resource.Resource {
+	return &VtexCollectionResource{}
+}
+
+// VtexCollectionResource is the resource implementation
+type VtexCollectionResource struct {
+	client *client.VtexClient
+}
+
+// VtexCollectionResourceModel is the resource data model
+type VtexCollectionResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Searchable    types.Bool   `tfsdk:"searchable"`
+	Highlight     types.Bool   `tfsdk:"highlight"`
+	DateFrom      types.String `tfsdk:"date_from"`
+	DateTo        types.String `tfsdk:"date_to"`
+	TotalProducts types.Int64  `tfsdk:"total_products"`
+	Type          types.String `tfsdk:"type"`
+}
+
+func (r *VtexCollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+func (r *VtexCollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a catalog collection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Collection ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Collection name",
+			},
+			"searchable": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the collection can be searched in the store",
+			},
+			"highlight": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether products of the collection are flagged as highlighted",
+			},
+			"date_from": schema.StringAttribute{
+				Required:    true,
+				Description: "Start of the collection, as an RFC 3339 date",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+			"date_to": schema.StringAttribute{
+				Required:    true,
+				Description: "End of the collection, as an RFC 3339 date",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+			"total_products": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of products in the collection",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Collection type, set by VTEX (e.g. Manual, Automatic)",
+			},
+		},
+	}
+}
+
+func (r *VtexCollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexCollectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexCollectionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if data.DateFrom.IsUnknown() || data.DateTo.IsUnknown() || data.DateFrom.IsNull() || data.DateTo.IsNull() {
+		return
+	}
+
+	from, errFrom := parseDateTime(data.DateFrom.ValueString())
+	to, errTo := parseDateTime(data.DateTo.ValueString())
+	if errFrom == nil && errTo == nil && !to.After(from) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("date_to"),
+			"Invalid Collection Dates",
+			"date_to must be after date_from.",
+		)
+	}
+}
+
+func (r *VtexCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexCollectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX collection", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	result, err := r.client.CreateCollection(ctx, collectionFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Collection",
+			"Could not create collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	collectionToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX collection", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexCollectionResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Collection ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX collection", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	collection, err := r.client.GetCollection(ctx, collectionID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX collection not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Collection",
+			"Could not read collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	collectionToModel(collection, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexCollectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Collection ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX collection", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.UpdateCollection(ctx, collectionID, collectionFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Collection",
+			"Could not update collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	collectionToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexCollectionResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Collection ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX collection", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.client.DeleteCollection(ctx, collectionID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Collection",
+			"Could not delete collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX collection", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexCollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric collection ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// collectionFromModel builds the API payload from the resource model
+func collectionFromModel(data VtexCollectionResourceModel) client.Collection {
+	return client.Collection{
+		Name:       data.Name.ValueString(),
+		Searchable: data.Searchable.ValueBool(),
+		Highlight:  data.Highlight.ValueBool(),
+		DateFrom:   formatDateTime(data.DateFrom.ValueString()),
+		DateTo:     formatDateTime(data.DateTo.ValueString()),
+	}
+}
+
+// collectionToModel copies an API collection into the resource model
+func collectionToModel(collection *client.Collection, data *VtexCollectionResourceModel) {
+	data.ID = types.StringValue(strconv.FormatInt(collection.ID, 10))
+	data.Name = types.StringValue(collection.Name)
+	data.Searchable = types.BoolValue(collection.Searchable)
+	data.Highlight = types.BoolValue(collection.Highlight)
+	data.DateFrom = dateTimeValue(collection.DateFrom, data.DateFrom)
+	data.DateTo = dateTimeValue(collection.DateTo, data.DateTo)
+	data.TotalProducts = types.Int64Value(collection.TotalProducts)
+	data.Type = types.StringValue(collection.Type)
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexCollectionsDataSource{}
+
+func NewVtexCollectionsDataSource() datasource.DataSource {
+	return &VtexCollectionsDataSource{}
+}
+
+// VtexCollectionsDataSource is the data source implementation
+type VtexCollectionsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexCollectionsDataSourceModel is the data source data model
+type VtexCollectionsDataSourceModel struct {
+	ID          types.String              `tfsdk:"id"`
+	Search      types.String              `tfsdk:"search"`
+	Collections []VtexCollectionItemModel `tfsdk:"collections"`
+}
+
+// VtexCollectionItemModel is a collection in the list
+type VtexCollectionItemModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Active        types.Bool   `tfsdk:"active"`
+	Searchable    types.Bool   `tfsdk:"searchable"`
+	Highlight     types.Bool   `tfsdk:"highlight"`
+	DateFrom      types.String `tfsdk:"date_from"`
+	DateTo        types.String `tfsdk:"date_to"`
+	TotalProducts types.Int64  `tfsdk:"total_products"`
+}
+
+func (d *VtexCollectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collections"
+}
+
+func (d *VtexCollectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists catalog collections, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Search term used, or \"all\"",
+			},
+			"search": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list collections whose name matches this term",
+			},
+			"collections": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Collections found",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Collection ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Collection name",
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the current date is inside the collection date range",
+						},
+						"searchable": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the collection can be searched in the store",
+						},
+						"highlight": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the collection products are highlighted",
+						},
+						"date_from": schema.StringAttribute{
+							Computed:    true,
+							Description: "Start of the collection date range",
+						},
+						"date_to": schema.StringAttribute{
+							Computed:    true,
+							Description: "End of the collection date range",
+						},
+						"total_products": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of products in the collection",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexCollectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexCollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexCollectionsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing VTEX collections", map[string]interface{}{
+		"search": data.Search.ValueString(),
+	})
+
+	collections, err := d.client.ListCollections(ctx, data.Search.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Collections",
+			"Could not list collections, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	now := time.Now()
+	data.Collections = make([]VtexCollectionItemModel, 0, len(collections))
+	for _, collection := range collections {
+		data.Collections = append(data.Collections, VtexCollectionItemModel{
+			ID:            types.StringValue(strconv.FormatInt(collection.ID, 10)),
+			Name:          types.StringValue(collection.Name),
+			Active:        types.BoolValue(collectionActive(collection, now)),
+			Searchable:    types.BoolValue(collection.Searchable),
+			Highlight:     types.BoolValue(collection.Highlight),
+			DateFrom:      types.StringValue(collection.DateFrom),
+			DateTo:        types.StringValue(collection.DateTo),
+			TotalProducts: types.Int64Value(collection.TotalProducts),
+		})
+	}
+
+	data.ID = types.StringValue("all")
+	if data.Search.ValueString() != "" {
+		data.ID = data.Search
+	}
+
+	tflog.Trace(ctx, "Listed VTEX collections", map[string]interface{}{
+		"count": len(data.Collections),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// collectionActive reports whether now is inside the collection date range
+func collectionActive(collection client.Collection, now time.Time) bool {
+	from, errFrom := parseDateTime(collection.DateFrom)
+	to, errTo := parseDateTime(collection.DateTo)
+	if errFrom != nil || errTo != nil {
+		return false
+	}
+	return !now.Before(from) && now.Before(to)
+}
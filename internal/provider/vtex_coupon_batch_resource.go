@@ -0,0 +1,492 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexCouponBatchResource{}
+var _ resource.ResourceWithImportState = &VtexCouponBatchResource{}
+var _ resource.ResourceWithValidateConfig = &VtexCouponBatchResource{}
+var _ resource.ResourceWithModifyPlan = &VtexCouponBatchResource{}
+
+func NewVtexCouponBatchResource() resource.Resource {
+	return &VtexCouponBatchResource{}
+}
+
+// VtexCouponBatchResource is the resource implementation
+type VtexCouponBatchResource struct {
+	client *client.VtexClient
+}
+
+// VtexCouponBatchResourceModel is the resource data model
+type VtexCouponBatchResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	Prefix                   types.String   `tfsdk:"prefix"`
+	Quantity                 types.Int64    `tfsdk:"quantity"`
+	UtmSource                types.String   `tfsdk:"utm_source"`
+	UtmCampaign              types.String   `tfsdk:"utm_campaign"`
+	MaxItemsPerClient        types.Int64    `tfsdk:"max_items_per_client"`
+	ExpirationIntervalPerUse types.String   `tfsdk:"expiration_interval_per_use"`
+	OutputPath               types.String   `tfsdk:"output_path"`
+	ContentSHA256            types.String   `tfsdk:"content_sha256"`
+	Codes                    []types.String `tfsdk:"codes"`
+}
+
+func (r *VtexCouponBatchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coupon_batch"
+}
+
+func (r *VtexCouponBatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a batch of unique coupon codes that share a prefix and a configuration. The coupons are archived on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Prefix of the batch",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Required:    true,
+				Description: "Start of every generated code, e.g. BLACKFRIDAY. Changing it generates a new batch",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: couponCodePattern, name: "coupon code", example: "BLACKFRIDAY"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quantity": schema.Int64Attribute{
+				Required:    true,
+				Description: "Number of codes to generate. Changing it generates a new batch",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"utm_source": schema.StringAttribute{
+				Optional:    true,
+				Description: "UTM source the coupons set, used by promotions to recognize them",
+			},
+			"utm_campaign": schema.StringAttribute{
+				Optional:    true,
+				Description: "UTM campaign the coupons set, used by promotions to recognize them",
+			},
+			"max_items_per_client": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "How many times one shopper can use each coupon (default: 0, no limit)",
+			},
+			"expiration_interval_per_use": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("0s"),
+				Description: "How long a coupon stays applied after each use, as a Go duration (default: 0s, no limit)",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"output_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to write the codes to, one per line",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the written file, or null without output_path",
+			},
+			"codes": schema.ListAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Generated codes, sorted",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexCouponBatchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexCouponBatchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexCouponBatchResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Quantity.IsNull() && !data.Quantity.IsUnknown() && data.Quantity.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("quantity"),
+			"Invalid Quantity",
+			fmt.Sprintf("Expected a quantity of at least 1, got: %d", data.Quantity.ValueInt64()),
+		)
+	}
+	if !data.MaxItemsPerClient.IsNull() && !data.MaxItemsPerClient.IsUnknown() && data.MaxItemsPerClient.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_items_per_client"),
+			"Invalid Max Items Per Client",
+			fmt.Sprintf("Expected 0 or more, got: %d", data.MaxItemsPerClient.ValueInt64()),
+		)
+	}
+}
+
+func (r *VtexCouponBatchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var outputPath, sum types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("output_path"), &outputPath)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("content_sha256"), &sum)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The file could not be written last time, so the next apply writes it again
+	if !outputPath.IsNull() && sum.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringUnknown())...)
+	}
+}
+
+func (r *VtexCouponBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexCouponBatchResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Generating VTEX coupon batch", map[string]interface{}{
+		"prefix":   data.Prefix.ValueString(),
+		"quantity": data.Quantity.ValueInt64(),
+	})
+
+	configuration := couponFromModel(data, data.Prefix.ValueString())
+	codes, err := r.client.GenerateCoupons(ctx, data.Quantity.ValueInt64(), configuration)
+	if err != nil {
+		// Archive what was generated, so a failed batch does not leave usable coupons behind
+		if archiveErr := r.archiveCoupons(ctx, codes); archiveErr != nil {
+			tflog.Warn(ctx, "Could not archive the coupons of a failed VTEX coupon batch", map[string]interface{}{
+				"error": archiveErr.Error(),
+			})
+		}
+		// The call is not retried, since a lost response may still have generated coupons
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Coupon Batch",
+			"Could not generate coupons, unexpected error: "+err.Error()+
+				fmt.Sprintf("\n\nIf the API did not answer, coupons starting with %q may have been generated anyway. Check them, and import them, before applying again.", data.Prefix.ValueString()),
+		)
+		return
+	}
+	sort.Strings(codes)
+
+	data.ID = data.Prefix
+	data.Codes = stringValues(codes)
+	data.ContentSHA256 = types.StringNull()
+	if !data.OutputPath.IsNull() {
+		sum, err := writeCouponCodes(data.OutputPath.ValueString(), codes)
+		if err != nil {
+			// A warning, not an error: an error would taint the resource and the next apply would
+			// archive these coupons and generate others
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("output_path"),
+				"Error Writing Coupon Codes",
+				"The coupons were generated and are kept in state, but the file could not be written: "+err.Error()+
+					"\n\nThe next apply writes the file again.",
+			)
+		} else {
+			data.ContentSHA256 = types.StringValue(sum)
+		}
+	}
+
+	tflog.Trace(ctx, "Generated VTEX coupon batch", map[string]interface{}{
+		"id":    data.ID.ValueString(),
+		"count": len(codes),
+	})
+
+	// Save data into Terraform state, even when the file could not be written, so the coupons are tracked
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCouponBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexCouponBatchResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX coupon batch", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	coupons, err := r.client.ListCoupons(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Coupon Batch",
+			"Could not list coupons, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	byCode := make(map[string]client.Coupon, len(coupons))
+	for _, coupon := range coupons {
+		byCode[coupon.CouponCode] = coupon
+	}
+
+	var first *client.Coupon
+	for _, code := range data.Codes {
+		coupon, ok := byCode[code.ValueString()]
+		if ok && !coupon.IsArchived {
+			first = &coupon
+			break
+		}
+	}
+	if first == nil {
+		tflog.Warn(ctx, "VTEX coupon batch not found or archived, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// All coupons of the batch share the configuration, the first one stands for the rest
+	data.UtmSource = optionalString(first.UtmSource, data.UtmSource)
+	data.UtmCampaign = optionalString(first.UtmCampaign, data.UtmCampaign)
+	data.MaxItemsPerClient = types.Int64Value(first.MaxItemsPerClient)
+	data.ExpirationIntervalPerUse = timeSpanValue(first.ExpirationIntervalPerUse, data.ExpirationIntervalPerUse)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCouponBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexCouponBatchResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codes := make([]string, 0, len(state.Codes))
+	for _, code := range state.Codes {
+		codes = append(codes, code.ValueString())
+	}
+
+	tflog.Debug(ctx, "Updating VTEX coupon batch", map[string]interface{}{
+		"id":    state.ID.ValueString(),
+		"count": len(codes),
+	})
+
+	coupons := make([]client.Coupon, 0, len(codes))
+	for _, code := range codes {
+		coupons = append(coupons, couponFromModel(plan, code))
+	}
+	if err := r.client.SaveCoupons(ctx, coupons); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Coupon Batch",
+			"Could not update coupons, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Codes = state.Codes
+	plan.ContentSHA256 = types.StringNull()
+	if !plan.OutputPath.IsNull() {
+		sum, err := writeCouponCodes(plan.OutputPath.ValueString(), codes)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("output_path"), "Error Writing Coupon Codes", err.Error())
+			return
+		}
+		plan.ContentSHA256 = types.StringValue(sum)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexCouponBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexCouponBatchResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codes := make([]string, 0, len(data.Codes))
+	for _, code := range data.Codes {
+		codes = append(codes, code.ValueString())
+	}
+
+	// The Promotions API cannot delete coupons, so they are archived
+	tflog.Debug(ctx, "Archiving VTEX coupon batch", map[string]interface{}{
+		"id":    data.ID.ValueString(),
+		"count": len(codes),
+	})
+
+	if err := r.archiveCoupons(ctx, codes); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Coupon Batch",
+			"Could not archive coupons, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Archived VTEX coupon batch", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexCouponBatchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: prefix. The batch is every active coupon whose code starts with it
+	if !couponCodePattern.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: prefix, got: %s", req.ID),
+		)
+		return
+	}
+
+	coupons, err := r.client.ListCoupons(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing VTEX Coupon Batch",
+			"Could not list coupons, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var codes []string
+	for _, coupon := range coupons {
+		if !coupon.IsArchived && strings.HasPrefix(coupon.CouponCode, req.ID) {
+			codes = append(codes, coupon.CouponCode)
+		}
+	}
+	if len(codes) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Importing VTEX Coupon Batch",
+			fmt.Sprintf("No active coupons start with %q", req.ID),
+		)
+		return
+	}
+	sort.Strings(codes)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prefix"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("quantity"), int64(len(codes)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("codes"), codes)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("content_sha256"), types.StringNull())...)
+}
+
+// archiveCoupons archives the coupons by code, skipping the ones that no longer exist
+func (r *VtexCouponBatchResource) archiveCoupons(ctx context.Context, codes []string) error {
+	for _, code := range codes {
+		if err := r.client.ArchiveCoupon(ctx, code); err != nil && !client.IsNotFound(err) {
+			return fmt.Errorf("could not archive coupon %s: %w", code, err)
+		}
+	}
+	return nil
+}
+
+// couponFromModel builds the API coupon with the given code from the resource model
+func couponFromModel(data VtexCouponBatchResourceModel, code string) client.Coupon {
+	// The validator already checked the duration
+	interval, _ := time.ParseDuration(data.ExpirationIntervalPerUse.ValueString())
+
+	return client.Coupon{
+		CouponCode:               code,
+		UtmSource:                data.UtmSource.ValueString(),
+		UtmCampaign:              data.UtmCampaign.ValueString(),
+		MaxItemsPerClient:        data.MaxItemsPerClient.ValueInt64(),
+		ExpirationIntervalPerUse: formatTimeSpan(interval),
+	}
+}
+
+// writeCouponCodes writes the codes to a file, one per line, and returns its SHA-256.
+// It writes a temporary file next to the output first, so a failure never leaves a partial file.
+func writeCouponCodes(outputPath string, codes []string) (string, error) {
+	file, err := os.CreateTemp(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	hash := sha256.New()
+	out := io.MultiWriter(file, hash)
+	for _, code := range codes {
+		if _, err := io.WriteString(out, code+"\n"); err != nil {
+			return "", err
+		}
+	}
+
+	// Coupon codes work like vouchers, so only the owner can read the file
+	if err := file.Chmod(0o600); err != nil {
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Rename(file.Name(), outputPath); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
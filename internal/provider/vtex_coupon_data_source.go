@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexCouponDataSource{}
+
+func NewVtexCouponDataSource() datasource.DataSource {
+	return &VtexCouponDataSource{}
+}
+
+// VtexCouponDataSource is the data source implementation
+type VtexCouponDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexCouponDataSourceModel is the data source data model
+type VtexCouponDataSourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Code                     types.String `tfsdk:"code"`
+	UtmSource                types.String `tfsdk:"utm_source"`
+	UtmCampaign              types.String `tfsdk:"utm_campaign"`
+	Archived                 types.Bool   `tfsdk:"archived"`
+	MaxItemsPerClient        types.Int64  `tfsdk:"max_items_per_client"`
+	ExpirationIntervalPerUse types.String `tfsdk:"expiration_interval_per_use"`
+	UsageCount               types.Int64  `tfsdk:"usage_count"`
+}
+
+func (d *VtexCouponDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coupon"
+}
+
+func (d *VtexCouponDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a coupon by code, with how many times it was used.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Coupon code",
+			},
+			"code": schema.StringAttribute{
+				Required:    true,
+				Description: "Coupon code to read",
+			},
+			"utm_source": schema.StringAttribute{
+				Computed:    true,
+				Description: "UTM source the coupon sets",
+			},
+			"utm_campaign": schema.StringAttribute{
+				Computed:    true,
+				Description: "UTM campaign the coupon sets",
+			},
+			"archived": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the coupon is archived and can no longer be used",
+			},
+			"max_items_per_client": schema.Int64Attribute{
+				Computed:    true,
+				Description: "How many times one shopper can use the coupon (0: no limit)",
+			},
+			"expiration_interval_per_use": schema.StringAttribute{
+				Computed:    true,
+				Description: "How long the coupon stays applied after each use, as a Go duration (0s: no limit)",
+			},
+			"usage_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of orders that used the coupon",
+			},
+		},
+	}
+}
+
+func (d *VtexCouponDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexCouponDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexCouponDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	code := data.Code.ValueString()
+
+	tflog.Debug(ctx, "Reading VTEX coupon", map[string]interface{}{
+		"code": code,
+	})
+
+	coupon, err := d.client.GetCoupon(ctx, code)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("code"),
+			"VTEX Coupon Not Found",
+			fmt.Sprintf("No coupon with code %q in the account", code),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Coupon",
+			"Could not read coupon, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// A coupon nobody used yet may have no usage record
+	var usage int64
+	couponUsage, err := d.client.GetCouponUsage(ctx, code)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Coupon",
+			"Could not read coupon usage, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err == nil {
+		usage = couponUsage.Usage
+	}
+
+	data.ID = types.StringValue(code)
+	data.UtmSource = types.StringValue(coupon.UtmSource)
+	data.UtmCampaign = types.StringValue(coupon.UtmCampaign)
+	data.Archived = types.BoolValue(coupon.IsArchived)
+	data.MaxItemsPerClient = types.Int64Value(coupon.MaxItemsPerClient)
+	data.ExpirationIntervalPerUse = timeSpanValue(coupon.ExpirationIntervalPerUse, types.StringNull())
+	data.UsageCount = types.Int64Value(usage)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexCustomPaymentResource{}
+var _ resource.ResourceWithImportState = &VtexCustomPaymentResource{}
+var _ resource.ResourceWithValidateConfig = &VtexCustomPaymentResource{}
+
+func NewVtexCustomPaymentResource() resource.Resource {
+	return &VtexCustomPaymentResource{}
+}
+
+// VtexCustomPaymentResource is the resource implementation
+type VtexCustomPaymentResource struct {
+	client *client.VtexClient
+}
+
+// VtexCustomPaymentResourceModel is the resource data model
+type VtexCustomPaymentResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	Type                  types.String `tfsdk:"type"`
+	ExpirationDays        types.Int64  `tfsdk:"expiration_days"`
+	RequiresAuthorization types.Bool   `tfsdk:"requires_authorization"`
+	PaymentSystemID       types.String `tfsdk:"payment_system_id"`
+}
+
+func (r *VtexCustomPaymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_payment"
+}
+
+func (r *VtexCustomPaymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom payment of the Payments Gateway, like a promissory note for B2B buyers who pay on account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Custom payment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name shown to the shopper at checkout, e.g. Pay on account",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description shown to the shopper at checkout",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.CustomPaymentPromissory),
+				Description: "Kind of custom payment: promissory, privateLabel or cobranded (default: promissory). Changing it creates a new custom payment",
+				Validators: []validator.String{
+					stringOneOfValidator{values: []string{client.CustomPaymentPromissory, client.CustomPaymentPrivateLabel, client.CustomPaymentCobranded}},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_days": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Days the shopper has to pay before the order is canceled (default: 0, no limit)",
+			},
+			"requires_authorization": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether an operator must approve each payment before the order goes on",
+			},
+			"payment_system_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the payment system the gateway created for the custom payment, used by payment rules",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexCustomPaymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexCustomPaymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexCustomPaymentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.ExpirationDays) && data.ExpirationDays.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiration_days"),
+			"Invalid Expiration Days",
+			fmt.Sprintf("Expected 0 or more days, got: %d", data.ExpirationDays.ValueInt64()),
+		)
+	}
+}
+
+func (r *VtexCustomPaymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexCustomPaymentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX custom payment", map[string]interface{}{
+		"name": data.Name.ValueString(),
+		"type": data.Type.ValueString(),
+	})
+
+	result, err := r.client.CreateCustomPayment(ctx, customPaymentFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Custom Payment",
+			"Could not create custom payment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	customPaymentToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX custom payment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCustomPaymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexCustomPaymentResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX custom payment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	payment, err := r.client.GetCustomPayment(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX custom payment not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Custom Payment",
+			"Could not read custom payment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	customPaymentToModel(payment, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCustomPaymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexCustomPaymentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX custom payment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.UpdateCustomPayment(ctx, data.ID.ValueString(), customPaymentFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Custom Payment",
+			"Could not update custom payment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	customPaymentToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexCustomPaymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexCustomPaymentResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX custom payment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteCustomPayment(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Custom Payment",
+			"Could not delete custom payment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX custom payment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexCustomPaymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: custom payment ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// customPaymentFromModel builds the API payload from the resource model
+func customPaymentFromModel(data VtexCustomPaymentResourceModel) client.CustomPayment {
+	return client.CustomPayment{
+		Name:                  data.Name.ValueString(),
+		Description:           data.Description.ValueString(),
+		Type:                  data.Type.ValueString(),
+		DaysToExpire:          data.ExpirationDays.ValueInt64(),
+		RequiresAuthorization: data.RequiresAuthorization.ValueBool(),
+	}
+}
+
+// customPaymentToModel copies an API custom payment into the resource model
+func customPaymentToModel(payment *client.CustomPayment, data *VtexCustomPaymentResourceModel) {
+	data.ID = types.StringValue(payment.ID)
+	data.Name = types.StringValue(payment.Name)
+	data.Description = optionalString(payment.Description, data.Description)
+	data.Type = types.StringValue(payment.Type)
+	data.ExpirationDays = types.Int64Value(payment.DaysToExpire)
+	data.RequiresAuthorization = types.BoolValue(payment.RequiresAuthorization)
+	data.PaymentSystemID = types.StringValue(strconv.FormatInt(payment.PaymentSystemID, 10))
+}
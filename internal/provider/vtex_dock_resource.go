@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexDockResource{}
+var _ resource.ResourceWithImportState = &VtexDockResource{}
+
+func NewVtexDockResource() resource.Resource {
+	return &VtexDockResource{}
+}
+
+// VtexDockResource is the resource implementation
+type VtexDockResource struct {
+	client *client.VtexClient
+}
+
+// VtexDockResourceModel is the resource data model
+type VtexDockResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	DockID            types.String `tfsdk:"dock_id"`
+	Name              types.String `tfsdk:"name"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	TimeOverhead      types.String `tfsdk:"time_overhead"`
+	SalesChannels     types.Set    `tfsdk:"sales_channels"`
+	ShippingPolicyIDs types.Set    `tfsdk:"shipping_policy_ids"`
+	WMSEndpoint       types.String `tfsdk:"wms_endpoint"`
+	PickupStore       types.Bool   `tfsdk:"pickup_store"`
+	PickupStoreName   types.String `tfsdk:"pickup_store_name"`
+}
+
+func (r *VtexDockResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dock"
+}
+
+func (r *VtexDockResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Logistics loading dock, which connects warehouses to shipping policies for some trade policies.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Dock ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dock_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Dock ID, chosen by the account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Dock name",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Priority of the dock when more than one can ship an order (lower first)",
+			},
+			"time_overhead": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("0s"),
+				Description: "Time added to the delivery estimate for orders to leave the dock, as a Go duration like 24h",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"sales_channels": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the trade policies (sales channels) the dock serves",
+			},
+			"shipping_policy_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the shipping policies (freight tables) the dock ships with",
+			},
+			"wms_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "URL of the warehouse management system that receives the dock's orders",
+			},
+			"pickup_store": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the dock is a store where shoppers pick up their orders",
+			},
+			"pickup_store_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Store name shown to shoppers when pickup_store is true",
+			},
+		},
+	}
+}
+
+func (r *VtexDockResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexDockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexDockResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dock, diags := dockFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX dock", map[string]interface{}{
+		"dock_id": dock.ID,
+		"name":    dock.Name,
+	})
+
+	result, err := r.saveDock(ctx, dock)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Dock",
+			"Could not create dock, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(dockToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX dock", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexDockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexDockResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX dock", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	dock, err := r.client.GetDock(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX dock not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Dock",
+			"Could not read dock, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(dockToModel(ctx, dock, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexDockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexDockResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dock, diags := dockFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX dock", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.saveDock(ctx, dock)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Dock",
+			"Could not update dock, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(dockToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexDockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexDockResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX dock", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteDock(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Dock",
+			"Could not delete dock, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX dock", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexDockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: dock ID
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dock_id"), req.ID)...)
+}
+
+// saveDock creates or updates a dock and reads it back, since the save call returns no body
+func (r *VtexDockResource) saveDock(ctx context.Context, dock client.Dock) (*client.Dock, error) {
+	if err := r.client.SaveDock(ctx, dock); err != nil {
+		return nil, err
+	}
+	return r.client.GetDock(ctx, dock.ID)
+}
+
+// dockFromModel builds the API payload from the resource model
+func dockFromModel(ctx context.Context, data VtexDockResourceModel) (client.Dock, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The validator already checked the duration
+	overhead, _ := time.ParseDuration(data.TimeOverhead.ValueString())
+
+	salesChannels := []string{}
+	diags.Append(data.SalesChannels.ElementsAs(ctx, &salesChannels, false)...)
+	shippingPolicyIDs := []string{}
+	if !data.ShippingPolicyIDs.IsNull() {
+		diags.Append(data.ShippingPolicyIDs.ElementsAs(ctx, &shippingPolicyIDs, false)...)
+	}
+
+	dock := client.Dock{
+		ID:              data.DockID.ValueString(),
+		Name:            data.Name.ValueString(),
+		Priority:        data.Priority.ValueInt64(),
+		DockTimeFake:    formatTimeSpan(overhead),
+		SalesChannels:   salesChannels,
+		FreightTableIDs: shippingPolicyIDs,
+		WMSEndPoint:     data.WMSEndpoint.ValueString(),
+		PickupStoreInfo: &client.DockPickupStoreInfo{
+			IsPickupStore: data.PickupStore.ValueBool(),
+			FriendlyName:  data.PickupStoreName.ValueString(),
+			DockID:        data.DockID.ValueString(),
+		},
+	}
+	return dock, diags
+}
+
+// dockToModel copies an API dock into the resource model
+func dockToModel(ctx context.Context, dock *client.Dock, data *VtexDockResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(dock.ID)
+	data.DockID = types.StringValue(dock.ID)
+	data.Name = types.StringValue(dock.Name)
+	data.Priority = types.Int64Value(dock.Priority)
+	data.TimeOverhead = timeSpanValue(dock.DockTimeFake, data.TimeOverhead)
+	data.WMSEndpoint = types.StringValue(dock.WMSEndPoint)
+
+	salesChannels, setDiags := types.SetValueFrom(ctx, types.StringType, dock.SalesChannels)
+	diags.Append(setDiags...)
+	data.SalesChannels = salesChannels
+
+	shippingPolicyIDs, setDiags := optionalStringSet(ctx, dock.FreightTableIDs, data.ShippingPolicyIDs)
+	diags.Append(setDiags...)
+	data.ShippingPolicyIDs = shippingPolicyIDs
+
+	data.PickupStore = types.BoolValue(false)
+	data.PickupStoreName = optionalString("", data.PickupStoreName)
+	if dock.PickupStoreInfo != nil {
+		data.PickupStore = types.BoolValue(dock.PickupStoreInfo.IsPickupStore)
+		data.PickupStoreName = optionalString(dock.PickupStoreInfo.FriendlyName, data.PickupStoreName)
+	}
+	return diags
+}
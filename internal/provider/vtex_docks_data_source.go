@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexDocksDataSource{}
+
+func NewVtexDocksDataSource() datasource.DataSource {
+	return &VtexDocksDataSource{}
+}
+
+// VtexDocksDataSource is the data source implementation
+type VtexDocksDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexDocksDataSourceModel is the data source data model
+type VtexDocksDataSourceModel struct {
+	ID           types.String        `tfsdk:"id"`
+	Name         types.String        `tfsdk:"name"`
+	SalesChannel types.String        `tfsdk:"sales_channel"`
+	Docks        []VtexDockItemModel `tfsdk:"docks"`
+}
+
+// VtexDockItemModel is a dock in the list
+type VtexDockItemModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Priority          types.Int64    `tfsdk:"priority"`
+	TimeOverhead      types.String   `tfsdk:"time_overhead"`
+	SalesChannels     []types.String `tfsdk:"sales_channels"`
+	ShippingPolicyIDs []types.String `tfsdk:"shipping_policy_ids"`
+	PickupStore       types.Bool     `tfsdk:"pickup_store"`
+}
+
+func (d *VtexDocksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_docks"
+}
+
+func (d *VtexDocksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Logistics docks of the account with their shipping policies and trade policies.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Filters used, or \"all\"",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list docks with this name",
+			},
+			"sales_channel": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list docks serving this trade policy (sales channel) ID",
+			},
+			"docks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Docks found",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Dock ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Dock name",
+						},
+						"priority": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Priority of the dock when more than one can ship an order",
+						},
+						"time_overhead": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time added to the delivery estimate, as a Go duration",
+						},
+						"sales_channels": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the trade policies the dock serves",
+						},
+						"shipping_policy_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the shipping policies the dock ships with",
+						},
+						"pickup_store": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the dock is a pickup store",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexDocksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexDocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexDocksDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	salesChannel := data.SalesChannel.ValueString()
+
+	tflog.Debug(ctx, "Listing VTEX docks", map[string]interface{}{
+		"name":          name,
+		"sales_channel": salesChannel,
+	})
+
+	docks, err := d.client.ListDocks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Docks",
+			"Could not list docks, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Docks = make([]VtexDockItemModel, 0, len(docks))
+	for _, dock := range docks {
+		if name != "" && dock.Name != name {
+			continue
+		}
+		if salesChannel != "" && !slices.Contains(dock.SalesChannels, salesChannel) {
+			continue
+		}
+
+		data.Docks = append(data.Docks, VtexDockItemModel{
+			ID:                types.StringValue(dock.ID),
+			Name:              types.StringValue(dock.Name),
+			Priority:          types.Int64Value(dock.Priority),
+			TimeOverhead:      timeSpanValue(dock.DockTimeFake, types.StringNull()),
+			SalesChannels:     stringValues(dock.SalesChannels),
+			ShippingPolicyIDs: stringValues(dock.FreightTableIDs),
+			PickupStore:       types.BoolValue(dock.PickupStoreInfo != nil && dock.PickupStoreInfo.IsPickupStore),
+		})
+	}
+
+	data.ID = types.StringValue("all")
+	if name != "" || salesChannel != "" {
+		data.ID = types.StringValue(encodeID(name, salesChannel))
+	}
+
+	tflog.Trace(ctx, "Listed VTEX docks", map[string]interface{}{
+		"count": len(data.Docks),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
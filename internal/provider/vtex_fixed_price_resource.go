@@ -0,0 +1,496 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexFixedPriceResource{}
+var _ resource.ResourceWithImportState = &VtexFixedPriceResource{}
+var _ resource.ResourceWithValidateConfig = &VtexFixedPriceResource{}
+
+func NewVtexFixedPriceResource() resource.Resource {
+	return &VtexFixedPriceResource{}
+}
+
+// VtexFixedPriceResource is the resource implementation
+type VtexFixedPriceResource struct {
+	client *client.VtexClient
+}
+
+// VtexFixedPriceResourceModel is the resource data model
+type VtexFixedPriceResourceModel struct {
+	ID            types.String               `tfsdk:"id"`
+	SKUID         types.String               `tfsdk:"sku_id"`
+	TradePolicyID types.String               `tfsdk:"trade_policy_id"`
+	Prices        []VtexFixedPriceValueModel `tfsdk:"prices"`
+}
+
+// VtexFixedPriceValueModel is a fixed price for a minimum quantity and date range
+type VtexFixedPriceValueModel struct {
+	Value       types.Float64 `tfsdk:"value"`
+	ListPrice   types.Float64 `tfsdk:"list_price"`
+	MinQuantity types.Int64   `tfsdk:"min_quantity"`
+	DateFrom    types.String  `tfsdk:"date_from"`
+	DateTo      types.String  `tfsdk:"date_to"`
+}
+
+func (r *VtexFixedPriceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fixed_price"
+}
+
+func (r *VtexFixedPriceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the fixed prices of a SKU in a trade policy, with quantity tiers and scheduled date ranges.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Fixed price ID (sku_id:trade_policy_id)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sku_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the SKU to price",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trade_policy_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the trade policy (price table) the prices apply to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prices": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Fixed prices, unique by min_quantity and date range",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.Float64Attribute{
+							Required:    true,
+							Description: "Selling price",
+						},
+						"list_price": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Suggested retail price, shown crossed out next to the selling price",
+						},
+						"min_quantity": schema.Int64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(1),
+							Description: "Minimum quantity in the cart for the price to apply",
+						},
+						"date_from": schema.StringAttribute{
+							Optional:    true,
+							Description: "Start of the price, as an RFC 3339 date. Requires date_to",
+							Validators: []validator.String{
+								dateTimeValidator{},
+							},
+						},
+						"date_to": schema.StringAttribute{
+							Optional:    true,
+							Description: "End of the price, as an RFC 3339 date. Requires date_from",
+							Validators: []validator.String{
+								dateTimeValidator{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexFixedPriceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexFixedPriceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var prices []VtexFixedPriceValueModel
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("prices"), &prices)...)
+
+	if resp.Diagnostics.HasError() || prices == nil {
+		return
+	}
+
+	if len(prices) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("prices"),
+			"Missing Fixed Prices",
+			"At least one price is required. Remove the resource to delete the fixed prices of the trade policy.",
+		)
+		return
+	}
+
+	seen := make(map[string]int, len(prices))
+	for i, price := range prices {
+		if price.DateFrom.IsUnknown() || price.DateTo.IsUnknown() || price.MinQuantity.IsUnknown() {
+			continue
+		}
+		if price.DateFrom.IsNull() != price.DateTo.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prices").AtListIndex(i),
+				"Incomplete Date Range",
+				"date_from and date_to must be set together.",
+			)
+			continue
+		}
+
+		// Null min_quantity in the config takes the default of 1
+		minQuantity := price.MinQuantity
+		if minQuantity.IsNull() {
+			minQuantity = types.Int64Value(1)
+		}
+		key := fixedPriceKey(minQuantity.ValueInt64(), price.DateFrom.ValueString(), price.DateTo.ValueString())
+		if first, ok := seen[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prices").AtListIndex(i),
+				"Duplicate Fixed Price",
+				fmt.Sprintf("Price %d has the same min_quantity and date range as price %d.", i, first),
+			)
+			continue
+		}
+		seen[key] = i
+	}
+}
+
+func (r *VtexFixedPriceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexFixedPriceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, err := parseNumericID(data.SKUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sku_id"), "Invalid SKU ID", err.Error())
+		return
+	}
+	tradePolicyID := data.TradePolicyID.ValueString()
+
+	tflog.Debug(ctx, "Creating VTEX fixed prices", map[string]interface{}{
+		"sku_id":          skuID,
+		"trade_policy_id": tradePolicyID,
+		"prices":          len(data.Prices),
+	})
+
+	err = r.client.PutFixedPrices(ctx, skuID, tradePolicyID, fixedPricesFromModel(data.Prices))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Fixed Price",
+			"Could not create fixed prices, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	prices, err := r.client.ListFixedPrices(ctx, skuID, tradePolicyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Fixed Price",
+			"Could not read fixed prices after creating them, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(encodeID(strconv.FormatInt(skuID, 10), tradePolicyID))
+	fixedPricesToModel(prices, &data)
+
+	tflog.Trace(ctx, "Created VTEX fixed prices", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexFixedPriceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexFixedPriceResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, tradePolicyID, diags := fixedPriceIDFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX fixed prices", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	prices, err := r.client.ListFixedPrices(ctx, skuID, tradePolicyID)
+	if client.IsNotFound(err) || (err == nil && len(prices) == 0) {
+		tflog.Warn(ctx, "VTEX fixed prices not found, removing them from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Fixed Price",
+			"Could not read fixed prices, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	fixedPricesToModel(prices, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexFixedPriceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexFixedPriceResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, tradePolicyID, diags := fixedPriceIDFromModel(state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Posting prices only upserts them, so removing a tier needs the trade policy cleared first
+	planned := make(map[string]bool, len(plan.Prices))
+	for _, price := range plan.Prices {
+		planned[fixedPriceKey(price.MinQuantity.ValueInt64(), price.DateFrom.ValueString(), price.DateTo.ValueString())] = true
+	}
+	removed := false
+	for _, price := range state.Prices {
+		if !planned[fixedPriceKey(price.MinQuantity.ValueInt64(), price.DateFrom.ValueString(), price.DateTo.ValueString())] {
+			removed = true
+		}
+	}
+
+	tflog.Debug(ctx, "Updating VTEX fixed prices", map[string]interface{}{
+		"id":      state.ID.ValueString(),
+		"prices":  len(plan.Prices),
+		"removed": removed,
+	})
+
+	if removed {
+		err := r.client.DeleteFixedPrices(ctx, skuID, tradePolicyID)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Updating VTEX Fixed Price",
+				"Could not delete removed fixed prices, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err := r.client.PutFixedPrices(ctx, skuID, tradePolicyID, fixedPricesFromModel(plan.Prices))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Fixed Price",
+			"Could not update fixed prices, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	prices, err := r.client.ListFixedPrices(ctx, skuID, tradePolicyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Fixed Price",
+			"Could not read fixed prices after updating them, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	fixedPricesToModel(prices, &plan)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexFixedPriceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexFixedPriceResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, tradePolicyID, diags := fixedPriceIDFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX fixed prices", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteFixedPrices(ctx, skuID, tradePolicyID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Fixed Price",
+			"Could not delete fixed prices, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX fixed prices", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexFixedPriceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: sku_id:trade_policy_id
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 2 {
+		err = fmt.Errorf("expected sku_id:trade_policy_id, got: %q", req.ID)
+	}
+	if err == nil {
+		_, err = parseNumericID(parts[0])
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sku_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("trade_policy_id"), parts[1])...)
+}
+
+// fixedPriceIDFromModel parses the SKU and trade policy of the resource
+func fixedPriceIDFromModel(data VtexFixedPriceResourceModel) (int64, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	skuID, err := parseNumericID(data.SKUID.ValueString())
+	if err != nil {
+		diags.AddError("Invalid VTEX Fixed Price ID", err.Error())
+		return 0, "", diags
+	}
+	return skuID, data.TradePolicyID.ValueString(), diags
+}
+
+// fixedPriceKey identifies a fixed price of a trade policy by minimum quantity and date range.
+// Dates are compared as instants, so different notations of the same date match.
+func fixedPriceKey(minQuantity int64, dateFrom, dateTo string) string {
+	return fmt.Sprintf("%d|%s|%s", minQuantity, formatDateTimeRFC3339(dateFrom), formatDateTimeRFC3339(dateTo))
+}
+
+// fixedPricesFromModel builds the API payload from the resource model
+func fixedPricesFromModel(prices []VtexFixedPriceValueModel) []client.FixedPrice {
+	result := make([]client.FixedPrice, 0, len(prices))
+	for _, price := range prices {
+		fixedPrice := client.FixedPrice{
+			Value:       price.Value.ValueFloat64(),
+			ListPrice:   price.ListPrice.ValueFloat64Pointer(),
+			MinQuantity: price.MinQuantity.ValueInt64(),
+		}
+		if !price.DateFrom.IsNull() {
+			fixedPrice.DateRange = &client.PriceDateRange{
+				From: formatDateTimeRFC3339(price.DateFrom.ValueString()),
+				To:   formatDateTimeRFC3339(price.DateTo.ValueString()),
+			}
+		}
+		result = append(result, fixedPrice)
+	}
+	return result
+}
+
+// fixedPricesToModel copies the API fixed prices into the resource model.
+// Prices keep the order of the model, and prices missing from it are added at the end.
+func fixedPricesToModel(prices []client.FixedPrice, data *VtexFixedPriceResourceModel) {
+	byKey := make(map[string]client.FixedPrice, len(prices))
+	order := make([]string, 0, len(prices))
+	for _, price := range prices {
+		var from, to string
+		if price.DateRange != nil {
+			from, to = price.DateRange.From, price.DateRange.To
+		}
+		key := fixedPriceKey(price.MinQuantity, from, to)
+		byKey[key] = price
+		order = append(order, key)
+	}
+
+	current := make(map[string]VtexFixedPriceValueModel, len(data.Prices))
+	keys := make([]string, 0, len(prices))
+	for _, price := range data.Prices {
+		key := fixedPriceKey(price.MinQuantity.ValueInt64(), price.DateFrom.ValueString(), price.DateTo.ValueString())
+		if _, ok := byKey[key]; ok {
+			current[key] = price
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range order {
+		if _, ok := current[key]; !ok {
+			current[key] = VtexFixedPriceValueModel{DateFrom: types.StringNull(), DateTo: types.StringNull()}
+			keys = append(keys, key)
+		}
+	}
+
+	data.Prices = make([]VtexFixedPriceValueModel, 0, len(keys))
+	for _, key := range keys {
+		price := byKey[key]
+		model := VtexFixedPriceValueModel{
+			Value:       types.Float64Value(price.Value),
+			ListPrice:   types.Float64PointerValue(price.ListPrice),
+			MinQuantity: types.Int64Value(price.MinQuantity),
+			DateFrom:    types.StringNull(),
+			DateTo:      types.StringNull(),
+		}
+		if price.DateRange != nil {
+			model.DateFrom = dateTimeValue(price.DateRange.From, current[key].DateFrom)
+			model.DateTo = dateTimeValue(price.DateRange.To, current[key].DateTo)
+		}
+		data.Prices = append(data.Prices, model)
+	}
+}
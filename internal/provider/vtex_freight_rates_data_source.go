@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexFreightRatesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexFreightRatesDataSource{}
+
+func NewVtexFreightRatesDataSource() datasource.DataSource {
+	return &VtexFreightRatesDataSource{}
+}
+
+// VtexFreightRatesDataSource is the data source implementation
+type VtexFreightRatesDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexFreightRatesDataSourceModel is the data source data model
+type VtexFreightRatesDataSourceModel struct {
+	ID               types.String           `tfsdk:"id"`
+	ShippingPolicyID types.String           `tfsdk:"shipping_policy_id"`
+	PostalCodes      []types.String         `tfsdk:"postal_codes"`
+	Rates            []VtexFreightRateModel `tfsdk:"rates"`
+}
+
+func (d *VtexFreightRatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_freight_rates"
+}
+
+func (d *VtexFreightRatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the freight table rows of a shipping policy that cover some postal codes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Shipping policy ID and postal codes",
+			},
+			"shipping_policy_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Shipping policy (freight table) ID",
+			},
+			"postal_codes": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Postal codes to look up. Rows covering any of them are returned once",
+			},
+			"rates": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Freight table rows covering the postal codes, in the order of postal_codes",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"country": schema.StringAttribute{
+							Computed:    true,
+							Description: "Three-letter country code",
+						},
+						"zip_code_start": schema.StringAttribute{
+							Computed:    true,
+							Description: "First postal code of the range",
+						},
+						"zip_code_end": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last postal code of the range",
+						},
+						"weight_start": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Minimum weight, in grams",
+						},
+						"weight_end": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Maximum weight, in grams",
+						},
+						"price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Fixed freight price",
+						},
+						"price_percent": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Percentage of the order value added to the price",
+						},
+						"price_percent_by_weight": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Price added per kilogram",
+						},
+						"max_volume": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Maximum volume, in cubic centimeters",
+						},
+						"transit_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Transit time, as a Go duration",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexFreightRatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexFreightRatesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexFreightRatesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PostalCodes != nil && len(data.PostalCodes) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("postal_codes"),
+			"Missing Postal Codes",
+			"At least one postal code is required.",
+		)
+	}
+}
+
+func (d *VtexFreightRatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexFreightRatesDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shippingPolicyID := data.ShippingPolicyID.ValueString()
+	postalCodes := make([]string, 0, len(data.PostalCodes))
+	for _, postalCode := range data.PostalCodes {
+		postalCodes = append(postalCodes, postalCode.ValueString())
+	}
+
+	tflog.Debug(ctx, "Reading VTEX freight rates", map[string]interface{}{
+		"shipping_policy_id": shippingPolicyID,
+		"postal_codes":       len(postalCodes),
+	})
+
+	seen := map[string]bool{}
+	data.Rates = make([]VtexFreightRateModel, 0)
+	for _, postalCode := range postalCodes {
+		values, err := d.client.GetFreightValues(ctx, shippingPolicyID, postalCode)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX Freight Rates",
+				fmt.Sprintf("Could not read freight rates for postal code %s, unexpected error: %s", postalCode, err),
+			)
+			return
+		}
+
+		for _, value := range values {
+			// Neighbouring postal codes usually fall in the same rows
+			key := freightRowKey(freightTableRow{
+				Country:      value.Country,
+				ZipCodeStart: value.ZipCodeStart,
+				ZipCodeEnd:   value.ZipCodeEnd,
+				WeightStart:  value.WeightStart,
+				WeightEnd:    value.WeightEnd,
+			})
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			data.Rates = append(data.Rates, VtexFreightRateModel{
+				Country:              types.StringValue(value.Country),
+				ZipCodeStart:         types.StringValue(value.ZipCodeStart),
+				ZipCodeEnd:           types.StringValue(value.ZipCodeEnd),
+				WeightStart:          types.Float64Value(value.WeightStart),
+				WeightEnd:            types.Float64Value(value.WeightEnd),
+				Price:                types.Float64Value(value.AbsoluteMoneyCost),
+				PricePercent:         types.Float64Value(value.PricePercent),
+				PricePercentByWeight: types.Float64Value(value.PricePercentByWeight),
+				MaxVolume:            types.Float64Value(value.MaxVolume),
+				TransitTime:          timeSpanValue(value.TimeCost, types.StringNull()),
+			})
+		}
+	}
+
+	data.ID = types.StringValue(encodeID(append([]string{shippingPolicyID}, postalCodes...)...))
+
+	tflog.Trace(ctx, "Read VTEX freight rates", map[string]interface{}{
+		"count": len(data.Rates),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
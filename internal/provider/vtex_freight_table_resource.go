@@ -0,0 +1,849 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexFreightTableResource{}
+var _ resource.ResourceWithImportState = &VtexFreightTableResource{}
+var _ resource.ResourceWithValidateConfig = &VtexFreightTableResource{}
+var _ resource.ResourceWithModifyPlan = &VtexFreightTableResource{}
+
+// freightTableChunkSize is the most rows sent in one freight values request
+const freightTableChunkSize = 1000
+
+// freightMaxVolumeDefault is the max volume VTEX uses for rows without a volume limit
+const freightMaxVolumeDefault = 1000000000
+
+func NewVtexFreightTableResource() resource.Resource {
+	return &VtexFreightTableResource{}
+}
+
+// VtexFreightTableResource is the resource implementation
+type VtexFreightTableResource struct {
+	client *client.VtexClient
+}
+
+// VtexFreightTableResourceModel is the resource data model
+type VtexFreightTableResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ShippingPolicyID types.String `tfsdk:"shipping_policy_id"`
+	Path             types.String `tfsdk:"path"`
+	Content          types.String `tfsdk:"content"`
+	Format           types.String `tfsdk:"format"`
+	ContentSHA256    types.String `tfsdk:"content_sha256"`
+	Rates            types.List   `tfsdk:"rates"`
+}
+
+// VtexFreightRateModel is a row of the freight table
+type VtexFreightRateModel struct {
+	Country              types.String  `tfsdk:"country"`
+	ZipCodeStart         types.String  `tfsdk:"zip_code_start"`
+	ZipCodeEnd           types.String  `tfsdk:"zip_code_end"`
+	WeightStart          types.Float64 `tfsdk:"weight_start"`
+	WeightEnd            types.Float64 `tfsdk:"weight_end"`
+	Price                types.Float64 `tfsdk:"price"`
+	PricePercent         types.Float64 `tfsdk:"price_percent"`
+	PricePercentByWeight types.Float64 `tfsdk:"price_percent_by_weight"`
+	MaxVolume            types.Float64 `tfsdk:"max_volume"`
+	TransitTime          types.String  `tfsdk:"transit_time"`
+}
+
+// freightTableRow is a row of a freight table file, also used to compare rows
+type freightTableRow struct {
+	Country              string   `json:"country"`
+	ZipCodeStart         string   `json:"zip_code_start"`
+	ZipCodeEnd           string   `json:"zip_code_end"`
+	WeightStart          float64  `json:"weight_start"`
+	WeightEnd            float64  `json:"weight_end"`
+	Price                float64  `json:"price"`
+	PricePercent         float64  `json:"price_percent"`
+	PricePercentByWeight float64  `json:"price_percent_by_weight"`
+	MaxVolume            *float64 `json:"max_volume"`
+	TransitTime          string   `json:"transit_time"`
+}
+
+// freightRateAttrTypes are the attribute types of a rates element
+var freightRateAttrTypes = map[string]attr.Type{
+	"country":                 types.StringType,
+	"zip_code_start":          types.StringType,
+	"zip_code_end":            types.StringType,
+	"weight_start":            types.Float64Type,
+	"weight_end":              types.Float64Type,
+	"price":                   types.Float64Type,
+	"price_percent":           types.Float64Type,
+	"price_percent_by_weight": types.Float64Type,
+	"max_volume":              types.Float64Type,
+	"transit_time":            types.StringType,
+}
+
+func (r *VtexFreightTableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_freight_table"
+}
+
+func (r *VtexFreightTableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the freight rate table of a shipping policy, from a CSV, JSON or YAML file or a list of rates.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Shipping policy ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shipping_policy_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the shipping policy (carrier) the table belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the rate file. Conflicts with content and rates",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Inline rate file content. Conflicts with path and rates",
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Description: "File format, csv, json or yaml (default: from the path extension, else csv)",
+				Validators: []validator.String{
+					stringOneOfValidator{values: fileSourceFormats},
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the file content, changes when the file changes",
+			},
+			"rates": schema.ListNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Rates of the table. Conflicts with path and content, which fill it from the file",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"country": schema.StringAttribute{
+							Required:    true,
+							Description: "Country of the postal codes, as an ISO 3166 alpha-3 code like BRA",
+						},
+						"zip_code_start": schema.StringAttribute{
+							Required:    true,
+							Description: "First postal code of the range",
+						},
+						"zip_code_end": schema.StringAttribute{
+							Required:    true,
+							Description: "Last postal code of the range",
+						},
+						"weight_start": schema.Float64Attribute{
+							Required:    true,
+							Description: "Lowest package weight of the rate, in grams",
+						},
+						"weight_end": schema.Float64Attribute{
+							Required:    true,
+							Description: "Highest package weight of the rate, in grams",
+						},
+						"price": schema.Float64Attribute{
+							Required:    true,
+							Description: "Freight price",
+						},
+						"price_percent": schema.Float64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     float64default.StaticFloat64(0),
+							Description: "Percentage of the order value added to the price",
+						},
+						"price_percent_by_weight": schema.Float64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     float64default.StaticFloat64(0),
+							Description: "Amount added to the price for each kilogram",
+						},
+						"max_volume": schema.Float64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     float64default.StaticFloat64(freightMaxVolumeDefault),
+							Description: "Largest package volume of the rate, in cubic centimeters",
+						},
+						"transit_time": schema.StringAttribute{
+							Required:    true,
+							Description: "Delivery time, as a Go duration like 72h",
+							Validators: []validator.String{
+								durationValidator{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexFreightTableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexFreightTableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexFreightTableResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if data.Path.IsUnknown() || data.Content.IsUnknown() || data.Rates.IsUnknown() {
+		return
+	}
+
+	sources := 0
+	for _, value := range []attr.Value{data.Path, data.Content, data.Rates} {
+		if !value.IsNull() {
+			sources++
+		}
+	}
+	if sources != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rates"),
+			"Invalid Freight Table",
+			"Exactly one of path, content or rates must be set.",
+		)
+		return
+	}
+
+	if data.Rates.IsNull() {
+		return
+	}
+
+	var rates []VtexFreightRateModel
+	resp.Diagnostics.Append(data.Rates.ElementsAs(ctx, &rates, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(rates) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rates"),
+			"Missing Freight Rates",
+			"At least one rate is required.",
+		)
+		return
+	}
+
+	seen := make(map[string]int, len(rates))
+	for i, rate := range rates {
+		if rate.WeightStart.IsUnknown() || rate.WeightEnd.IsUnknown() ||
+			rate.Country.IsUnknown() || rate.ZipCodeStart.IsUnknown() || rate.ZipCodeEnd.IsUnknown() {
+			continue
+		}
+
+		if rate.WeightEnd.ValueFloat64() < rate.WeightStart.ValueFloat64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rates").AtListIndex(i).AtName("weight_end"),
+				"Invalid Weight Range",
+				"weight_end must not be lower than weight_start.",
+			)
+		}
+
+		key := freightRowKey(freightTableRow{
+			Country:      rate.Country.ValueString(),
+			ZipCodeStart: rate.ZipCodeStart.ValueString(),
+			ZipCodeEnd:   rate.ZipCodeEnd.ValueString(),
+			WeightStart:  rate.WeightStart.ValueFloat64(),
+			WeightEnd:    rate.WeightEnd.ValueFloat64(),
+		})
+		if first, ok := seen[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rates").AtListIndex(i),
+				"Duplicate Freight Rate",
+				fmt.Sprintf("Rate %d has the same country, postal code and weight ranges as rate %d.", i+1, first+1),
+			)
+			continue
+		}
+		seen[key] = i
+	}
+}
+
+func (r *VtexFreightTableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data VtexFreightTableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Path.IsUnknown() || data.Content.IsUnknown() || data.Format.IsUnknown() {
+		return
+	}
+
+	// Rates set in the configuration have no file
+	if data.Path.IsNull() && data.Content.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringNull())...)
+		return
+	}
+
+	// Parse the file at plan time, so file changes and row errors show up before apply
+	_, diags := freightTableFile(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), data.ContentSHA256)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rates"), data.Rates)...)
+}
+
+func (r *VtexFreightTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexFreightTableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rows, diags := plannedFreightRows(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX freight table", map[string]interface{}{
+		"shipping_policy_id": data.ShippingPolicyID.ValueString(),
+		"rates":              len(rows),
+	})
+
+	err := r.updateFreightRows(ctx, data.ShippingPolicyID.ValueString(), rows, client.FreightOperationSave)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Freight Table",
+			"Could not create freight table, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(data.ShippingPolicyID.ValueString())
+
+	tflog.Trace(ctx, "Created VTEX freight table", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexFreightTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexFreightTableResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX freight table", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// The Logistics API only reads rates by postal code, so the rows are kept from the state
+	// and only the shipping policy is checked
+	_, err := r.client.GetShippingPolicy(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX shipping policy not found, removing freight table from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Freight Table",
+			"Could not read shipping policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ShippingPolicyID = types.StringValue(data.ID.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexFreightTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexFreightTableResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := plannedFreightRows(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	current, diags := freightRowsFromModel(ctx, state.Rates)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only new and changed rows are sent, so small edits of large tables stay small
+	existing := make(map[string]freightTableRow, len(current))
+	for _, row := range current {
+		existing[freightRowKey(row)] = row
+	}
+	wanted := make(map[string]bool, len(planned))
+	var toSave []freightTableRow
+	for _, row := range planned {
+		key := freightRowKey(row)
+		wanted[key] = true
+		if old, ok := existing[key]; !ok || !sameFreightRow(old, row) {
+			toSave = append(toSave, row)
+		}
+	}
+	var toDelete []freightTableRow
+	for _, row := range current {
+		if !wanted[freightRowKey(row)] {
+			toDelete = append(toDelete, row)
+		}
+	}
+
+	shippingPolicyID := plan.ShippingPolicyID.ValueString()
+
+	tflog.Debug(ctx, "Updating VTEX freight table", map[string]interface{}{
+		"id":     state.ID.ValueString(),
+		"save":   len(toSave),
+		"delete": len(toDelete),
+	})
+
+	// Save first, so postal codes moving between ranges always have a rate
+	err := r.updateFreightRows(ctx, shippingPolicyID, toSave, client.FreightOperationSave)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Freight Table",
+			"Could not save freight rates, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.updateFreightRows(ctx, shippingPolicyID, toDelete, client.FreightOperationDelete)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Freight Table",
+			"Could not delete freight rates, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexFreightTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexFreightTableResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rows, diags := freightRowsFromModel(ctx, data.Rates)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX freight table", map[string]interface{}{
+		"id":    data.ID.ValueString(),
+		"rates": len(rows),
+	})
+
+	err := r.updateFreightRows(ctx, data.ID.ValueString(), rows, client.FreightOperationDelete)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Freight Table",
+			"Could not delete freight table, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX freight table", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexFreightTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: shipping policy ID. The rates cannot be listed, so the first
+	// apply uploads the configured rates and keeps any other rows of the table.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("shipping_policy_id"), req.ID)...)
+}
+
+// updateFreightRows sends the rows with the operation in chunks of freightTableChunkSize
+func (r *VtexFreightTableResource) updateFreightRows(ctx context.Context, shippingPolicyID string, rows []freightTableRow, operation int) error {
+	for start := 0; start < len(rows); start += freightTableChunkSize {
+		end := min(start+freightTableChunkSize, len(rows))
+
+		values := make([]client.FreightValue, 0, end-start)
+		for _, row := range rows[start:end] {
+			values = append(values, freightValue(row, operation))
+		}
+
+		tflog.Trace(ctx, "Sending VTEX freight rates", map[string]interface{}{
+			"shipping_policy_id": shippingPolicyID,
+			"from":               start + 1,
+			"to":                 end,
+		})
+
+		if err := r.client.UpdateFreightValues(ctx, shippingPolicyID, values); err != nil {
+			return fmt.Errorf("rows %d to %d: %w", start+1, end, err)
+		}
+	}
+	return nil
+}
+
+// plannedFreightRows returns the rows to apply. Files are parsed in ModifyPlan,
+// but a path only known at apply time is read here.
+func plannedFreightRows(ctx context.Context, data *VtexFreightTableResourceModel) ([]freightTableRow, diag.Diagnostics) {
+	if (!data.Path.IsNull() || !data.Content.IsNull()) && (data.Rates.IsUnknown() || data.ContentSHA256.IsUnknown()) {
+		return freightTableFile(ctx, data)
+	}
+	if data.ContentSHA256.IsUnknown() {
+		data.ContentSHA256 = types.StringNull()
+	}
+	return freightRowsFromModel(ctx, data.Rates)
+}
+
+// freightTableFile parses the rate file of the model and fills content_sha256 and rates
+func freightTableFile(ctx context.Context, data *VtexFreightTableResourceModel) ([]freightTableRow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	content, format, err := fileSource(data.Path, data.Content, data.Format)
+	if err != nil {
+		diags.AddAttributeError(path.Root("path"), "Invalid Freight Table File", err.Error())
+		return nil, diags
+	}
+
+	rows, err := parseFreightTable(content, format)
+	if err != nil {
+		diags.AddAttributeError(path.Root("content"), "Invalid Freight Table File", err.Error())
+		return nil, diags
+	}
+
+	sum := sha256.Sum256(content)
+	data.ContentSHA256 = types.StringValue(hex.EncodeToString(sum[:]))
+	rates, listDiags := freightRatesValue(ctx, rows)
+	diags.Append(listDiags...)
+	data.Rates = rates
+	return rows, diags
+}
+
+// parseFreightTable parses CSV or JSON rows into checked rates with a
+// Go duration transit time. CSV files need a header, either with the
+// attribute names of rates or the column names of the VTEX freight spreadsheet.
+func parseFreightTable(content []byte, format string) ([]freightTableRow, error) {
+	var rows []freightTableRow
+
+	switch format {
+	case fileFormatJSON:
+		if err := json.Unmarshal(content, &rows); err != nil {
+			return nil, fmt.Errorf("expected a JSON list of objects with the rates attributes: %w", err)
+		}
+	default:
+		parsed, err := parseFreightTableCSV(content)
+		if err != nil {
+			return nil, err
+		}
+		rows = parsed
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("the file has no rates")
+	}
+
+	seen := make(map[string]int, len(rows))
+	for i := range rows {
+		row := &rows[i]
+		row.Country = strings.TrimSpace(row.Country)
+		row.ZipCodeStart = strings.TrimSpace(row.ZipCodeStart)
+		row.ZipCodeEnd = strings.TrimSpace(row.ZipCodeEnd)
+
+		if row.Country == "" || row.ZipCodeStart == "" || row.ZipCodeEnd == "" {
+			return nil, fmt.Errorf("row %d: country, zip_code_start and zip_code_end are required", i+1)
+		}
+		if row.WeightEnd < row.WeightStart {
+			return nil, fmt.Errorf("row %d: weight_end is lower than weight_start", i+1)
+		}
+
+		transitTime, err := parseTransitTime(row.TransitTime)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i+1, err)
+		}
+		row.TransitTime = transitTime.String()
+
+		if row.MaxVolume == nil {
+			maxVolume := float64(freightMaxVolumeDefault)
+			row.MaxVolume = &maxVolume
+		}
+
+		key := freightRowKey(*row)
+		if first, ok := seen[key]; ok {
+			return nil, fmt.Errorf("row %d: same country, postal code and weight ranges as row %d", i+1, first+1)
+		}
+		seen[key] = i
+	}
+	return rows, nil
+}
+
+// parseFreightTableCSV reads CSV rows by header name
+func parseFreightTableCSV(content []byte) ([]freightTableRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read CSV header: %w", err)
+	}
+
+	// Columns are matched without underscores, so ZipCodeStart and zip_code_start are the same
+	aliases := map[string]string{
+		"absolutemoneycost": "price",
+		"timecost":          "transittime",
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		name := strings.ReplaceAll(csvColumnName(column), "_", "")
+		if alias, ok := aliases[name]; ok {
+			name = alias
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"country", "zipcodestart", "zipcodeend", "weightstart", "weightend", "price", "transittime"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV header must have a %s column, got: %s", required, strings.Join(header, ","))
+		}
+	}
+
+	var rows []freightTableRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		// Skip blank lines left by spreadsheet exports
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		field := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		// Spreadsheets in some locales use a decimal comma
+		var parseErr error
+		number := func(column string) float64 {
+			value := strings.ReplaceAll(field(column), ",", ".")
+			if value == "" {
+				return 0
+			}
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil && parseErr == nil {
+				parseErr = fmt.Errorf("line %d: invalid %s %q", line, column, field(column))
+			}
+			return parsed
+		}
+
+		row := freightTableRow{
+			Country:              field("country"),
+			ZipCodeStart:         field("zipcodestart"),
+			ZipCodeEnd:           field("zipcodeend"),
+			WeightStart:          number("weightstart"),
+			WeightEnd:            number("weightend"),
+			Price:                number("price"),
+			PricePercent:         number("pricepercent"),
+			PricePercentByWeight: number("pricepercentbyweight"),
+			TransitTime:          field("transittime"),
+		}
+		if field("maxvolume") != "" {
+			maxVolume := number("maxvolume")
+			row.MaxVolume = &maxVolume
+		}
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseTransitTime parses a Go duration, a .NET time span or a number of days
+func parseTransitTime(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if d, err := time.ParseDuration(value); err == nil {
+		return d, nil
+	}
+	if d, err := parseTimeSpan(value); err == nil {
+		return d, nil
+	}
+	if days, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return 0, fmt.Errorf("invalid transit_time %q, expected a duration like 72h", value)
+}
+
+// freightRowKey identifies a row of the freight table
+func freightRowKey(row freightTableRow) string {
+	return encodeID(
+		row.Country,
+		row.ZipCodeStart,
+		row.ZipCodeEnd,
+		strconv.FormatFloat(row.WeightStart, 'f', -1, 64),
+		strconv.FormatFloat(row.WeightEnd, 'f', -1, 64),
+	)
+}
+
+// sameFreightRow reports whether two rows with the same key have the same rate
+func sameFreightRow(a, b freightTableRow) bool {
+	return a.Price == b.Price &&
+		a.PricePercent == b.PricePercent &&
+		a.PricePercentByWeight == b.PricePercentByWeight &&
+		*a.MaxVolume == *b.MaxVolume &&
+		a.TransitTime == b.TransitTime
+}
+
+// freightValue builds the API row for an operation
+func freightValue(row freightTableRow, operation int) client.FreightValue {
+	// Rows were checked when parsed
+	transitTime, _ := time.ParseDuration(row.TransitTime)
+
+	return client.FreightValue{
+		ZipCodeStart:         row.ZipCodeStart,
+		ZipCodeEnd:           row.ZipCodeEnd,
+		WeightStart:          row.WeightStart,
+		WeightEnd:            row.WeightEnd,
+		AbsoluteMoneyCost:    row.Price,
+		PricePercent:         row.PricePercent,
+		PricePercentByWeight: row.PricePercentByWeight,
+		MaxVolume:            *row.MaxVolume,
+		TimeCost:             formatTimeSpan(transitTime),
+		Country:              row.Country,
+		OperationType:        operation,
+	}
+}
+
+// freightRowsFromModel reads the rates list
+func freightRowsFromModel(ctx context.Context, rates types.List) ([]freightTableRow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if rates.IsNull() || rates.IsUnknown() {
+		return nil, diags
+	}
+
+	var models []VtexFreightRateModel
+	diags.Append(rates.ElementsAs(ctx, &models, false)...)
+
+	rows := make([]freightTableRow, 0, len(models))
+	for _, m := range models {
+		maxVolume := m.MaxVolume.ValueFloat64()
+		rows = append(rows, freightTableRow{
+			Country:              m.Country.ValueString(),
+			ZipCodeStart:         m.ZipCodeStart.ValueString(),
+			ZipCodeEnd:           m.ZipCodeEnd.ValueString(),
+			WeightStart:          m.WeightStart.ValueFloat64(),
+			WeightEnd:            m.WeightEnd.ValueFloat64(),
+			Price:                m.Price.ValueFloat64(),
+			PricePercent:         m.PricePercent.ValueFloat64(),
+			PricePercentByWeight: m.PricePercentByWeight.ValueFloat64(),
+			MaxVolume:            &maxVolume,
+			TransitTime:          m.TransitTime.ValueString(),
+		})
+	}
+	return rows, diags
+}
+
+// freightRatesValue converts parsed rows into the rates list, in file order
+func freightRatesValue(ctx context.Context, rows []freightTableRow) (types.List, diag.Diagnostics) {
+	rates := make([]VtexFreightRateModel, 0, len(rows))
+	for _, row := range rows {
+		rates = append(rates, VtexFreightRateModel{
+			Country:              types.StringValue(row.Country),
+			ZipCodeStart:         types.StringValue(row.ZipCodeStart),
+			ZipCodeEnd:           types.StringValue(row.ZipCodeEnd),
+			WeightStart:          types.Float64Value(row.WeightStart),
+			WeightEnd:            types.Float64Value(row.WeightEnd),
+			Price:                types.Float64Value(row.Price),
+			PricePercent:         types.Float64Value(row.PricePercent),
+			PricePercentByWeight: types.Float64Value(row.PricePercentByWeight),
+			MaxVolume:            types.Float64Value(*row.MaxVolume),
+			TransitTime:          types.StringValue(row.TransitTime),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: freightRateAttrTypes}, rates)
+}
@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexGeoShapeResource{}
+var _ resource.ResourceWithImportState = &VtexGeoShapeResource{}
+
+// Geometry types the Logistics API accepts for polygons
+const (
+	geoJSONPolygon      = "Polygon"
+	geoJSONMultiPolygon = "MultiPolygon"
+)
+
+func NewVtexGeoShapeResource() resource.Resource {
+	return &VtexGeoShapeResource{}
+}
+
+// VtexGeoShapeResource is the resource implementation
+type VtexGeoShapeResource struct {
+	client *client.VtexClient
+}
+
+// VtexGeoShapeResourceModel is the resource data model
+type VtexGeoShapeResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	GeoJSON      types.String `tfsdk:"geojson"`
+	GeometryType types.String `tfsdk:"geometry_type"`
+}
+
+// geoJSONObject is a GeoJSON geometry, feature or feature collection
+type geoJSONObject struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+	Geometry    *geoJSONObject  `json:"geometry"`
+	Features    []geoJSONObject `json:"features"`
+}
+
+func (r *VtexGeoShapeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_geo_shape"
+}
+
+func (r *VtexGeoShapeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Logistics polygon, the delivery area of freight rates that use a polygon instead of a postal code range.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Polygon name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Polygon name, referenced by freight rates",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"geojson": schema.StringAttribute{
+				Required:    true,
+				Description: "GeoJSON Polygon or MultiPolygon, as a geometry or a feature (or a collection with one feature)",
+				Validators: []validator.String{
+					geoJSONValidator{},
+				},
+			},
+			"geometry_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Geometry type, Polygon or MultiPolygon",
+			},
+		},
+	}
+}
+
+func (r *VtexGeoShapeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexGeoShapeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexGeoShapeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	geometry, err := parseGeoShape(data.GeoJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("geojson"), "Invalid GeoJSON", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX geo shape", map[string]interface{}{
+		"name": data.Name.ValueString(),
+		"type": geometry.Type,
+	})
+
+	result, err := r.saveGeoShape(ctx, client.GeoShape{Name: data.Name.ValueString(), GeoShape: geometry})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Geo Shape",
+			"Could not create geo shape, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	geoShapeToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX geo shape", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexGeoShapeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexGeoShapeResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX geo shape", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	geoShape, err := r.client.GetGeoShape(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX geo shape not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Geo Shape",
+			"Could not read geo shape, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	geoShapeToModel(geoShape, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexGeoShapeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexGeoShapeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	geometry, err := parseGeoShape(data.GeoJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("geojson"), "Invalid GeoJSON", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX geo shape", map[string]interface{}{
+		"id":   data.ID.ValueString(),
+		"type": geometry.Type,
+	})
+
+	result, err := r.saveGeoShape(ctx, client.GeoShape{Name: data.Name.ValueString(), GeoShape: geometry})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Geo Shape",
+			"Could not update geo shape, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	geoShapeToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexGeoShapeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexGeoShapeResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX geo shape", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteGeoShape(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Geo Shape",
+			"Could not delete geo shape, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX geo shape", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexGeoShapeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: polygon name
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// saveGeoShape creates or replaces a polygon and reads it back
+func (r *VtexGeoShapeResource) saveGeoShape(ctx context.Context, geoShape client.GeoShape) (*client.GeoShape, error) {
+	if err := r.client.SaveGeoShape(ctx, geoShape); err != nil {
+		return nil, err
+	}
+	return r.client.GetGeoShape(ctx, geoShape.Name)
+}
+
+// parseGeoShape extracts the Polygon or MultiPolygon geometry of a GeoJSON document and
+// checks its rings, so drawing mistakes fail at plan time instead of in the Logistics API
+func parseGeoShape(value string) (client.GeoShapeGeometry, error) {
+	var object geoJSONObject
+	if err := json.Unmarshal([]byte(value), &object); err != nil {
+		return client.GeoShapeGeometry{}, fmt.Errorf("could not parse GeoJSON: %w", err)
+	}
+
+	switch object.Type {
+	case "FeatureCollection":
+		if len(object.Features) != 1 {
+			return client.GeoShapeGeometry{}, fmt.Errorf("a FeatureCollection must have exactly one feature, got %d", len(object.Features))
+		}
+		object = object.Features[0]
+		if object.Type != "Feature" {
+			return client.GeoShapeGeometry{}, fmt.Errorf("expected a Feature in the FeatureCollection, got %q", object.Type)
+		}
+		fallthrough
+	case "Feature":
+		if object.Geometry == nil {
+			return client.GeoShapeGeometry{}, fmt.Errorf("the Feature has no geometry")
+		}
+		object = *object.Geometry
+	}
+
+	var polygons [][][][]float64
+	switch object.Type {
+	case geoJSONPolygon:
+		var polygon [][][]float64
+		if err := json.Unmarshal(object.Coordinates, &polygon); err != nil {
+			return client.GeoShapeGeometry{}, fmt.Errorf("invalid Polygon coordinates: %w", err)
+		}
+		polygons = [][][][]float64{polygon}
+	case geoJSONMultiPolygon:
+		if err := json.Unmarshal(object.Coordinates, &polygons); err != nil {
+			return client.GeoShapeGeometry{}, fmt.Errorf("invalid MultiPolygon coordinates: %w", err)
+		}
+	default:
+		return client.GeoShapeGeometry{}, fmt.Errorf("expected a Polygon or MultiPolygon geometry, got %q", object.Type)
+	}
+
+	if len(polygons) == 0 {
+		return client.GeoShapeGeometry{}, fmt.Errorf("the geometry has no polygons")
+	}
+	for p, polygon := range polygons {
+		if len(polygon) == 0 {
+			return client.GeoShapeGeometry{}, fmt.Errorf("polygon %d has no rings", p+1)
+		}
+		for i, ring := range polygon {
+			if err := checkGeoJSONRing(ring); err != nil {
+				return client.GeoShapeGeometry{}, fmt.Errorf("polygon %d, ring %d: %w", p+1, i+1, err)
+			}
+		}
+	}
+
+	// Compact the coordinates, so formatting in the configuration is not sent
+	var coordinates bytes.Buffer
+	if err := json.Compact(&coordinates, object.Coordinates); err != nil {
+		return client.GeoShapeGeometry{}, fmt.Errorf("invalid coordinates: %w", err)
+	}
+	return client.GeoShapeGeometry{Type: object.Type, Coordinates: coordinates.Bytes()}, nil
+}
+
+// checkGeoJSONRing checks that a ring is closed and its positions are longitude, latitude pairs
+func checkGeoJSONRing(ring [][]float64) error {
+	if len(ring) < 4 {
+		return fmt.Errorf("a ring needs at least 4 positions, got %d", len(ring))
+	}
+	for i, position := range ring {
+		if len(position) < 2 {
+			return fmt.Errorf("position %d must be [longitude, latitude]", i+1)
+		}
+		if position[0] < -180 || position[0] > 180 || position[1] < -90 || position[1] > 90 {
+			return fmt.Errorf("position %d [%g, %g] is out of range, positions are [longitude, latitude]", i+1, position[0], position[1])
+		}
+	}
+	first, last := ring[0], ring[len(ring)-1]
+	if first[0] != last[0] || first[1] != last[1] {
+		return fmt.Errorf("the ring is not closed, the last position must repeat the first")
+	}
+	return nil
+}
+
+// sameGeoShape reports whether two geometries have the same type and coordinates
+func sameGeoShape(a, b client.GeoShapeGeometry) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	var coordinatesA, coordinatesB interface{}
+	if json.Unmarshal(a.Coordinates, &coordinatesA) != nil || json.Unmarshal(b.Coordinates, &coordinatesB) != nil {
+		return false
+	}
+	encodedA, _ := json.Marshal(coordinatesA)
+	encodedB, _ := json.Marshal(coordinatesB)
+	return bytes.Equal(encodedA, encodedB)
+}
+
+// geoShapeToModel copies an API polygon into the resource model. The configured GeoJSON
+// is kept while it describes the same geometry, so features and formatting do not show as a diff.
+func geoShapeToModel(geoShape *client.GeoShape, data *VtexGeoShapeResourceModel) {
+	data.ID = types.StringValue(geoShape.Name)
+	data.Name = types.StringValue(geoShape.Name)
+	data.GeometryType = types.StringValue(geoShape.GeoShape.Type)
+
+	if !data.GeoJSON.IsNull() && !data.GeoJSON.IsUnknown() {
+		if configured, err := parseGeoShape(data.GeoJSON.ValueString()); err == nil && sameGeoShape(configured, geoShape.GeoShape) {
+			return
+		}
+	}
+
+	encoded, err := json.Marshal(geoShape.GeoShape)
+	if err != nil {
+		data.GeoJSON = types.StringValue("")
+		return
+	}
+	data.GeoJSON = types.StringValue(string(encoded))
+}
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexGiftCardProviderResource{}
+var _ resource.ResourceWithImportState = &VtexGiftCardProviderResource{}
+var _ resource.ResourceWithValidateConfig = &VtexGiftCardProviderResource{}
+
+// giftCardOAuthProviderVtex authenticates the shopper of a gift card with the VTEX login
+const giftCardOAuthProviderVtex = "vtex"
+
+func NewVtexGiftCardProviderResource() resource.Resource {
+	return &VtexGiftCardProviderResource{}
+}
+
+// VtexGiftCardProviderResource is the resource implementation
+type VtexGiftCardProviderResource struct {
+	client *client.VtexClient
+}
+
+// VtexGiftCardProviderResourceModel is the resource data model
+type VtexGiftCardProviderResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	ServiceURL     types.String `tfsdk:"service_url"`
+	OAuthProvider  types.String `tfsdk:"oauth_provider"`
+	PreAuthEnabled types.Bool   `tfsdk:"preauth_enabled"`
+	CancelEnabled  types.Bool   `tfsdk:"cancel_enabled"`
+	AppKey         types.String `tfsdk:"app_key"`
+	AppToken       types.String `tfsdk:"app_token"`
+}
+
+func (r *VtexGiftCardProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_giftcard_provider"
+}
+
+func (r *VtexGiftCardProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a gift card provider of the Gift Card Hub: an external service checkout uses to redeem gift cards.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Provider ID, same as name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Provider ID in the Gift Card Hub, e.g. MyGiftCards. Changing it creates a new provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_url": schema.StringAttribute{
+				Required:    true,
+				Description: "Base HTTPS URL of the provider API the Gift Card Hub calls",
+			},
+			"oauth_provider": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(giftCardOAuthProviderVtex),
+				Description: "Login that identifies the shopper to the provider (default: vtex)",
+			},
+			"preauth_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the gift card value is reserved when the order is placed and settled later, instead of debited at once",
+			},
+			"cancel_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the provider supports canceling transactions, to give the value back when orders are canceled",
+			},
+			"app_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "App key the Gift Card Hub sends to the provider",
+			},
+			"app_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "App token the Gift Card Hub sends to the provider. It is not read back",
+			},
+		},
+	}
+}
+
+func (r *VtexGiftCardProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexGiftCardProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexGiftCardProviderResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.ServiceURL) {
+		if u, err := url.Parse(data.ServiceURL.ValueString()); err != nil || u.Scheme != "https" || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("service_url"),
+				"Invalid Service URL",
+				fmt.Sprintf("Expected an https:// URL, got: %q", data.ServiceURL.ValueString()),
+			)
+		}
+	}
+
+	// The provider needs both credentials or none
+	if !data.AppKey.IsUnknown() && !data.AppToken.IsUnknown() && data.AppKey.IsNull() != data.AppToken.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("app_token"),
+			"Incomplete Provider Credentials",
+			"app_key and app_token must be set together.",
+		)
+	}
+}
+
+func (r *VtexGiftCardProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexGiftCardProviderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX gift card provider", map[string]interface{}{
+		"name":        data.Name.ValueString(),
+		"service_url": data.ServiceURL.ValueString(),
+	})
+
+	result, err := r.client.SaveGiftCardProvider(ctx, data.Name.ValueString(), giftCardProviderFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Gift Card Provider",
+			"Could not create gift card provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	giftCardProviderToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX gift card provider", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexGiftCardProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexGiftCardProviderResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX gift card provider", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	provider, err := r.client.GetGiftCardProvider(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX gift card provider not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Gift Card Provider",
+			"Could not read gift card provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Imported providers only have the ID
+	data.Name = data.ID
+	giftCardProviderToModel(provider, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexGiftCardProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexGiftCardProviderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX gift card provider", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.SaveGiftCardProvider(ctx, data.ID.ValueString(), giftCardProviderFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Gift Card Provider",
+			"Could not update gift card provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	giftCardProviderToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexGiftCardProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexGiftCardProviderResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX gift card provider", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteGiftCardProvider(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Gift Card Provider",
+			"Could not delete gift card provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX gift card provider", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexGiftCardProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: provider ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// giftCardProviderFromModel builds the API payload from the resource model
+func giftCardProviderFromModel(data VtexGiftCardProviderResourceModel) client.GiftCardProvider {
+	return client.GiftCardProvider{
+		ServiceURL:     data.ServiceURL.ValueString(),
+		OAuthProvider:  data.OAuthProvider.ValueString(),
+		PreAuthEnabled: data.PreAuthEnabled.ValueBool(),
+		CancelEnabled:  data.CancelEnabled.ValueBool(),
+		AppKey:         data.AppKey.ValueString(),
+		AppToken:       data.AppToken.ValueString(),
+	}
+}
+
+// giftCardProviderToModel copies an API gift card provider into the resource model.
+// The app token is kept as configured, the Gift Card Hub does not return it.
+func giftCardProviderToModel(provider *client.GiftCardProvider, data *VtexGiftCardProviderResourceModel) {
+	data.ID = data.Name
+	data.ServiceURL = types.StringValue(provider.ServiceURL)
+	data.OAuthProvider = types.StringValue(giftCardOAuthProviderVtex)
+	if provider.OAuthProvider != "" {
+		data.OAuthProvider = types.StringValue(provider.OAuthProvider)
+	}
+	data.PreAuthEnabled = types.BoolValue(provider.PreAuthEnabled)
+	data.CancelEnabled = types.BoolValue(provider.CancelEnabled)
+	data.AppKey = optionalString(provider.AppKey, data.AppKey)
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexGiftCardsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexGiftCardsDataSource{}
+
+// giftCardCodeHintLength is how many characters of a redemption code the hint shows
+const giftCardCodeHintLength = 4
+
+func NewVtexGiftCardsDataSource() datasource.DataSource {
+	return &VtexGiftCardsDataSource{}
+}
+
+// VtexGiftCardsDataSource is the data source implementation
+type VtexGiftCardsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexGiftCardsDataSourceModel is the data source data model
+type VtexGiftCardsDataSourceModel struct {
+	ID                     types.String            `tfsdk:"id"`
+	CustomerID             types.String            `tfsdk:"customer_id"`
+	RelationName           types.String            `tfsdk:"relation_name"`
+	IncludeRedemptionCodes types.Bool              `tfsdk:"include_redemption_codes"`
+	GiftCards              []VtexGiftCardItemModel `tfsdk:"giftcards"`
+	RedemptionCodes        types.Map               `tfsdk:"redemption_codes"`
+	TotalBalance           types.Float64           `tfsdk:"total_balance"`
+}
+
+// VtexGiftCardItemModel is a gift card in the list
+type VtexGiftCardItemModel struct {
+	ID                 types.String  `tfsdk:"id"`
+	RelationName       types.String  `tfsdk:"relation_name"`
+	Caption            types.String  `tfsdk:"caption"`
+	Balance            types.Float64 `tfsdk:"balance"`
+	EmissionDate       types.String  `tfsdk:"emission_date"`
+	ExpiringDate       types.String  `tfsdk:"expiring_date"`
+	RedemptionCodeHint types.String  `tfsdk:"redemption_code_hint"`
+}
+
+func (d *VtexGiftCardsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_giftcards"
+}
+
+func (d *VtexGiftCardsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the gift cards of the account by shopper or relation name, with their balances. Redemption codes are masked unless requested.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Filters used",
+			},
+			"customer_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the gift cards of this shopper, by profile ID or email",
+			},
+			"relation_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the gift cards of this relation, e.g. a loyalty program",
+			},
+			"include_redemption_codes": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to return the full redemption codes in redemption_codes (default: false)",
+			},
+			"giftcards": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Gift cards found, by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Gift card ID",
+						},
+						"relation_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Relation the gift card belongs to",
+						},
+						"caption": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name shown to the shopper",
+						},
+						"balance": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Value left on the gift card",
+						},
+						"emission_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the gift card was issued",
+						},
+						"expiring_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the gift card expires",
+						},
+						"redemption_code_hint": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last characters of the redemption code, the rest masked with *",
+						},
+					},
+				},
+			},
+			"redemption_codes": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Redemption codes by gift card ID, or null unless include_redemption_codes is true",
+			},
+			"total_balance": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Sum of the balances of the gift cards found",
+			},
+		},
+	}
+}
+
+func (d *VtexGiftCardsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexGiftCardsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexGiftCardsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.CustomerID.IsUnknown() || data.RelationName.IsUnknown() {
+		return
+	}
+
+	// Listing every gift card of the account is not supported by the API
+	if data.CustomerID.ValueString() == "" && data.RelationName.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("customer_id"),
+			"Missing Gift Card Filter",
+			"At least one of customer_id or relation_name must be set.",
+		)
+	}
+}
+
+func (d *VtexGiftCardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexGiftCardsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	search := client.GiftCardSearch{
+		ClientID:     data.CustomerID.ValueString(),
+		RelationName: data.RelationName.ValueString(),
+	}
+	includeCodes := data.IncludeRedemptionCodes.ValueBool()
+
+	tflog.Debug(ctx, "Listing VTEX gift cards", map[string]interface{}{
+		"relation_name":            search.RelationName,
+		"include_redemption_codes": includeCodes,
+	})
+
+	giftCards, err := d.client.SearchGiftCards(ctx, search)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Gift Cards",
+			"Could not search gift cards, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(giftCards, func(i, j int) bool { return giftCards[i].ID < giftCards[j].ID })
+
+	var total float64
+	codes := map[string]string{}
+	data.GiftCards = make([]VtexGiftCardItemModel, 0, len(giftCards))
+	for _, giftCard := range giftCards {
+		total += giftCard.Balance
+		codes[giftCard.ID] = giftCard.RedemptionCode
+
+		data.GiftCards = append(data.GiftCards, VtexGiftCardItemModel{
+			ID:                 types.StringValue(giftCard.ID),
+			RelationName:       types.StringValue(giftCard.RelationName),
+			Caption:            types.StringValue(giftCard.Caption),
+			Balance:            types.Float64Value(giftCard.Balance),
+			EmissionDate:       types.StringValue(giftCard.EmissionDate),
+			ExpiringDate:       types.StringValue(giftCard.ExpiringDate),
+			RedemptionCodeHint: types.StringValue(redemptionCodeHint(giftCard.RedemptionCode)),
+		})
+	}
+	data.TotalBalance = types.Float64Value(total)
+
+	// Full codes let anyone who reads the state spend the gift cards, so they are opt-in
+	data.RedemptionCodes = types.MapNull(types.StringType)
+	if includeCodes {
+		codesMap, diags := types.MapValueFrom(ctx, types.StringType, codes)
+		resp.Diagnostics.Append(diags...)
+		data.RedemptionCodes = codesMap
+	}
+
+	data.ID = types.StringValue(encodeID(search.ClientID, search.RelationName, strconv.FormatBool(includeCodes)))
+
+	tflog.Trace(ctx, "Listed VTEX gift cards", map[string]interface{}{
+		"count": len(data.GiftCards),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// redemptionCodeHint masks a redemption code but its last characters, e.g. ************WXYZ.
+// Codes too short to keep a hint are masked entirely.
+func redemptionCodeHint(code string) string {
+	if len(code) <= giftCardCodeHintLength {
+		return strings.Repeat("*", len(code))
+	}
+	return strings.Repeat("*", len(code)-giftCardCodeHintLength) + code[len(code)-giftCardCodeHintLength:]
+}
@@ -0,0 +1,548 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexHolidayResource{}
+var _ resource.ResourceWithValidateConfig = &VtexHolidayResource{}
+
+// Date formats of the holidays, a full date for a single year or a month and day that recurs
+const (
+	holidayDateLayout      = "2006-01-02"
+	holidayRecurringLayout = "01-02"
+)
+
+func NewVtexHolidayResource() resource.Resource {
+	return &VtexHolidayResource{}
+}
+
+// VtexHolidayResource is the resource implementation
+type VtexHolidayResource struct {
+	client *client.VtexClient
+}
+
+// VtexHolidayResourceModel is the resource data model
+type VtexHolidayResourceModel struct {
+	ID         types.String           `tfsdk:"id"`
+	Years      types.Set              `tfsdk:"years"`
+	Holidays   []VtexHolidayItemModel `tfsdk:"holidays"`
+	Exclusive  types.Bool             `tfsdk:"exclusive"`
+	HolidayIDs types.Set              `tfsdk:"holiday_ids"`
+}
+
+// VtexHolidayItemModel is a holiday of the calendar
+type VtexHolidayItemModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Date types.String `tfsdk:"date"`
+}
+
+func (r *VtexHolidayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_holiday"
+}
+
+func (r *VtexHolidayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages Logistics holidays, the days without deliveries in shipping estimates, including holidays that recur every year.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique ID of the resource (first holiday ID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"years": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "Years the recurring holidays are created for",
+			},
+			"holidays": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Holidays of the calendar",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "Holiday ID. Recurring holidays get the year appended, like christmas-2026",
+						},
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Holiday name",
+						},
+						"date": schema.StringAttribute{
+							Required:    true,
+							Description: "Date like 2026-02-17, or month and day like 12-25 for a holiday every year in years",
+						},
+					},
+				},
+			},
+			"exclusive": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, other holidays of the same years are deleted, so the configuration replaces the annual calendar",
+			},
+			"holiday_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the VTEX holidays managed by the resource",
+			},
+		},
+	}
+}
+
+func (r *VtexHolidayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexHolidayResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexHolidayResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Holidays) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("holidays"),
+			"Missing Holidays",
+			"At least one holiday is required.",
+		)
+		return
+	}
+
+	yearsKnown := !data.Years.IsUnknown()
+	hasYears := yearsKnown && !data.Years.IsNull() && len(data.Years.Elements()) > 0
+
+	seen := make(map[string]int, len(data.Holidays))
+	for i, holiday := range data.Holidays {
+		holidayPath := path.Root("holidays").AtListIndex(i)
+
+		if !holiday.Date.IsUnknown() {
+			recurring, err := holidayRecurs(holiday.Date.ValueString())
+			switch {
+			case err != nil:
+				resp.Diagnostics.AddAttributeError(holidayPath.AtName("date"), "Invalid Holiday Date", err.Error())
+			case recurring && yearsKnown && !hasYears:
+				resp.Diagnostics.AddAttributeError(
+					holidayPath.AtName("date"),
+					"Missing Holiday Years",
+					fmt.Sprintf("Holiday %q recurs every year, so years must list the years to create it for.", holiday.ID.ValueString()),
+				)
+			}
+		}
+
+		if holiday.ID.IsUnknown() {
+			continue
+		}
+		if first, ok := seen[holiday.ID.ValueString()]; ok {
+			resp.Diagnostics.AddAttributeError(
+				holidayPath.AtName("id"),
+				"Duplicate Holiday",
+				fmt.Sprintf("Holiday %d has the same ID as holiday %d.", i+1, first+1),
+			)
+			continue
+		}
+		seen[holiday.ID.ValueString()] = i
+	}
+}
+
+func (r *VtexHolidayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexHolidayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	holidays, diags := holidaysFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX holidays", map[string]interface{}{
+		"count": len(holidays),
+	})
+
+	err := r.applyHolidays(ctx, holidays, nil, data.Exclusive.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Holidays",
+			"Could not create holidays, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(data.Holidays[0].ID.ValueString())
+	resp.Diagnostics.Append(setHolidayIDs(ctx, holidays, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX holidays", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexHolidayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexHolidayResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX holidays", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	existing, err := r.client.ListHolidays(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Holidays",
+			"Could not read holidays, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	expected, diags := holidaysFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	byID := make(map[string]client.Holiday, len(existing))
+	for _, holiday := range existing {
+		byID[holiday.ID] = holiday
+	}
+
+	// A holiday changed in the admin is read back as is, so the next apply sets it again.
+	// A holiday deleted in one of its years is dropped from the state, so it is created again.
+	holidays := make([]VtexHolidayItemModel, 0, len(data.Holidays))
+	managed := make(map[string]bool, len(expected))
+	var found []calendarHoliday
+	for _, item := range data.Holidays {
+		item := item
+		complete := true
+		for _, holiday := range expected {
+			if holiday.item != item.ID.ValueString() {
+				continue
+			}
+			managed[holiday.ID] = true
+			current, ok := byID[holiday.ID]
+			if !ok {
+				complete = false
+				continue
+			}
+			found = append(found, calendarHoliday{Holiday: current, item: holiday.item})
+			item.Name = types.StringValue(current.Name)
+			if holiday.StartDate != holidayStartDate(current.StartDate) {
+				item.Date = types.StringValue(holidayStartDate(current.StartDate))
+			}
+		}
+		if complete {
+			holidays = append(holidays, item)
+		}
+	}
+
+	// Other holidays of the calendar years show as holidays to delete
+	if data.Exclusive.ValueBool() {
+		years := holidayYears(expected)
+		for _, holiday := range existing {
+			date := holidayStartDate(holiday.StartDate)
+			if managed[holiday.ID] || !years[holidayYear(date)] {
+				continue
+			}
+			holidays = append(holidays, VtexHolidayItemModel{
+				ID:   types.StringValue(holiday.ID),
+				Name: types.StringValue(holiday.Name),
+				Date: types.StringValue(date),
+			})
+		}
+	}
+
+	if len(holidays) == 0 {
+		tflog.Warn(ctx, "VTEX holidays not found, removing them from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Holidays = holidays
+	resp.Diagnostics.Append(setHolidayIDs(ctx, found, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexHolidayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexHolidayResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	holidays, diags := holidaysFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	var previous []string
+	resp.Diagnostics.Append(state.HolidayIDs.ElementsAs(ctx, &previous, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX holidays", map[string]interface{}{
+		"id":    state.ID.ValueString(),
+		"count": len(holidays),
+	})
+
+	err := r.applyHolidays(ctx, holidays, previous, plan.Exclusive.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Holidays",
+			"Could not update holidays, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(setHolidayIDs(ctx, holidays, &plan)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexHolidayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexHolidayResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var holidayIDs []string
+	resp.Diagnostics.Append(data.HolidayIDs.ElementsAs(ctx, &holidayIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX holidays", map[string]interface{}{
+		"id":    data.ID.ValueString(),
+		"count": len(holidayIDs),
+	})
+
+	for _, holidayID := range holidayIDs {
+		err := r.client.DeleteHoliday(ctx, holidayID)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting VTEX Holidays",
+				fmt.Sprintf("Could not delete holiday %s, unexpected error: %s", holidayID, err.Error()),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX holidays", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// applyHolidays saves the holidays, then deletes the previous ones that are gone and,
+// when exclusive, every other holiday of the same years
+func (r *VtexHolidayResource) applyHolidays(ctx context.Context, holidays []calendarHoliday, previous []string, exclusive bool) error {
+	wanted := make(map[string]bool, len(holidays))
+	for _, holiday := range holidays {
+		wanted[holiday.ID] = true
+		if err := r.client.SaveHoliday(ctx, holiday.Holiday); err != nil {
+			return fmt.Errorf("holiday %s: %w", holiday.ID, err)
+		}
+	}
+
+	toDelete := make(map[string]bool)
+	for _, holidayID := range previous {
+		if !wanted[holidayID] {
+			toDelete[holidayID] = true
+		}
+	}
+
+	if exclusive {
+		existing, err := r.client.ListHolidays(ctx)
+		if err != nil {
+			return err
+		}
+		years := holidayYears(holidays)
+		for _, holiday := range existing {
+			if !wanted[holiday.ID] && years[holidayYear(holidayStartDate(holiday.StartDate))] {
+				toDelete[holiday.ID] = true
+			}
+		}
+	}
+
+	holidayIDs := make([]string, 0, len(toDelete))
+	for holidayID := range toDelete {
+		holidayIDs = append(holidayIDs, holidayID)
+	}
+	sort.Strings(holidayIDs)
+
+	for _, holidayID := range holidayIDs {
+		tflog.Debug(ctx, "Deleting VTEX holiday", map[string]interface{}{
+			"holiday_id": holidayID,
+		})
+		if err := r.client.DeleteHoliday(ctx, holidayID); err != nil && !client.IsNotFound(err) {
+			return fmt.Errorf("holiday %s: %w", holidayID, err)
+		}
+	}
+	return nil
+}
+
+// calendarHoliday is a VTEX holiday with the ID of the configured holiday it comes from
+type calendarHoliday struct {
+	client.Holiday
+	item string
+}
+
+// holidayRecurs reports whether a holiday date is a month and day that recurs every year
+func holidayRecurs(date string) (bool, error) {
+	if _, err := time.Parse(holidayDateLayout, date); err == nil {
+		return false, nil
+	}
+	if parsed, err := time.Parse(holidayRecurringLayout, date); err == nil {
+		// February 29 does not exist every year
+		if parsed.Month() == time.February && parsed.Day() == 29 {
+			return false, fmt.Errorf("02-29 cannot recur every year, use a full date like 2028-02-29")
+		}
+		return true, nil
+	}
+	return false, fmt.Errorf("expected a date like 2026-02-17 or a month and day like 12-25, got: %q", date)
+}
+
+// holidaysFromModel expands the configured holidays into VTEX holidays, one per year for
+// recurring ones, sorted by date
+func holidaysFromModel(ctx context.Context, data VtexHolidayResourceModel) ([]calendarHoliday, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var years []int64
+	if !data.Years.IsNull() {
+		diags.Append(data.Years.ElementsAs(ctx, &years, false)...)
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i] < years[j] })
+
+	var holidays []calendarHoliday
+	for _, item := range data.Holidays {
+		date := item.Date.ValueString()
+		recurring, err := holidayRecurs(date)
+		if err != nil {
+			diags.AddError("Invalid Holiday Date", err.Error())
+			continue
+		}
+
+		if !recurring {
+			holidays = append(holidays, calendarHoliday{
+				Holiday: client.Holiday{ID: item.ID.ValueString(), Name: item.Name.ValueString(), StartDate: date},
+				item:    item.ID.ValueString(),
+			})
+			continue
+		}
+
+		for _, year := range years {
+			yearText := strconv.FormatInt(year, 10)
+			holidays = append(holidays, calendarHoliday{
+				Holiday: client.Holiday{
+					ID:        item.ID.ValueString() + "-" + yearText,
+					Name:      item.Name.ValueString(),
+					StartDate: fmt.Sprintf("%04d-%s", year, date),
+				},
+				item: item.ID.ValueString(),
+			})
+		}
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].StartDate < holidays[j].StartDate
+	})
+	return holidays, diags
+}
+
+// holidayStartDate returns the date part of an API start date, which may include a time
+func holidayStartDate(value string) string {
+	if len(value) > len(holidayDateLayout) {
+		return value[:len(holidayDateLayout)]
+	}
+	return value
+}
+
+// holidayYear returns the year of a holiday date
+func holidayYear(date string) string {
+	if len(date) < 4 {
+		return ""
+	}
+	return date[:4]
+}
+
+// holidayYears returns the years of the holidays
+func holidayYears(holidays []calendarHoliday) map[string]bool {
+	years := make(map[string]bool)
+	for _, holiday := range holidays {
+		years[holidayYear(holidayStartDate(holiday.StartDate))] = true
+	}
+	return years
+}
+
+// setHolidayIDs sets the computed IDs of the managed holidays
+func setHolidayIDs(ctx context.Context, holidays []calendarHoliday, data *VtexHolidayResourceModel) diag.Diagnostics {
+	holidayIDs := make([]string, 0, len(holidays))
+	for _, holiday := range holidays {
+		holidayIDs = append(holidayIDs, holiday.ID)
+	}
+
+	value, diags := types.SetValueFrom(ctx, types.StringType, holidayIDs)
+	data.HolidayIDs = value
+	return diags
+}
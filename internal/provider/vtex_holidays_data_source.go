@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexHolidaysDataSource{}
+
+func NewVtexHolidaysDataSource() datasource.DataSource {
+	return &VtexHolidaysDataSource{}
+}
+
+// VtexHolidaysDataSource is the data source implementation
+type VtexHolidaysDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexHolidaysDataSourceModel is the data source data model
+type VtexHolidaysDataSourceModel struct {
+	ID       types.String           `tfsdk:"id"`
+	Year     types.Int64            `tfsdk:"year"`
+	Holidays []VtexHolidayItemModel `tfsdk:"holidays"`
+	Dates    []types.String         `tfsdk:"dates"`
+}
+
+func (d *VtexHolidaysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_holidays"
+}
+
+func (d *VtexHolidaysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Logistics holidays of the account, the days without deliveries in shipping estimates.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Year listed, or \"all\"",
+			},
+			"year": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only list holidays in this year",
+			},
+			"holidays": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Holidays found, by date",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Holiday ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Holiday name",
+						},
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Holiday date, as YYYY-MM-DD",
+						},
+					},
+				},
+			},
+			"dates": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Distinct dates of the holidays, as YYYY-MM-DD, in order",
+			},
+		},
+	}
+}
+
+func (d *VtexHolidaysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexHolidaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexHolidaysDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	year := ""
+	if !data.Year.IsNull() {
+		year = fmt.Sprintf("%04d", data.Year.ValueInt64())
+	}
+
+	tflog.Debug(ctx, "Listing VTEX holidays", map[string]interface{}{
+		"year": year,
+	})
+
+	holidays, err := d.client.ListHolidays(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Holidays",
+			"Could not list holidays, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		a, b := holidayStartDate(holidays[i].StartDate), holidayStartDate(holidays[j].StartDate)
+		if a != b {
+			return a < b
+		}
+		return holidays[i].ID < holidays[j].ID
+	})
+
+	data.Holidays = make([]VtexHolidayItemModel, 0, len(holidays))
+	dates := make([]string, 0, len(holidays))
+	for _, holiday := range holidays {
+		date := holidayStartDate(holiday.StartDate)
+		if year != "" && holidayYear(date) != year {
+			continue
+		}
+
+		data.Holidays = append(data.Holidays, VtexHolidayItemModel{
+			ID:   types.StringValue(holiday.ID),
+			Name: types.StringValue(holiday.Name),
+			Date: types.StringValue(date),
+		})
+		if len(dates) == 0 || dates[len(dates)-1] != date {
+			dates = append(dates, date)
+		}
+	}
+	data.Dates = stringValues(dates)
+
+	data.ID = types.StringValue("all")
+	if year != "" {
+		data.ID = types.StringValue(strconv.FormatInt(data.Year.ValueInt64(), 10))
+	}
+
+	tflog.Trace(ctx, "Listed VTEX holidays", map[string]interface{}{
+		"count": len(data.Holidays),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
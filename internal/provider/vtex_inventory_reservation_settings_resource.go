@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexInventoryReservationSettingsResource{}
+var _ resource.ResourceWithImportState = &VtexInventoryReservationSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &VtexInventoryReservationSettingsResource{}
+
+// inventoryReservationSettingsID is the ID of the only reservation settings of an account
+const inventoryReservationSettingsID = "reservations"
+
+// Release policies of inventory reservations
+var reservationReleasePolicies = []string{client.ReservationReleaseOnExpiration, client.ReservationReleaseOnCancellation}
+
+func NewVtexInventoryReservationSettingsResource() resource.Resource {
+	return &VtexInventoryReservationSettingsResource{}
+}
+
+// VtexInventoryReservationSettingsResource is the resource implementation
+type VtexInventoryReservationSettingsResource struct {
+	client *client.VtexClient
+}
+
+// VtexInventoryReservationSettingsResourceModel is the resource data model
+type VtexInventoryReservationSettingsResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ReservationTimeout types.String `tfsdk:"reservation_timeout"`
+	ReleasePolicy      types.String `tfsdk:"release_policy"`
+}
+
+func (r *VtexInventoryReservationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_reservation_settings"
+}
+
+func (r *VtexInventoryReservationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages how long orders reserve inventory and when reservations are released. There is one per account; arguments that are not set keep their current value.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always \"" + inventoryReservationSettingsID + "\"",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reservation_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How long an order reserves its items before payment, as a Go duration like 24h",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"release_policy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When unpaid reservations are released: expiration, when the timeout ends, or cancellation, when the order is canceled",
+				Validators: []validator.String{
+					stringOneOfValidator{values: reservationReleasePolicies},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexInventoryReservationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexInventoryReservationSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var timeout types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("reservation_timeout"), &timeout)...)
+
+	if resp.Diagnostics.HasError() || !isKnown(timeout) {
+		return
+	}
+
+	// The attribute validator reports invalid durations
+	if d, err := time.ParseDuration(timeout.ValueString()); err == nil && d < time.Minute {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reservation_timeout"),
+			"Invalid Reservation Timeout",
+			fmt.Sprintf("Expected a timeout of at least 1m, got: %s", timeout.ValueString()),
+		)
+	}
+}
+
+func (r *VtexInventoryReservationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexInventoryReservationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX inventory reservation settings")
+
+	settings, err := r.updateSettings(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Inventory Reservation Settings",
+			"Could not update inventory reservation settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	reservationSettingsToModel(settings, &data)
+
+	tflog.Trace(ctx, "Created VTEX inventory reservation settings")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexInventoryReservationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexInventoryReservationSettingsResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX inventory reservation settings")
+
+	settings, err := r.client.GetReservationSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Inventory Reservation Settings",
+			"Could not read inventory reservation settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	reservationSettingsToModel(settings, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexInventoryReservationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexInventoryReservationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX inventory reservation settings")
+
+	settings, err := r.updateSettings(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Inventory Reservation Settings",
+			"Could not update inventory reservation settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	reservationSettingsToModel(settings, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexInventoryReservationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The reservation settings cannot be deleted, so they are only removed from state
+	tflog.Debug(ctx, "Removing VTEX inventory reservation settings from state, the account keeps its settings")
+}
+
+func (r *VtexInventoryReservationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: "reservations"
+	if req.ID != inventoryReservationSettingsID {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("expected %q, got: %q", inventoryReservationSettingsID, req.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateSettings applies the configured arguments over the current settings
+// and reads the result back
+func (r *VtexInventoryReservationSettingsResource) updateSettings(ctx context.Context, data VtexInventoryReservationSettingsResourceModel) (*client.ReservationSettings, error) {
+	settings, err := r.client.GetReservationSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if isKnown(data.ReservationTimeout) {
+		// The validator already checked the duration
+		timeout, _ := time.ParseDuration(data.ReservationTimeout.ValueString())
+		settings.LockTTL = formatTimeSpan(timeout)
+	}
+	if isKnown(data.ReleasePolicy) {
+		settings.ReleasePolicy = data.ReleasePolicy.ValueString()
+	}
+
+	if err := r.client.UpdateReservationSettings(ctx, *settings); err != nil {
+		return nil, err
+	}
+	return r.client.GetReservationSettings(ctx)
+}
+
+// reservationSettingsToModel copies the API reservation settings into the resource model
+func reservationSettingsToModel(settings *client.ReservationSettings, data *VtexInventoryReservationSettingsResourceModel) {
+	data.ID = types.StringValue(inventoryReservationSettingsID)
+	data.ReservationTimeout = timeSpanValue(settings.LockTTL, data.ReservationTimeout)
+	data.ReleasePolicy = types.StringValue(settings.ReleasePolicy)
+}
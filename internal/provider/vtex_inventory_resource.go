@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexInventoryResource{}
+var _ resource.ResourceWithImportState = &VtexInventoryResource{}
+var _ resource.ResourceWithValidateConfig = &VtexInventoryResource{}
+
+func NewVtexInventoryResource() resource.Resource {
+	return &VtexInventoryResource{}
+}
+
+// VtexInventoryResource is the resource implementation
+type VtexInventoryResource struct {
+	client *client.VtexClient
+}
+
+// VtexInventoryResourceModel is the resource data model
+type VtexInventoryResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	SKUID             types.String `tfsdk:"sku_id"`
+	WarehouseID       types.String `tfsdk:"warehouse_id"`
+	Quantity          types.Int64  `tfsdk:"quantity"`
+	UnlimitedQuantity types.Bool   `tfsdk:"unlimited_quantity"`
+	ReservedQuantity  types.Int64  `tfsdk:"reserved_quantity"`
+}
+
+func (r *VtexInventoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory"
+}
+
+func (r *VtexInventoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the stock of a SKU in a warehouse.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique ID of the resource (sku_id:warehouse_id)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sku_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the SKU",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"warehouse_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the warehouse that holds the stock",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quantity": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Units in stock. Ignored when unlimited_quantity is true",
+			},
+			"unlimited_quantity": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the SKU never runs out of stock in the warehouse, as for virtual and service SKUs",
+			},
+			"reserved_quantity": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Units reserved by orders not yet invoiced",
+			},
+		},
+	}
+}
+
+func (r *VtexInventoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexInventoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexInventoryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Quantity.IsNull() && !data.Quantity.IsUnknown() && data.Quantity.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("quantity"),
+			"Invalid Quantity",
+			"quantity must not be negative.",
+		)
+	}
+
+	if data.UnlimitedQuantity.ValueBool() && !data.Quantity.IsNull() && !data.Quantity.IsUnknown() && data.Quantity.ValueInt64() != 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("quantity"),
+			"Quantity Ignored",
+			"quantity is ignored when unlimited_quantity is true.",
+		)
+	}
+}
+
+func (r *VtexInventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexInventoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, diags := inventorySKUFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warehouseID := data.WarehouseID.ValueString()
+
+	tflog.Debug(ctx, "Creating VTEX inventory", map[string]interface{}{
+		"sku_id":       skuID,
+		"warehouse_id": warehouseID,
+	})
+
+	balance, err := r.updateInventory(ctx, skuID, warehouseID, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Inventory",
+			"Could not create inventory, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(encodeID(strconv.FormatInt(skuID, 10), warehouseID))
+	inventoryToModel(balance, &data)
+
+	tflog.Trace(ctx, "Created VTEX inventory", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexInventoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexInventoryResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, diags := inventorySKUFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX inventory", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	balance, err := r.client.GetInventoryBalance(ctx, skuID, data.WarehouseID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX inventory not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Inventory",
+			"Could not read inventory, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	inventoryToModel(balance, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexInventoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexInventoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, diags := inventorySKUFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX inventory", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	balance, err := r.updateInventory(ctx, skuID, data.WarehouseID.ValueString(), data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Inventory",
+			"Could not update inventory, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	inventoryToModel(balance, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexInventoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexInventoryResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, diags := inventorySKUFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX inventory", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Stock entries cannot be deleted, so the SKU is left out of stock in the warehouse
+	err := r.client.UpdateInventory(ctx, skuID, data.WarehouseID.ValueString(), client.InventoryUpdate{})
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Inventory",
+			"Could not delete inventory, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX inventory", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexInventoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: sku_id:warehouse_id
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 2 {
+		err = fmt.Errorf("expected sku_id:warehouse_id, got: %q", req.ID)
+	}
+	if err == nil {
+		_, err = parseNumericID(parts[0])
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sku_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("warehouse_id"), parts[1])...)
+}
+
+// updateInventory sets the planned stock and reads it back
+func (r *VtexInventoryResource) updateInventory(ctx context.Context, skuID int64, warehouseID string, data VtexInventoryResourceModel) (*client.InventoryBalance, error) {
+	update := client.InventoryUpdate{
+		Quantity:          data.Quantity.ValueInt64(),
+		UnlimitedQuantity: data.UnlimitedQuantity.ValueBool(),
+	}
+	if err := r.client.UpdateInventory(ctx, skuID, warehouseID, update); err != nil {
+		return nil, err
+	}
+	return r.client.GetInventoryBalance(ctx, skuID, warehouseID)
+}
+
+// inventorySKUFromModel parses the SKU of the resource
+func inventorySKUFromModel(data VtexInventoryResourceModel) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	skuID, err := parseNumericID(data.SKUID.ValueString())
+	if err != nil {
+		diags.AddError("Invalid VTEX Inventory ID", err.Error())
+	}
+	return skuID, diags
+}
+
+// inventoryToModel copies an API balance into the resource model
+func inventoryToModel(balance *client.InventoryBalance, data *VtexInventoryResourceModel) {
+	data.UnlimitedQuantity = types.BoolValue(balance.HasUnlimitedQuantity)
+	data.ReservedQuantity = types.Int64Value(balance.ReservedQuantity)
+
+	// Unlimited stock reports a placeholder quantity, the configured one is kept
+	switch {
+	case !balance.HasUnlimitedQuantity:
+		data.Quantity = types.Int64Value(balance.TotalQuantity)
+	case data.Quantity.IsNull() || data.Quantity.IsUnknown():
+		data.Quantity = types.Int64Value(0)
+	}
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexIOAppResource{}
+var _ resource.ResourceWithImportState = &VtexIOAppResource{}
+
+// ioMasterWorkspace is the production workspace every account has
+const ioMasterWorkspace = "master"
+
+// ioAppNamePattern matches a VTEX IO app name, vendor.name
+var ioAppNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*\.[a-z0-9][a-z0-9-]*$`)
+
+// ioAppVersionPattern matches an app version (2.1.3, 2.1.3-beta.1) or a range of them (2.x, 2.1.x)
+var ioAppVersionPattern = regexp.MustCompile(`^\d+\.(x|\d+\.(x|\d+(-[0-9A-Za-z.-]+)?))$`)
+
+// ioWorkspaceNamePattern matches a workspace name, lowercase letters and digits starting with a letter
+var ioWorkspaceNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+func NewVtexIOAppResource() resource.Resource {
+	return &VtexIOAppResource{}
+}
+
+// VtexIOAppResource is the resource implementation
+type VtexIOAppResource struct {
+	client *client.VtexClient
+}
+
+// VtexIOAppResourceModel is the resource data model
+type VtexIOAppResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Account          types.String `tfsdk:"account"`
+	Workspace        types.String `tfsdk:"workspace"`
+	App              types.String `tfsdk:"app"`
+	Version          types.String `tfsdk:"version"`
+	InstalledVersion types.String `tfsdk:"installed_version"`
+}
+
+func (r *VtexIOAppResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_io_app"
+}
+
+func (r *VtexIOAppResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Installs a VTEX IO app in a workspace, pinned to a version or a range of versions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account, workspace and app, as account:workspace:app",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account the app is installed in (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(ioMasterWorkspace),
+				Description: "Workspace the app is installed in (default: master)",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioWorkspaceNamePattern, name: "workspace name", example: "master"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app": schema.StringAttribute{
+				Required:    true,
+				Description: "App to install, as vendor.name, e.g. vtex.google-tag-manager. Changing it installs a new app",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioAppNamePattern, name: "vendor.name app name", example: "vtex.google-tag-manager"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:    true,
+				Description: "Version to install, e.g. 3.2.1, or a range like 3.x that installs its latest version. Changing it upgrades or downgrades the app",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioAppVersionPattern, name: "version or version range", example: "3.x"},
+				},
+			},
+			"installed_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version installed",
+			},
+		},
+	}
+}
+
+func (r *VtexIOAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexIOAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexIOAppResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Installing VTEX IO app", map[string]interface{}{
+		"account":   data.Account.ValueString(),
+		"workspace": data.Workspace.ValueString(),
+		"app":       data.App.ValueString(),
+		"version":   data.Version.ValueString(),
+	})
+
+	app, err := r.client.InstallIOApp(ctx, data.Account.ValueString(), data.Workspace.ValueString(), ioAppIDFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX IO App",
+			"Could not install IO app, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ioAppToModel(app, &data)
+
+	tflog.Trace(ctx, "Installed VTEX IO app", map[string]interface{}{
+		"id":      data.ID.ValueString(),
+		"version": data.InstalledVersion.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexIOAppResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX IO app", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	app, err := r.client.GetIOApp(ctx, data.Account.ValueString(), data.Workspace.ValueString(), data.App.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX IO app not installed, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX IO App",
+			"Could not read IO app, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ioAppToModel(app, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexIOAppResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX IO app", map[string]interface{}{
+		"id":      data.ID.ValueString(),
+		"version": data.Version.ValueString(),
+	})
+
+	// Installing another version replaces the installed one
+	app, err := r.client.InstallIOApp(ctx, data.Account.ValueString(), data.Workspace.ValueString(), ioAppIDFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX IO App",
+			"Could not install IO app version, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ioAppToModel(app, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexIOAppResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Uninstalling VTEX IO app", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.UninstallIOApp(ctx, data.Account.ValueString(), data.Workspace.ValueString(), data.App.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX IO App",
+			"Could not uninstall IO app, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Uninstalled VTEX IO app", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexIOAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: account:workspace:app
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 3 {
+		err = fmt.Errorf("expected account:workspace:app, got: %q", req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app"), parts[2])...)
+}
+
+// ioAppIDFromModel builds the app ID to install, vendor.name@version
+func ioAppIDFromModel(data VtexIOAppResourceModel) string {
+	return data.App.ValueString() + "@" + data.Version.ValueString()
+}
+
+// ioAppToModel copies an installed app into the resource model. The configured version is kept
+// while the installed one satisfies it, so ranges like 3.x do not show a diff.
+func ioAppToModel(app *client.IOApp, data *VtexIOAppResourceModel) {
+	name, installed := client.SplitIOAppID(app.ID)
+
+	data.ID = types.StringValue(encodeID(data.Account.ValueString(), data.Workspace.ValueString(), name))
+	data.App = types.StringValue(name)
+	data.InstalledVersion = types.StringValue(installed)
+	if data.Version.IsNull() || !ioVersionSatisfied(data.Version.ValueString(), installed) {
+		data.Version = types.StringValue(installed)
+	}
+}
+
+// ioVersionSatisfied tells if an installed version is the configured one or in its range
+func ioVersionSatisfied(configured, installed string) bool {
+	if prefix, ok := strings.CutSuffix(configured, "x"); ok {
+		return strings.HasPrefix(installed, prefix)
+	}
+	return configured == installed
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexIOAppSettingsDataSource{}
+
+func NewVtexIOAppSettingsDataSource() datasource.DataSource {
+	return &VtexIOAppSettingsDataSource{}
+}
+
+// VtexIOAppSettingsDataSource is the data source implementation
+type VtexIOAppSettingsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexIOAppSettingsDataSourceModel is the data source data model
+type VtexIOAppSettingsDataSourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Account   types.String   `tfsdk:"account"`
+	Workspace types.String   `tfsdk:"workspace"`
+	App       types.String   `tfsdk:"app"`
+	Keys      []types.String `tfsdk:"keys"`
+	Settings  types.String   `tfsdk:"settings"`
+	Values    types.Map      `tfsdk:"values"`
+}
+
+func (d *VtexIOAppSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_io_app_settings"
+}
+
+func (d *VtexIOAppSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the settings of a VTEX IO app installed in a workspace, to use values configured elsewhere without managing them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account, workspace and app, as account:workspace:app",
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account the app is installed in (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+			},
+			"workspace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Workspace the app is installed in (default: master)",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioWorkspaceNamePattern, name: "workspace name", example: "master"},
+				},
+			},
+			"app": schema.StringAttribute{
+				Required:    true,
+				Description: "App to read the settings of, as vendor.name, e.g. vtex.google-tag-manager",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioAppNamePattern, name: "vendor.name app name", example: "vtex.google-tag-manager"},
+				},
+			},
+			"keys": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Settings to read. All settings when not set",
+			},
+			"settings": schema.StringAttribute{
+				Computed:    true,
+				Description: "Settings read, as a JSON object to use with jsondecode()",
+			},
+			"values": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Settings read by name: strings as they are, other values as JSON. Null settings are left out",
+			},
+		},
+	}
+}
+
+func (d *VtexIOAppSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexIOAppSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexIOAppSettingsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account := data.Account.ValueString()
+	app := data.App.ValueString()
+	workspace := ioMasterWorkspace
+	if !data.Workspace.IsNull() {
+		workspace = data.Workspace.ValueString()
+	}
+
+	tflog.Debug(ctx, "Reading VTEX IO app settings", map[string]interface{}{
+		"account":   account,
+		"workspace": workspace,
+		"app":       app,
+	})
+
+	settings, err := d.client.GetIOAppSettings(ctx, account, workspace, app)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("app"),
+			"VTEX IO App Not Found",
+			fmt.Sprintf("App %q is not installed in workspace %q of account %q", app, workspace, account),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX IO App Settings",
+			"Could not read IO app settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Only the settings asked for, when there is a list
+	if data.Keys != nil {
+		selected := client.IOAppSettings{}
+		for _, key := range data.Keys {
+			if value, ok := settings[key.ValueString()]; ok {
+				selected[key.ValueString()] = value
+			}
+		}
+		settings = selected
+	}
+
+	encoded, err := json.Marshal(settings)
+	var normalized string
+	if err == nil {
+		normalized, err = normalizeJSON(string(encoded))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected App Settings",
+			"Could not encode the app settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	data.Settings = types.StringValue(normalized)
+
+	values := map[string]string{}
+	for name, value := range settings {
+		var text string
+		switch {
+		case strings.TrimSpace(string(value)) == "null":
+			// Null settings are left out
+		case json.Unmarshal(value, &text) == nil:
+			values[name] = text
+		default:
+			values[name], _ = normalizeJSON(string(value))
+		}
+	}
+	valuesMap, diags := types.MapValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	data.Values = valuesMap
+
+	data.Workspace = types.StringValue(workspace)
+	data.ID = types.StringValue(encodeID(account, workspace, app))
+
+	tflog.Trace(ctx, "Read VTEX IO app settings", map[string]interface{}{
+		"id":       data.ID.ValueString(),
+		"settings": len(values),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
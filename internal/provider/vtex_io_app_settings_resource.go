@@ -0,0 +1,406 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexIOAppSettingsResource{}
+var _ resource.ResourceWithImportState = &VtexIOAppSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &VtexIOAppSettingsResource{}
+
+func NewVtexIOAppSettingsResource() resource.Resource {
+	return &VtexIOAppSettingsResource{}
+}
+
+// VtexIOAppSettingsResource is the resource implementation
+type VtexIOAppSettingsResource struct {
+	client *client.VtexClient
+}
+
+// VtexIOAppSettingsResourceModel is the resource data model
+type VtexIOAppSettingsResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Account           types.String `tfsdk:"account"`
+	Workspace         types.String `tfsdk:"workspace"`
+	App               types.String `tfsdk:"app"`
+	Settings          types.String `tfsdk:"settings"`
+	SensitiveSettings types.String `tfsdk:"sensitive_settings"`
+}
+
+func (r *VtexIOAppSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_io_app_settings"
+}
+
+func (r *VtexIOAppSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of a VTEX IO app installed in a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account, workspace and app, as account:workspace:app",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account the app is installed in (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(ioMasterWorkspace),
+				Description: "Workspace the app is installed in (default: master)",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioWorkspaceNamePattern, name: "workspace name", example: "master"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app": schema.StringAttribute{
+				Required:    true,
+				Description: "App whose settings are managed, as vendor.name, e.g. vtex.google-tag-manager",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioAppNamePattern, name: "vendor.name app name", example: "vtex.google-tag-manager"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"settings": schema.StringAttribute{
+				Optional:    true,
+				Description: "Settings as a JSON object, e.g. from jsonencode(). Formatting and key order are ignored",
+				PlanModifiers: []planmodifier.String{
+					semanticJSONModifier{},
+				},
+			},
+			"sensitive_settings": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Settings with secrets as a JSON object, saved with settings but hidden in plans and output",
+				PlanModifiers: []planmodifier.String{
+					semanticJSONModifier{},
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexIOAppSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexIOAppSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexIOAppSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var settings, sensitive client.IOAppSettings
+	var err error
+	if isKnown(data.Settings) {
+		if settings, err = parseIOAppSettings(data.Settings.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("settings"), "Invalid App Settings", err.Error())
+		}
+	}
+	if isKnown(data.SensitiveSettings) {
+		// The value is not shown, it is secret
+		if sensitive, err = parseIOAppSettings(data.SensitiveSettings.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("sensitive_settings"), "Invalid App Settings", "Expected a JSON object, e.g. from jsonencode().")
+		}
+	}
+
+	for name := range sensitive {
+		if _, ok := settings[name]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sensitive_settings"),
+				"Duplicate App Setting",
+				fmt.Sprintf("Setting %q is in both settings and sensitive_settings.", name),
+			)
+		}
+	}
+}
+
+func (r *VtexIOAppSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexIOAppSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := ioAppSettingsFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX IO app settings", map[string]interface{}{
+		"account":   data.Account.ValueString(),
+		"workspace": data.Workspace.ValueString(),
+		"app":       data.App.ValueString(),
+		"settings":  len(settings),
+	})
+
+	result, err := r.client.SaveIOAppSettings(ctx, data.Account.ValueString(), data.Workspace.ValueString(), data.App.ValueString(), settings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX IO App Settings",
+			"Could not save IO app settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(encodeID(data.Account.ValueString(), data.Workspace.ValueString(), data.App.ValueString()))
+	resp.Diagnostics.Append(ioAppSettingsToModel(result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX IO app settings", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOAppSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexIOAppSettingsResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX IO app settings", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	settings, err := r.client.GetIOAppSettings(ctx, data.Account.ValueString(), data.Workspace.ValueString(), data.App.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX IO app not installed, removing its settings from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX IO App Settings",
+			"Could not read IO app settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ioAppSettingsToModel(settings, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOAppSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexIOAppSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := ioAppSettingsFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX IO app settings", map[string]interface{}{
+		"id":       data.ID.ValueString(),
+		"settings": len(settings),
+	})
+
+	result, err := r.client.SaveIOAppSettings(ctx, data.Account.ValueString(), data.Workspace.ValueString(), data.App.ValueString(), settings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX IO App Settings",
+			"Could not save IO app settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ioAppSettingsToModel(result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOAppSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexIOAppSettingsResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Clearing VTEX IO app settings", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// The settings cannot be deleted, they are emptied
+	_, err := r.client.SaveIOAppSettings(ctx, data.Account.ValueString(), data.Workspace.ValueString(), data.App.ValueString(), client.IOAppSettings{})
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX IO App Settings",
+			"Could not clear IO app settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Cleared VTEX IO app settings", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexIOAppSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: account:workspace:app
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 3 {
+		err = fmt.Errorf("expected account:workspace:app, got: %q", req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app"), parts[2])...)
+}
+
+// parseIOAppSettings parses app settings given as a JSON object
+func parseIOAppSettings(value string) (client.IOAppSettings, error) {
+	var settings client.IOAppSettings
+	if err := json.Unmarshal([]byte(value), &settings); err != nil || settings == nil {
+		return nil, fmt.Errorf("expected a JSON object, e.g. from jsonencode()")
+	}
+	return settings, nil
+}
+
+// ioAppSettingsFromModel merges settings and sensitive_settings into the settings to save
+func ioAppSettingsFromModel(data VtexIOAppSettingsResourceModel) (client.IOAppSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings := client.IOAppSettings{}
+	for _, value := range []types.String{data.Settings, data.SensitiveSettings} {
+		if value.IsNull() {
+			continue
+		}
+		parsed, err := parseIOAppSettings(value.ValueString())
+		if err != nil {
+			diags.AddError("Invalid App Settings", err.Error())
+			return nil, diags
+		}
+		for name, setting := range parsed {
+			settings[name] = setting
+		}
+	}
+	return settings, diags
+}
+
+// ioAppSettingsToModel splits the app settings into the resource model: the ones named in
+// sensitive_settings go there and the rest go to settings. The configured documents are kept
+// while they have the same settings.
+func ioAppSettingsToModel(settings client.IOAppSettings, data *VtexIOAppSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var sensitiveNames client.IOAppSettings
+	if !data.SensitiveSettings.IsNull() {
+		sensitiveNames, _ = parseIOAppSettings(data.SensitiveSettings.ValueString())
+	}
+
+	plain := client.IOAppSettings{}
+	sensitive := client.IOAppSettings{}
+	for name, value := range settings {
+		if _, ok := sensitiveNames[name]; ok {
+			sensitive[name] = value
+		} else {
+			plain[name] = value
+		}
+	}
+
+	for _, part := range []struct {
+		settings client.IOAppSettings
+		value    *types.String
+	}{
+		{plain, &data.Settings},
+		{sensitive, &data.SensitiveSettings},
+	} {
+		// Not configured and nothing to show
+		if part.value.IsNull() && len(part.settings) == 0 {
+			continue
+		}
+		encoded, err := json.Marshal(part.settings)
+		var normalized string
+		if err == nil {
+			normalized, err = normalizeJSON(string(encoded))
+		}
+		if err != nil {
+			diags.AddError("Unexpected App Settings", "Could not encode the app settings: "+err.Error())
+			return diags
+		}
+		if !part.value.IsNull() && !part.value.IsUnknown() {
+			if configured, err := normalizeJSON(part.value.ValueString()); err == nil && configured == normalized {
+				continue
+			}
+		}
+		*part.value = types.StringValue(normalized)
+	}
+	return diags
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexIOAppsDataSource{}
+
+func NewVtexIOAppsDataSource() datasource.DataSource {
+	return &VtexIOAppsDataSource{}
+}
+
+// VtexIOAppsDataSource is the data source implementation
+type VtexIOAppsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexIOAppsDataSourceModel is the data source data model
+type VtexIOAppsDataSourceModel struct {
+	ID        types.String         `tfsdk:"id"`
+	Account   types.String         `tfsdk:"account"`
+	Workspace types.String         `tfsdk:"workspace"`
+	Apps      []VtexIOAppItemModel `tfsdk:"apps"`
+	Versions  types.Map            `tfsdk:"versions"`
+}
+
+// VtexIOAppItemModel is an installed app in the list
+type VtexIOAppItemModel struct {
+	ID           types.String   `tfsdk:"id"`
+	App          types.String   `tfsdk:"app"`
+	Version      types.String   `tfsdk:"version"`
+	Dependencies []types.String `tfsdk:"dependencies"`
+}
+
+func (d *VtexIOAppsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_io_apps"
+}
+
+func (d *VtexIOAppsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the VTEX IO apps installed in a workspace, with their versions and the apps they depend on.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account and workspace, as account:workspace",
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account of the workspace (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+			},
+			"workspace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Workspace to list the apps of (default: master)",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioWorkspaceNamePattern, name: "workspace name", example: "master"},
+				},
+			},
+			"apps": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Apps installed, sorted by app name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "App and version, as vendor.name@version",
+						},
+						"app": schema.StringAttribute{
+							Computed:    true,
+							Description: "App name, as vendor.name",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Version installed",
+						},
+						"dependencies": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Apps the app depends on, as vendor.name@version, as resolved in the workspace",
+						},
+					},
+				},
+			},
+			"versions": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Version installed of each app, by vendor.name",
+			},
+		},
+	}
+}
+
+func (d *VtexIOAppsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexIOAppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexIOAppsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account := data.Account.ValueString()
+	workspace := ioMasterWorkspace
+	if !data.Workspace.IsNull() {
+		workspace = data.Workspace.ValueString()
+	}
+
+	tflog.Debug(ctx, "Listing VTEX IO apps", map[string]interface{}{
+		"account":   account,
+		"workspace": workspace,
+	})
+
+	apps, err := d.client.ListIOApps(ctx, account, workspace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX IO Apps",
+			"Could not list IO apps, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	dependencies, err := d.client.GetIOAppDependencies(ctx, account, workspace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX IO Apps",
+			"Could not read IO app dependencies, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(apps, func(i, j int) bool { return apps[i].ID < apps[j].ID })
+
+	data.Apps = make([]VtexIOAppItemModel, 0, len(apps))
+	versions := map[string]string{}
+	for _, app := range apps {
+		name, version := client.SplitIOAppID(app.ID)
+
+		// Dependencies are listed by app ID, or by name for some apps
+		appDependencies, ok := dependencies[app.ID]
+		if !ok {
+			appDependencies = dependencies[name]
+		}
+		sorted := append([]string{}, appDependencies...)
+		sort.Strings(sorted)
+
+		data.Apps = append(data.Apps, VtexIOAppItemModel{
+			ID:           types.StringValue(app.ID),
+			App:          types.StringValue(name),
+			Version:      types.StringValue(version),
+			Dependencies: stringValues(sorted),
+		})
+		versions[name] = version
+	}
+
+	versionsMap, diags := types.MapValueFrom(ctx, types.StringType, versions)
+	resp.Diagnostics.Append(diags...)
+	data.Versions = versionsMap
+
+	data.Workspace = types.StringValue(workspace)
+	data.ID = types.StringValue(encodeID(account, workspace))
+
+	tflog.Trace(ctx, "Listed VTEX IO apps", map[string]interface{}{
+		"count": len(data.Apps),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
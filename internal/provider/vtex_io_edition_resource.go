@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexIOEditionResource{}
+var _ resource.ResourceWithImportState = &VtexIOEditionResource{}
+var _ resource.ResourceWithValidateConfig = &VtexIOEditionResource{}
+
+func NewVtexIOEditionResource() resource.Resource {
+	return &VtexIOEditionResource{}
+}
+
+// VtexIOEditionResource is the resource implementation
+type VtexIOEditionResource struct {
+	client *client.VtexClient
+}
+
+// VtexIOEditionResourceModel is the resource data model
+type VtexIOEditionResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	SponsorAccount   types.String `tfsdk:"sponsor_account"`
+	Account          types.String `tfsdk:"account"`
+	Workspace        types.String `tfsdk:"workspace"`
+	Edition          types.String `tfsdk:"edition"`
+	Version          types.String `tfsdk:"version"`
+	InstalledVersion types.String `tfsdk:"installed_version"`
+}
+
+func (r *VtexIOEditionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_io_edition"
+}
+
+func (r *VtexIOEditionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets the edition app of an account sponsored by another account: the apps every workspace of the account must have.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Sponsor account, account and workspace, as sponsor_account:account:workspace",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sponsor_account": schema.StringAttribute{
+				Required:    true,
+				Description: "Sponsor (parent) account that sets the edition (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "Sponsored account the edition is set for (e.g. vendorfranchise1)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(ioMasterWorkspace),
+				Description: "Workspace of the account the edition is set in (default: master)",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioWorkspaceNamePattern, name: "workspace name", example: "master"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"edition": schema.StringAttribute{
+				Required:    true,
+				Description: "Edition app, as vendor.name, e.g. vtex.edition-store",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioAppNamePattern, name: "vendor.name app name", example: "vtex.edition-store"},
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:    true,
+				Description: "Version of the edition app, e.g. 5.1.0, or a range like 5.x",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioAppVersionPattern, name: "version or version range", example: "5.x"},
+				},
+			},
+			"installed_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the edition app the account has",
+			},
+		},
+	}
+}
+
+func (r *VtexIOEditionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexIOEditionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexIOEditionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.SponsorAccount) && isKnown(data.Account) && data.SponsorAccount.ValueString() == data.Account.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("account"),
+			"Invalid Sponsored Account",
+			"An account cannot set its own edition; use the account of its sponsor as sponsor_account.",
+		)
+	}
+}
+
+func (r *VtexIOEditionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexIOEditionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Setting VTEX IO edition", map[string]interface{}{
+		"sponsor_account": data.SponsorAccount.ValueString(),
+		"account":         data.Account.ValueString(),
+		"workspace":       data.Workspace.ValueString(),
+		"edition":         ioEditionIDFromModel(data),
+	})
+
+	edition, err := r.client.SetIOEdition(ctx, data.SponsorAccount.ValueString(), data.Account.ValueString(), data.Workspace.ValueString(), ioEditionIDFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX IO Edition",
+			"Could not set IO edition, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ioEditionToModel(edition, &data)
+
+	tflog.Trace(ctx, "Set VTEX IO edition", map[string]interface{}{
+		"id":      data.ID.ValueString(),
+		"version": data.InstalledVersion.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOEditionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexIOEditionResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX IO edition", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	edition, err := r.client.GetIOEdition(ctx, data.Account.ValueString(), data.Workspace.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX IO edition not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX IO Edition",
+			"Could not read IO edition, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ioEditionToModel(edition, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOEditionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexIOEditionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX IO edition", map[string]interface{}{
+		"id":      data.ID.ValueString(),
+		"edition": ioEditionIDFromModel(data),
+	})
+
+	edition, err := r.client.SetIOEdition(ctx, data.SponsorAccount.ValueString(), data.Account.ValueString(), data.Workspace.ValueString(), ioEditionIDFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX IO Edition",
+			"Could not set IO edition, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ioEditionToModel(edition, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOEditionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// An account always has an edition, it is only removed from state
+	tflog.Debug(ctx, "Removing VTEX IO edition from state, the account keeps its edition")
+}
+
+func (r *VtexIOEditionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: sponsor_account:account:workspace
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 3 {
+		err = fmt.Errorf("expected sponsor_account:account:workspace, got: %q", req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sponsor_account"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), parts[2])...)
+}
+
+// ioEditionIDFromModel builds the edition app ID to set, vendor.name@version
+func ioEditionIDFromModel(data VtexIOEditionResourceModel) string {
+	return data.Edition.ValueString() + "@" + data.Version.ValueString()
+}
+
+// ioEditionToModel copies the edition of the account into the resource model. The configured
+// version is kept while the installed one satisfies it, like for vtex_io_app.
+func ioEditionToModel(edition *client.IOEdition, data *VtexIOEditionResourceModel) {
+	name, installed := client.SplitIOAppID(edition.ID)
+
+	data.ID = types.StringValue(encodeID(data.SponsorAccount.ValueString(), data.Account.ValueString(), data.Workspace.ValueString()))
+	data.Edition = types.StringValue(name)
+	data.InstalledVersion = types.StringValue(installed)
+	if data.Version.IsNull() || !ioVersionSatisfied(data.Version.ValueString(), installed) {
+		data.Version = types.StringValue(installed)
+	}
+}
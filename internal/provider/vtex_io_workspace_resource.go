@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexIOWorkspaceResource{}
+var _ resource.ResourceWithImportState = &VtexIOWorkspaceResource{}
+var _ resource.ResourceWithValidateConfig = &VtexIOWorkspaceResource{}
+
+func NewVtexIOWorkspaceResource() resource.Resource {
+	return &VtexIOWorkspaceResource{}
+}
+
+// VtexIOWorkspaceResource is the resource implementation
+type VtexIOWorkspaceResource struct {
+	client *client.VtexClient
+}
+
+// VtexIOWorkspaceResourceModel is the resource data model
+type VtexIOWorkspaceResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Account    types.String `tfsdk:"account"`
+	Name       types.String `tfsdk:"name"`
+	Production types.Bool   `tfsdk:"production"`
+	Weight     types.Int64  `tfsdk:"weight"`
+}
+
+func (r *VtexIOWorkspaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_io_workspace"
+}
+
+func (r *VtexIOWorkspaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a VTEX IO workspace, such as a development or review workspace of a store.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account and workspace, as account:name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account of the workspace (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the workspace, lowercase letters and digits. Changing it creates a new workspace",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioWorkspaceNamePattern, name: "workspace name", example: "review123"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"production": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the workspace is a production workspace, which can serve real traffic (default: false)",
+			},
+			"weight": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Share of the traffic the workspace gets in an A/B test against master (default: 0)",
+			},
+		},
+	}
+}
+
+func (r *VtexIOWorkspaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexIOWorkspaceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexIOWorkspaceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.Name) && data.Name.ValueString() == ioMasterWorkspace {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Workspace Name",
+			"The master workspace always exists and cannot be created or deleted.",
+		)
+	}
+
+	if isKnown(data.Weight) && data.Weight.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("weight"),
+			"Invalid Weight",
+			fmt.Sprintf("Expected 0 or more, got: %d", data.Weight.ValueInt64()),
+		)
+	}
+}
+
+func (r *VtexIOWorkspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexIOWorkspaceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX IO workspace", map[string]interface{}{
+		"account":    data.Account.ValueString(),
+		"name":       data.Name.ValueString(),
+		"production": data.Production.ValueBool(),
+	})
+
+	workspace, err := r.client.CreateIOWorkspace(ctx, data.Account.ValueString(), ioWorkspaceFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX IO Workspace",
+			"Could not create IO workspace, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ioWorkspaceToModel(workspace, &data)
+
+	tflog.Trace(ctx, "Created VTEX IO workspace", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOWorkspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexIOWorkspaceResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX IO workspace", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	workspace, err := r.client.GetIOWorkspace(ctx, data.Account.ValueString(), data.Name.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX IO workspace not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX IO Workspace",
+			"Could not read IO workspace, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ioWorkspaceToModel(workspace, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOWorkspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexIOWorkspaceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX IO workspace", map[string]interface{}{
+		"id":         data.ID.ValueString(),
+		"production": data.Production.ValueBool(),
+		"weight":     data.Weight.ValueInt64(),
+	})
+
+	workspace, err := r.client.UpdateIOWorkspace(ctx, data.Account.ValueString(), ioWorkspaceFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX IO Workspace",
+			"Could not update IO workspace, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ioWorkspaceToModel(workspace, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexIOWorkspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexIOWorkspaceResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX IO workspace", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteIOWorkspace(ctx, data.Account.ValueString(), data.Name.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX IO Workspace",
+			"Could not delete IO workspace, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX IO workspace", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexIOWorkspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: account:name
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 2 {
+		err = fmt.Errorf("expected account:name, got: %q", req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// ioWorkspaceFromModel builds the API payload from the resource model
+func ioWorkspaceFromModel(data VtexIOWorkspaceResourceModel) client.IOWorkspace {
+	return client.IOWorkspace{
+		Name:       data.Name.ValueString(),
+		Production: data.Production.ValueBool(),
+		Weight:     data.Weight.ValueInt64(),
+	}
+}
+
+// ioWorkspaceToModel copies an API workspace into the resource model
+func ioWorkspaceToModel(workspace *client.IOWorkspace, data *VtexIOWorkspaceResourceModel) {
+	data.ID = types.StringValue(encodeID(data.Account.ValueString(), workspace.Name))
+	data.Name = types.StringValue(workspace.Name)
+	data.Production = types.BoolValue(workspace.Production)
+	data.Weight = types.Int64Value(workspace.Weight)
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexIOWorkspacesDataSource{}
+
+func NewVtexIOWorkspacesDataSource() datasource.DataSource {
+	return &VtexIOWorkspacesDataSource{}
+}
+
+// VtexIOWorkspacesDataSource is the data source implementation
+type VtexIOWorkspacesDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexIOWorkspacesDataSourceModel is the data source data model
+type VtexIOWorkspacesDataSourceModel struct {
+	ID         types.String               `tfsdk:"id"`
+	Account    types.String               `tfsdk:"account"`
+	NamePrefix types.String               `tfsdk:"name_prefix"`
+	Workspaces []VtexIOWorkspaceItemModel `tfsdk:"workspaces"`
+	Names      []types.String             `tfsdk:"names"`
+}
+
+// VtexIOWorkspaceItemModel is a workspace in the list
+type VtexIOWorkspaceItemModel struct {
+	Name       types.String `tfsdk:"name"`
+	Production types.Bool   `tfsdk:"production"`
+	Weight     types.Int64  `tfsdk:"weight"`
+}
+
+func (d *VtexIOWorkspacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_io_workspaces"
+}
+
+func (d *VtexIOWorkspacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the VTEX IO workspaces of an account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account, or account:name_prefix",
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account to list the workspaces of (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the workspaces whose name starts with this, e.g. review",
+			},
+			"workspaces": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Workspaces found, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the workspace",
+						},
+						"production": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether it is a production workspace",
+						},
+						"weight": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Share of the traffic the workspace gets in an A/B test",
+						},
+					},
+				},
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of the workspaces found, sorted",
+			},
+		},
+	}
+}
+
+func (d *VtexIOWorkspacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexIOWorkspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexIOWorkspacesDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account := data.Account.ValueString()
+	prefix := data.NamePrefix.ValueString()
+
+	tflog.Debug(ctx, "Listing VTEX IO workspaces", map[string]interface{}{
+		"account":     account,
+		"name_prefix": prefix,
+	})
+
+	workspaces, err := d.client.ListIOWorkspaces(ctx, account)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX IO Workspaces",
+			"Could not list IO workspaces, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(workspaces, func(i, j int) bool { return workspaces[i].Name < workspaces[j].Name })
+
+	data.Workspaces = make([]VtexIOWorkspaceItemModel, 0, len(workspaces))
+	data.Names = make([]types.String, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		if !strings.HasPrefix(workspace.Name, prefix) {
+			continue
+		}
+		data.Workspaces = append(data.Workspaces, VtexIOWorkspaceItemModel{
+			Name:       types.StringValue(workspace.Name),
+			Production: types.BoolValue(workspace.Production),
+			Weight:     types.Int64Value(workspace.Weight),
+		})
+		data.Names = append(data.Names, types.StringValue(workspace.Name))
+	}
+
+	data.ID = types.StringValue(account)
+	if prefix != "" {
+		data.ID = types.StringValue(encodeID(account, prefix))
+	}
+
+	tflog.Trace(ctx, "Listed VTEX IO workspaces", map[string]interface{}{
+		"count": len(data.Workspaces),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
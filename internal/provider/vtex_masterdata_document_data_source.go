@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexMasterDataDocumentDataSource{}
+
+func NewVtexMasterDataDocumentDataSource() datasource.DataSource {
+	return &VtexMasterDataDocumentDataSource{}
+}
+
+// VtexMasterDataDocumentDataSource is the data source implementation
+type VtexMasterDataDocumentDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexMasterDataDocumentDataSourceModel is the data source data model
+type VtexMasterDataDocumentDataSourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	DataEntity types.String   `tfsdk:"data_entity"`
+	DocumentID types.String   `tfsdk:"document_id"`
+	Schema     types.String   `tfsdk:"schema"`
+	Fields     []types.String `tfsdk:"fields"`
+	Document   types.String   `tfsdk:"document"`
+	Values     types.Map      `tfsdk:"values"`
+}
+
+func (d *VtexMasterDataDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_masterdata_document"
+}
+
+func (d *VtexMasterDataDocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a Master Data document by ID, to use values stored in Master Data without managing them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Data entity and document ID, as data_entity:document_id",
+			},
+			"data_entity": schema.StringAttribute{
+				Required:    true,
+				Description: "Data entity of the document, e.g. featureflags",
+			},
+			"document_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Document ID",
+			},
+			"schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schema the fields are read with, e.g. v1",
+			},
+			"fields": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Fields to read. All fields when not set",
+			},
+			"document": schema.StringAttribute{
+				Computed:    true,
+				Description: "Fields read, as a JSON object to use with jsondecode()",
+			},
+			"values": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Fields read by name: strings as they are, other values as JSON. Null fields are left out",
+			},
+		},
+	}
+}
+
+func (d *VtexMasterDataDocumentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexMasterDataDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexMasterDataDocumentDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataEntity := data.DataEntity.ValueString()
+	documentID := data.DocumentID.ValueString()
+	var fields []string
+	for _, field := range data.Fields {
+		fields = append(fields, field.ValueString())
+	}
+
+	tflog.Debug(ctx, "Reading VTEX Master Data document", map[string]interface{}{
+		"data_entity": dataEntity,
+		"document_id": documentID,
+		"fields":      strings.Join(fields, ","),
+	})
+
+	document, err := d.client.GetMasterDataDocument(ctx, dataEntity, documentID, data.Schema.ValueString(), fields)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("document_id"),
+			"VTEX Master Data Document Not Found",
+			fmt.Sprintf("No document with ID %q in data entity %q", documentID, dataEntity),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Master Data Document",
+			"Could not read Master Data document, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	encoded, err := json.Marshal(document)
+	var normalized string
+	if err == nil {
+		normalized, err = normalizeJSON(string(encoded))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Master Data Document",
+			"Could not encode the document, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	data.Document = types.StringValue(normalized)
+
+	values := map[string]string{}
+	for name, value := range document {
+		var text string
+		switch {
+		case strings.TrimSpace(string(value)) == "null":
+			// Null fields are left out
+		case json.Unmarshal(value, &text) == nil:
+			values[name] = text
+		default:
+			values[name], _ = normalizeJSON(string(value))
+		}
+	}
+	valuesMap, diags := types.MapValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	data.Values = valuesMap
+
+	data.ID = types.StringValue(encodeID(dataEntity, documentID))
+
+	tflog.Trace(ctx, "Read VTEX Master Data document", map[string]interface{}{
+		"id":     data.ID.ValueString(),
+		"fields": len(values),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,416 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexMasterDataDocumentResource{}
+var _ resource.ResourceWithImportState = &VtexMasterDataDocumentResource{}
+var _ resource.ResourceWithValidateConfig = &VtexMasterDataDocumentResource{}
+
+func NewVtexMasterDataDocumentResource() resource.Resource {
+	return &VtexMasterDataDocumentResource{}
+}
+
+// VtexMasterDataDocumentResource is the resource implementation
+type VtexMasterDataDocumentResource struct {
+	client *client.VtexClient
+}
+
+// VtexMasterDataDocumentResourceModel is the resource data model
+type VtexMasterDataDocumentResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	DataEntity types.String `tfsdk:"data_entity"`
+	Schema     types.String `tfsdk:"schema"`
+	DocumentID types.String `tfsdk:"document_id"`
+	Fields     types.String `tfsdk:"fields"`
+}
+
+func (r *VtexMasterDataDocumentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_masterdata_document"
+}
+
+func (r *VtexMasterDataDocumentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Master Data v2 document, such as a feature flag or a store setting kept in a data entity.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Data entity and document ID, as data_entity:document_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_entity": schema.StringAttribute{
+				Required:    true,
+				Description: "Data entity of the document, e.g. featureflags. Changing it creates a new document",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schema the document is validated against and indexed with, e.g. v1",
+			},
+			"document_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Document ID. Generated by Master Data when not set. Changing it creates a new document",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fields": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON object with the fields of the document, e.g. from jsonencode(). Only these fields are managed; formatting and key order are ignored",
+				PlanModifiers: []planmodifier.String{
+					semanticJSONModifier{},
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexMasterDataDocumentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexMasterDataDocumentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexMasterDataDocumentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !isKnown(data.Fields) {
+		return
+	}
+
+	if _, err := parseMasterDataFields(data.Fields.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid Document Fields", err.Error())
+	}
+}
+
+func (r *VtexMasterDataDocumentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexMasterDataDocumentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, err := parseMasterDataFields(data.Fields.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid Document Fields", err.Error())
+		return
+	}
+
+	dataEntity := data.DataEntity.ValueString()
+	documentID := data.DocumentID.ValueString()
+
+	tflog.Debug(ctx, "Creating VTEX Master Data document", map[string]interface{}{
+		"data_entity": dataEntity,
+		"document_id": documentID,
+		"schema":      data.Schema.ValueString(),
+	})
+
+	if documentID != "" {
+		err = r.client.SaveMasterDataDocument(ctx, dataEntity, documentID, data.Schema.ValueString(), fields)
+	} else {
+		documentID, err = r.client.CreateMasterDataDocument(ctx, dataEntity, data.Schema.ValueString(), fields)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Master Data Document",
+			"Could not create Master Data document, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(encodeID(dataEntity, documentID))
+	data.DocumentID = types.StringValue(documentID)
+
+	document, err := r.client.GetMasterDataDocument(ctx, dataEntity, documentID, data.Schema.ValueString(), masterDataFieldNames(fields))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Master Data Document",
+			"Created Master Data document "+documentID+" but could not read it back, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(masterDataDocumentToModel(document, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX Master Data document", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexMasterDataDocumentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexMasterDataDocumentResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported documents have no fields yet, so all of them are read
+	var fieldNames []string
+	if !data.Fields.IsNull() {
+		if fields, err := parseMasterDataFields(data.Fields.ValueString()); err == nil {
+			fieldNames = masterDataFieldNames(fields)
+		}
+	}
+
+	tflog.Debug(ctx, "Reading VTEX Master Data document", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	document, err := r.client.GetMasterDataDocument(ctx, data.DataEntity.ValueString(), data.DocumentID.ValueString(), data.Schema.ValueString(), fieldNames)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX Master Data document not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Master Data Document",
+			"Could not read Master Data document, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(masterDataDocumentToModel(document, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexMasterDataDocumentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexMasterDataDocumentResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, err := parseMasterDataFields(plan.Fields.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid Document Fields", err.Error())
+		return
+	}
+	// Fields of the state that no longer parse are not removed
+	previous, _ := parseMasterDataFields(state.Fields.ValueString())
+	changes := masterDataFieldChanges(previous, fields)
+
+	tflog.Debug(ctx, "Updating VTEX Master Data document", map[string]interface{}{
+		"id":      plan.ID.ValueString(),
+		"changes": len(changes),
+	})
+
+	// Only the changed fields are sent, so fields managed elsewhere are kept
+	if len(changes) > 0 {
+		err = r.client.UpdateMasterDataDocument(ctx, plan.DataEntity.ValueString(), plan.DocumentID.ValueString(), plan.Schema.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating VTEX Master Data Document",
+				"Could not update Master Data document, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	document, err := r.client.GetMasterDataDocument(ctx, plan.DataEntity.ValueString(), plan.DocumentID.ValueString(), plan.Schema.ValueString(), masterDataFieldNames(fields))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Master Data Document",
+			"Could not read Master Data document, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(masterDataDocumentToModel(document, &plan)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexMasterDataDocumentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexMasterDataDocumentResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX Master Data document", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteMasterDataDocument(ctx, data.DataEntity.ValueString(), data.DocumentID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Master Data Document",
+			"Could not delete Master Data document, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX Master Data document", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexMasterDataDocumentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: data_entity:document_id
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 2 {
+		err = fmt.Errorf("expected data_entity:document_id, got: %q", req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_entity"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("document_id"), parts[1])...)
+}
+
+// parseMasterDataFields parses the configured fields of a document. Master Data sets the
+// system fields itself, and a null value would never be read back, so both are rejected.
+func parseMasterDataFields(value string) (client.MasterDataDocument, error) {
+	var fields client.MasterDataDocument
+	if err := json.Unmarshal([]byte(value), &fields); err != nil || fields == nil {
+		return nil, fmt.Errorf("expected a JSON object, e.g. from jsonencode()")
+	}
+	for _, name := range client.MasterDataSystemFields {
+		if _, ok := fields[name]; ok {
+			return nil, fmt.Errorf("%q is set by Master Data and cannot be managed, use document_id for the ID", name)
+		}
+	}
+	for name, fieldValue := range fields {
+		if bytes.Equal(bytes.TrimSpace(fieldValue), []byte("null")) {
+			return nil, fmt.Errorf("%q is null, remove the field instead", name)
+		}
+	}
+	return fields, nil
+}
+
+// masterDataFieldNames returns the names of the fields to read, sorted. The ID is read
+// when there are none, as reading no fields reads all of them.
+func masterDataFieldNames(fields client.MasterDataDocument) []string {
+	if len(fields) == 0 {
+		return []string{"id"}
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// masterDataFieldChanges returns the fields to PATCH to go from the previous fields to the
+// wanted ones: the new or changed fields, and null for the removed ones
+func masterDataFieldChanges(previous, wanted client.MasterDataDocument) client.MasterDataDocument {
+	changes := client.MasterDataDocument{}
+	for name, value := range wanted {
+		current, ok := previous[name]
+		if !ok {
+			changes[name] = value
+			continue
+		}
+		valueJSON, errValue := normalizeJSON(string(value))
+		currentJSON, errCurrent := normalizeJSON(string(current))
+		if errValue != nil || errCurrent != nil || valueJSON != currentJSON {
+			changes[name] = value
+		}
+	}
+	for name := range previous {
+		if _, ok := wanted[name]; !ok {
+			changes[name] = json.RawMessage("null")
+		}
+	}
+	return changes
+}
+
+// masterDataDocumentToModel copies the fields of an API document into the resource model,
+// leaving out the system fields and the fields that are not set. The configured fields are
+// kept while the document has the same values, so formatting and key order do not show as a diff.
+func masterDataDocumentToModel(document client.MasterDataDocument, data *VtexMasterDataDocumentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := client.MasterDataDocument{}
+	for name, value := range document {
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			continue
+		}
+		fields[name] = value
+	}
+	for _, name := range client.MasterDataSystemFields {
+		delete(fields, name)
+	}
+
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		diags.AddError("Unexpected Master Data Document", "Could not encode the document fields: "+err.Error())
+		return diags
+	}
+	normalized, err := normalizeJSON(string(encoded))
+	if err != nil {
+		diags.AddError("Unexpected Master Data Document", "Could not encode the document fields: "+err.Error())
+		return diags
+	}
+
+	if !data.Fields.IsNull() && !data.Fields.IsUnknown() {
+		if configured, err := normalizeJSON(data.Fields.ValueString()); err == nil && configured == normalized {
+			return diags
+		}
+	}
+	data.Fields = types.StringValue(normalized)
+	return diags
+}
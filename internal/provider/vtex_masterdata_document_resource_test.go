@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+)
+
+// masterDataDocument builds a document from raw JSON field values
+func masterDataDocument(fields map[string]string) client.MasterDataDocument {
+	document := client.MasterDataDocument{}
+	for name, value := range fields {
+		document[name] = json.RawMessage(value)
+	}
+	return document
+}
+
+func TestMasterDataFieldChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous map[string]string
+		wanted   map[string]string
+		want     map[string]string
+	}{
+		{
+			name:     "no changes",
+			previous: map[string]string{"email": `"a@example.com"`, "age": `30`},
+			wanted:   map[string]string{"email": `"a@example.com"`, "age": `30`},
+			want:     map[string]string{},
+		},
+		{
+			name:     "changed field",
+			previous: map[string]string{"email": `"a@example.com"`, "age": `30`},
+			wanted:   map[string]string{"email": `"a@example.com"`, "age": `31`},
+			want:     map[string]string{"age": `31`},
+		},
+		{
+			name:     "added field",
+			previous: map[string]string{"email": `"a@example.com"`},
+			wanted:   map[string]string{"email": `"a@example.com"`, "phone": `"+1 555"`},
+			want:     map[string]string{"phone": `"+1 555"`},
+		},
+		{
+			name:     "removed field is set to null",
+			previous: map[string]string{"email": `"a@example.com"`, "phone": `"+1 555"`},
+			wanted:   map[string]string{"email": `"a@example.com"`},
+			want:     map[string]string{"phone": `null`},
+		},
+		{
+			name:     "formatting and key order are not changes",
+			previous: map[string]string{"address": `{"city":"Lima","zip":"15001"}`},
+			wanted:   map[string]string{"address": `{ "zip": "15001", "city": "Lima" }`},
+			want:     map[string]string{},
+		},
+		{
+			name:     "nested change sends the whole field",
+			previous: map[string]string{"address": `{"city":"Lima","zip":"15001"}`},
+			wanted:   map[string]string{"address": `{"city":"Cusco","zip":"15001"}`},
+			want:     map[string]string{"address": `{"city":"Cusco","zip":"15001"}`},
+		},
+		{
+			name:     "invalid previous value is replaced",
+			previous: map[string]string{"age": `not json`},
+			wanted:   map[string]string{"age": `30`},
+			want:     map[string]string{"age": `30`},
+		},
+		{
+			name:     "empty previous document sends every field",
+			previous: map[string]string{},
+			wanted:   map[string]string{"email": `"a@example.com"`, "age": `30`},
+			want:     map[string]string{"email": `"a@example.com"`, "age": `30`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := masterDataFieldChanges(masterDataDocument(test.previous), masterDataDocument(test.wanted))
+			if len(got) != len(test.want) {
+				t.Fatalf("masterDataFieldChanges = %s, want %v", got, test.want)
+			}
+			for name, want := range test.want {
+				value, ok := got[name]
+				if !ok {
+					t.Errorf("field %s is not in the changes", name)
+					continue
+				}
+				if string(value) != want {
+					t.Errorf("field %s = %s, want %s", name, value, want)
+				}
+			}
+		})
+	}
+}
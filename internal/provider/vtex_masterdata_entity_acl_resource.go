@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexMasterDataEntityACLResource{}
+var _ resource.ResourceWithImportState = &VtexMasterDataEntityACLResource{}
+
+func NewVtexMasterDataEntityACLResource() resource.Resource {
+	return &VtexMasterDataEntityACLResource{}
+}
+
+// VtexMasterDataEntityACLResource is the resource implementation
+type VtexMasterDataEntityACLResource struct {
+	client *client.VtexClient
+}
+
+// VtexMasterDataEntityACLResourceModel is the resource data model
+type VtexMasterDataEntityACLResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	DataEntity   types.String `tfsdk:"data_entity"`
+	Schema       types.String `tfsdk:"schema"`
+	PublicRead   types.Set    `tfsdk:"public_read"`
+	PublicWrite  types.Set    `tfsdk:"public_write"`
+	PublicFilter types.Set    `tfsdk:"public_filter"`
+	AllowGetAll  types.Bool   `tfsdk:"allow_get_all"`
+	PublicSchema types.Bool   `tfsdk:"public_schema"`
+}
+
+func (r *VtexMasterDataEntityACLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_masterdata_entity_acl"
+}
+
+func (r *VtexMasterDataEntityACLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the field access of a Master Data v2 schema (v-security): the fields anyone can read, write or filter by without credentials. Everything else needs a user or app key with a Master Data role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Data entity and schema name, as data_entity:schema",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_entity": schema.StringAttribute{
+				Required:    true,
+				Description: "Data entity of the schema, e.g. reviews. Changing it creates a new ACL",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the schema the access is set on, e.g. v1. Changing it creates a new ACL",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_read": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Fields anyone can read",
+			},
+			"public_write": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Fields anyone can write, e.g. from a storefront form",
+			},
+			"public_filter": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Fields anyone can search by",
+			},
+			"allow_get_all": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether anyone can list the documents without a filter",
+			},
+			"public_schema": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether anyone can read the JSON schema",
+			},
+		},
+	}
+}
+
+func (r *VtexMasterDataEntityACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexMasterDataEntityACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexMasterDataEntityACLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	security, diags := masterDataSecurityFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX Master Data entity ACL", map[string]interface{}{
+		"data_entity": data.DataEntity.ValueString(),
+		"schema":      data.Schema.ValueString(),
+	})
+
+	result, err := r.saveSecurity(ctx, data.DataEntity.ValueString(), data.Schema.ValueString(), &security)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Master Data Entity ACL",
+			"Could not set the access of the Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(encodeID(data.DataEntity.ValueString(), data.Schema.ValueString()))
+	resp.Diagnostics.Append(masterDataSecurityToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX Master Data entity ACL", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexMasterDataEntityACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexMasterDataEntityACLResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX Master Data entity ACL", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	masterDataSchema, err := r.client.GetMasterDataSchema(ctx, data.DataEntity.ValueString(), data.Schema.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX Master Data schema not found, removing its ACL from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Master Data Entity ACL",
+			"Could not read Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	security, err := masterDataSchemaSecurity(masterDataSchema)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Master Data Schema", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(masterDataSecurityToModel(ctx, security, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexMasterDataEntityACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexMasterDataEntityACLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	security, diags := masterDataSecurityFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX Master Data entity ACL", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.saveSecurity(ctx, data.DataEntity.ValueString(), data.Schema.ValueString(), &security)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Master Data Entity ACL",
+			"Could not set the access of the Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(masterDataSecurityToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexMasterDataEntityACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexMasterDataEntityACLResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX Master Data entity ACL", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Removing v-security makes every field private again
+	_, err := r.saveSecurity(ctx, data.DataEntity.ValueString(), data.Schema.ValueString(), nil)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Master Data Entity ACL",
+			"Could not remove the access of the Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX Master Data entity ACL", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexMasterDataEntityACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: data_entity:schema
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 2 {
+		err = fmt.Errorf("expected data_entity:schema, got: %q", req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_entity"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schema"), parts[1])...)
+}
+
+// saveSecurity sets the v-security keyword of a schema, or removes it when security is nil,
+// keeping the rest of the schema. It returns the access read back.
+func (r *VtexMasterDataEntityACLResource) saveSecurity(ctx context.Context, dataEntity, schemaName string, security *client.MasterDataSecurity) (*client.MasterDataSecurity, error) {
+	masterDataSchema, err := r.client.GetMasterDataSchema(ctx, dataEntity, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(masterDataSchema, client.MasterDataSecurityKey)
+	if security != nil {
+		encoded, err := json.Marshal(security)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", client.MasterDataSecurityKey, err)
+		}
+		masterDataSchema[client.MasterDataSecurityKey] = encoded
+	}
+
+	result, err := r.client.SaveMasterDataSchema(ctx, dataEntity, schemaName, masterDataSchema)
+	if err != nil {
+		return nil, err
+	}
+	return masterDataSchemaSecurity(result)
+}
+
+// masterDataSchemaSecurity reads the v-security keyword of a schema, empty when it is not set
+func masterDataSchemaSecurity(masterDataSchema client.MasterDataSchema) (*client.MasterDataSecurity, error) {
+	var security client.MasterDataSecurity
+	if value, ok := masterDataSchema[client.MasterDataSecurityKey]; ok {
+		if err := json.Unmarshal(value, &security); err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", client.MasterDataSecurityKey, err)
+		}
+	}
+	return &security, nil
+}
+
+// masterDataSecurityFromModel builds the v-security keyword from the resource model
+func masterDataSecurityFromModel(ctx context.Context, data VtexMasterDataEntityACLResourceModel) (client.MasterDataSecurity, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	security := client.MasterDataSecurity{
+		AllowGetAll:      data.AllowGetAll.ValueBool(),
+		PublicRead:       []string{},
+		PublicWrite:      []string{},
+		PublicFilter:     []string{},
+		PublicJSONSchema: data.PublicSchema.ValueBool(),
+	}
+	if !data.PublicRead.IsNull() {
+		diags.Append(data.PublicRead.ElementsAs(ctx, &security.PublicRead, false)...)
+	}
+	if !data.PublicWrite.IsNull() {
+		diags.Append(data.PublicWrite.ElementsAs(ctx, &security.PublicWrite, false)...)
+	}
+	if !data.PublicFilter.IsNull() {
+		diags.Append(data.PublicFilter.ElementsAs(ctx, &security.PublicFilter, false)...)
+	}
+
+	return security, diags
+}
+
+// masterDataSecurityToModel copies the v-security keyword into the resource model
+func masterDataSecurityToModel(ctx context.Context, security *client.MasterDataSecurity, data *VtexMasterDataEntityACLResourceModel) diag.Diagnostics {
+	var diags, setDiags diag.Diagnostics
+
+	data.PublicRead, setDiags = optionalStringSet(ctx, security.PublicRead, data.PublicRead)
+	diags.Append(setDiags...)
+	data.PublicWrite, setDiags = optionalStringSet(ctx, security.PublicWrite, data.PublicWrite)
+	diags.Append(setDiags...)
+	data.PublicFilter, setDiags = optionalStringSet(ctx, security.PublicFilter, data.PublicFilter)
+	diags.Append(setDiags...)
+	data.AllowGetAll = types.BoolValue(security.AllowGetAll)
+	data.PublicSchema = types.BoolValue(security.PublicJSONSchema)
+
+	return diags
+}
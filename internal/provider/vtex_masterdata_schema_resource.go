@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexMasterDataSchemaResource{}
+var _ resource.ResourceWithImportState = &VtexMasterDataSchemaResource{}
+var _ resource.ResourceWithValidateConfig = &VtexMasterDataSchemaResource{}
+
+func NewVtexMasterDataSchemaResource() resource.Resource {
+	return &VtexMasterDataSchemaResource{}
+}
+
+// VtexMasterDataSchemaResource is the resource implementation
+type VtexMasterDataSchemaResource struct {
+	client *client.VtexClient
+}
+
+// VtexMasterDataSchemaResourceModel is the resource data model
+type VtexMasterDataSchemaResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	DataEntity types.String `tfsdk:"data_entity"`
+	Name       types.String `tfsdk:"name"`
+	Schema     types.String `tfsdk:"schema"`
+	VIndexed   types.Set    `tfsdk:"v_indexed"`
+	VCache     types.Bool   `tfsdk:"v_cache"`
+}
+
+func (r *VtexMasterDataSchemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_masterdata_schema"
+}
+
+func (r *VtexMasterDataSchemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Master Data v2 JSON schema of a data entity, with the fields indexed for searches.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Data entity and schema name, as data_entity:name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_entity": schema.StringAttribute{
+				Required:    true,
+				Description: "Data entity of the schema, e.g. reviews. Changing it creates a new schema",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Schema name, e.g. v1. Changing it creates a new schema",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON schema of the documents, e.g. from jsonencode() or file(). Formatting and key order are ignored",
+				PlanModifiers: []planmodifier.String{
+					semanticJSONModifier{},
+				},
+			},
+			"v_indexed": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Fields indexed for searches and filters (v-indexed)",
+			},
+			"v_cache": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether Master Data caches the documents read with the schema (v-cache, default: true)",
+			},
+		},
+	}
+}
+
+func (r *VtexMasterDataSchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexMasterDataSchemaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexMasterDataSchemaResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !isKnown(data.Schema) {
+		return
+	}
+
+	if _, err := parseMasterDataSchema(data.Schema.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("schema"), "Invalid JSON Schema", err.Error())
+	}
+}
+
+func (r *VtexMasterDataSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexMasterDataSchemaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	masterDataSchema, diags := masterDataSchemaFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX Master Data schema", map[string]interface{}{
+		"data_entity": data.DataEntity.ValueString(),
+		"name":        data.Name.ValueString(),
+	})
+
+	err := r.keepMasterDataSecurity(ctx, data.DataEntity.ValueString(), data.Name.ValueString(), masterDataSchema)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Master Data Schema",
+			"Could not read the existing Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result, err := r.client.SaveMasterDataSchema(ctx, data.DataEntity.ValueString(), data.Name.ValueString(), masterDataSchema)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Master Data Schema",
+			"Could not create Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(encodeID(data.DataEntity.ValueString(), data.Name.ValueString()))
+	resp.Diagnostics.Append(masterDataSchemaToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX Master Data schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexMasterDataSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexMasterDataSchemaResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX Master Data schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.GetMasterDataSchema(ctx, data.DataEntity.ValueString(), data.Name.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX Master Data schema not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Master Data Schema",
+			"Could not read Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(masterDataSchemaToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexMasterDataSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexMasterDataSchemaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	masterDataSchema, diags := masterDataSchemaFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX Master Data schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.keepMasterDataSecurity(ctx, data.DataEntity.ValueString(), data.Name.ValueString(), masterDataSchema)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Master Data Schema",
+			"Could not read the current Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result, err := r.client.SaveMasterDataSchema(ctx, data.DataEntity.ValueString(), data.Name.ValueString(), masterDataSchema)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Master Data Schema",
+			"Could not update Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(masterDataSchemaToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexMasterDataSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexMasterDataSchemaResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX Master Data schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteMasterDataSchema(ctx, data.DataEntity.ValueString(), data.Name.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Master Data Schema",
+			"Could not delete Master Data schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX Master Data schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexMasterDataSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: data_entity:name
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 2 {
+		err = fmt.Errorf("expected data_entity:name, got: %q", req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_entity"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// masterDataKeywordOwners are the Master Data keywords of a schema and what sets them
+var masterDataKeywordOwners = map[string]string{
+	client.MasterDataIndexedKey:  "the v_indexed attribute",
+	client.MasterDataCacheKey:    "the v_cache attribute",
+	client.MasterDataSecurityKey: "a vtex_masterdata_entity_acl resource",
+}
+
+// parseMasterDataSchema parses the configured JSON schema. The Master Data keywords are
+// set elsewhere, so they cannot be set in the document too.
+func parseMasterDataSchema(value string) (client.MasterDataSchema, error) {
+	var masterDataSchema client.MasterDataSchema
+	if err := json.Unmarshal([]byte(value), &masterDataSchema); err != nil || masterDataSchema == nil {
+		return nil, fmt.Errorf("expected a JSON object, e.g. from jsonencode()")
+	}
+	for _, key := range []string{client.MasterDataIndexedKey, client.MasterDataCacheKey, client.MasterDataSecurityKey} {
+		if _, ok := masterDataSchema[key]; ok {
+			return nil, fmt.Errorf("%q is set with %s, remove it from the schema", key, masterDataKeywordOwners[key])
+		}
+	}
+	return masterDataSchema, nil
+}
+
+// keepMasterDataSecurity copies the v-security keyword of the saved schema into the payload,
+// so saving the schema does not reset the access set by vtex_masterdata_entity_acl
+func (r *VtexMasterDataSchemaResource) keepMasterDataSecurity(ctx context.Context, dataEntity, schemaName string, masterDataSchema client.MasterDataSchema) error {
+	current, err := r.client.GetMasterDataSchema(ctx, dataEntity, schemaName)
+	if client.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if security, ok := current[client.MasterDataSecurityKey]; ok {
+		masterDataSchema[client.MasterDataSecurityKey] = security
+	}
+	return nil
+}
+
+// masterDataSchemaFromModel builds the API payload from the resource model
+func masterDataSchemaFromModel(ctx context.Context, data VtexMasterDataSchemaResourceModel) (client.MasterDataSchema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	masterDataSchema, err := parseMasterDataSchema(data.Schema.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("schema"), "Invalid JSON Schema", err.Error())
+		return nil, diags
+	}
+
+	indexed := []string{}
+	if !data.VIndexed.IsNull() {
+		diags.Append(data.VIndexed.ElementsAs(ctx, &indexed, false)...)
+	}
+	masterDataSchema[client.MasterDataIndexedKey], _ = json.Marshal(indexed)
+	masterDataSchema[client.MasterDataCacheKey], _ = json.Marshal(data.VCache.ValueBool())
+
+	return masterDataSchema, diags
+}
+
+// masterDataSchemaToModel copies an API schema into the resource model. The Master Data
+// keywords go to their attributes (v-security is left to vtex_masterdata_entity_acl), and the
+// configured schema is kept while the rest of the document is the same, so formatting and key
+// order do not show as a diff.
+func masterDataSchemaToModel(ctx context.Context, masterDataSchema client.MasterDataSchema, data *VtexMasterDataSchemaResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var indexed []string
+	if value, ok := masterDataSchema[client.MasterDataIndexedKey]; ok {
+		if err := json.Unmarshal(value, &indexed); err != nil {
+			diags.AddError("Unexpected Master Data Schema", fmt.Sprintf("Could not parse %s: %s", client.MasterDataIndexedKey, err))
+		}
+	}
+	vIndexed, setDiags := optionalStringSet(ctx, indexed, data.VIndexed)
+	diags.Append(setDiags...)
+	data.VIndexed = vIndexed
+
+	// Master Data caches documents unless told otherwise
+	cache := true
+	if value, ok := masterDataSchema[client.MasterDataCacheKey]; ok {
+		if err := json.Unmarshal(value, &cache); err != nil {
+			diags.AddError("Unexpected Master Data Schema", fmt.Sprintf("Could not parse %s: %s", client.MasterDataCacheKey, err))
+		}
+	}
+	data.VCache = types.BoolValue(cache)
+
+	document := client.MasterDataSchema{}
+	for key, value := range masterDataSchema {
+		if _, ok := masterDataKeywordOwners[key]; !ok {
+			document[key] = value
+		}
+	}
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		diags.AddError("Unexpected Master Data Schema", "Could not encode the schema: "+err.Error())
+		return diags
+	}
+	normalized, err := normalizeJSON(string(encoded))
+	if err != nil {
+		diags.AddError("Unexpected Master Data Schema", "Could not encode the schema: "+err.Error())
+		return diags
+	}
+
+	if !data.Schema.IsNull() && !data.Schema.IsUnknown() {
+		if configured, err := normalizeJSON(data.Schema.ValueString()); err == nil && configured == normalized {
+			return diags
+		}
+	}
+	data.Schema = types.StringValue(normalized)
+	return diags
+}
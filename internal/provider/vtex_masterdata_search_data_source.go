@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexMasterDataSearchDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexMasterDataSearchDataSource{}
+
+// masterDataSearchDefaultLimit is the number of documents the data source lists by default
+const masterDataSearchDefaultLimit = 100
+
+func NewVtexMasterDataSearchDataSource() datasource.DataSource {
+	return &VtexMasterDataSearchDataSource{}
+}
+
+// VtexMasterDataSearchDataSource is the data source implementation
+type VtexMasterDataSearchDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexMasterDataSearchDataSourceModel is the data source data model
+type VtexMasterDataSearchDataSourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	DataEntity  types.String   `tfsdk:"data_entity"`
+	Schema      types.String   `tfsdk:"schema"`
+	Where       types.String   `tfsdk:"where"`
+	Fields      []types.String `tfsdk:"fields"`
+	Sort        types.String   `tfsdk:"sort"`
+	Limit       types.Int64    `tfsdk:"limit"`
+	Documents   []types.String `tfsdk:"documents"`
+	DocumentIDs []types.String `tfsdk:"document_ids"`
+	TotalCount  types.Int64    `tfsdk:"total_count"`
+}
+
+func (d *VtexMasterDataSearchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_masterdata_search"
+}
+
+func (d *VtexMasterDataSearchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the documents of a Master Data entity, such as CL (clients), AD (addresses) or a custom entity.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Search used",
+			},
+			"data_entity": schema.StringAttribute{
+				Required:    true,
+				Description: "Data entity to search, e.g. CL",
+			},
+			"schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schema the fields are read with, e.g. v1",
+			},
+			"where": schema.StringAttribute{
+				Optional:    true,
+				Description: "Master Data filter (_where), e.g. isCorporate=true AND createdIn>2024-01-01",
+			},
+			"fields": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Fields to return (_fields). All fields when not set",
+			},
+			"sort": schema.StringAttribute{
+				Optional:    true,
+				Description: "Sort order (_sort), e.g. createdIn DESC",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Largest number of documents to return, up to %d (default: %d)", client.MasterDataSearchMaxResults, masterDataSearchDefaultLimit),
+			},
+			"documents": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Documents found, each as a JSON object to use with jsondecode()",
+			},
+			"document_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the documents found, when the id field is returned",
+			},
+			"total_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of documents matching the search, which can be more than the documents returned",
+			},
+		},
+	}
+}
+
+func (d *VtexMasterDataSearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexMasterDataSearchDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexMasterDataSearchDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.Limit) && (data.Limit.ValueInt64() < 1 || data.Limit.ValueInt64() > client.MasterDataSearchMaxResults) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Invalid Limit",
+			fmt.Sprintf("Expected 1 to %d documents, got: %d", client.MasterDataSearchMaxResults, data.Limit.ValueInt64()),
+		)
+	}
+}
+
+func (d *VtexMasterDataSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexMasterDataSearchDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	search := client.MasterDataSearch{
+		Schema: data.Schema.ValueString(),
+		Where:  data.Where.ValueString(),
+		Sort:   data.Sort.ValueString(),
+	}
+	for _, field := range data.Fields {
+		search.Fields = append(search.Fields, field.ValueString())
+	}
+
+	limit := int64(masterDataSearchDefaultLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Listing VTEX Master Data documents", map[string]interface{}{
+		"data_entity": data.DataEntity.ValueString(),
+		"schema":      search.Schema,
+		"fields":      strings.Join(search.Fields, ","),
+		"limit":       limit,
+	})
+
+	documents, total, err := d.client.SearchMasterDataDocuments(ctx, data.DataEntity.ValueString(), search, int(limit))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Master Data Documents",
+			"Could not search Master Data documents, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Documents = make([]types.String, 0, len(documents))
+	data.DocumentIDs = make([]types.String, 0, len(documents))
+	for _, document := range documents {
+		encoded, err := json.Marshal(document)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Master Data Document",
+				"Could not encode a document found, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		normalized, err := normalizeJSON(string(encoded))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Master Data Document",
+				"Could not encode a document found, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		data.Documents = append(data.Documents, types.StringValue(normalized))
+
+		var documentID string
+		if json.Unmarshal(document["id"], &documentID) == nil && documentID != "" {
+			data.DocumentIDs = append(data.DocumentIDs, types.StringValue(documentID))
+		}
+	}
+	data.TotalCount = types.Int64Value(total)
+
+	data.ID = types.StringValue(encodeID(
+		data.DataEntity.ValueString(),
+		search.Schema,
+		search.Where,
+		strings.Join(search.Fields, ","),
+		search.Sort,
+		strconv.FormatInt(limit, 10),
+	))
+
+	tflog.Trace(ctx, "Listed VTEX Master Data documents", map[string]interface{}{
+		"count": len(data.Documents),
+		"total": total,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
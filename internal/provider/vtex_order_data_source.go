@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexOrderDataSource{}
+
+func NewVtexOrderDataSource() datasource.DataSource {
+	return &VtexOrderDataSource{}
+}
+
+// VtexOrderDataSource is the data source implementation
+type VtexOrderDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexOrderDataSourceModel is the data source data model
+type VtexOrderDataSourceModel struct {
+	ID                  types.String         `tfsdk:"id"`
+	OrderID             types.String         `tfsdk:"order_id"`
+	Sequence            types.String         `tfsdk:"sequence"`
+	Status              types.String         `tfsdk:"status"`
+	StatusDescription   types.String         `tfsdk:"status_description"`
+	SalesChannel        types.String         `tfsdk:"sales_channel"`
+	Origin              types.String         `tfsdk:"origin"`
+	CreationDate        types.String         `tfsdk:"creation_date"`
+	LastChange          types.String         `tfsdk:"last_change"`
+	Total               types.Float64        `tfsdk:"total"`
+	Totals              types.Map            `tfsdk:"totals"`
+	Items               []VtexOrderItemModel `tfsdk:"items"`
+	ClientEmail         types.String         `tfsdk:"client_email"`
+	ClientFirstName     types.String         `tfsdk:"client_first_name"`
+	ClientLastName      types.String         `tfsdk:"client_last_name"`
+	ClientIsCorporate   types.Bool           `tfsdk:"client_is_corporate"`
+	ClientCorporateName types.String         `tfsdk:"client_corporate_name"`
+}
+
+// VtexOrderItemModel is an item of the order
+type VtexOrderItemModel struct {
+	SKUID        types.String  `tfsdk:"sku_id"`
+	ProductID    types.String  `tfsdk:"product_id"`
+	RefID        types.String  `tfsdk:"ref_id"`
+	Name         types.String  `tfsdk:"name"`
+	Quantity     types.Int64   `tfsdk:"quantity"`
+	Seller       types.String  `tfsdk:"seller"`
+	Price        types.Float64 `tfsdk:"price"`
+	SellingPrice types.Float64 `tfsdk:"selling_price"`
+}
+
+func (d *VtexOrderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_order"
+}
+
+func (d *VtexOrderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an order of the OMS by ID, with its status, items, totals and a summary of the shopper.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Order ID",
+			},
+			"order_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Order ID to read, e.g. 1234567890123-01",
+			},
+			"sequence": schema.StringAttribute{
+				Computed:    true,
+				Description: "Sequence number of the order in the account",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Workflow status of the order, e.g. ready-for-handling",
+			},
+			"status_description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the order as shown in the admin",
+			},
+			"sales_channel": schema.StringAttribute{
+				Computed:    true,
+				Description: "Trade policy the order was placed in",
+			},
+			"origin": schema.StringAttribute{
+				Computed:    true,
+				Description: "Where the order was placed: Marketplace or Fulfillment",
+			},
+			"creation_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the order was placed",
+			},
+			"last_change": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the order last changed",
+			},
+			"total": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Value of the order",
+			},
+			"totals": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Float64Type,
+				Description: "Totals of the order by ID (Items, Discounts, Shipping, Tax)",
+			},
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Items of the order, in the order they were added",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sku_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "SKU ID",
+						},
+						"product_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Product ID",
+						},
+						"ref_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Reference code of the SKU",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "SKU name",
+						},
+						"quantity": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Quantity ordered",
+						},
+						"seller": schema.StringAttribute{
+							Computed:    true,
+							Description: "Seller that fulfills the item",
+						},
+						"price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Unit price",
+						},
+						"selling_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Unit price after discounts",
+						},
+					},
+				},
+			},
+			"client_email": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Email of the shopper, often a masked alias for marketplace orders",
+			},
+			"client_first_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "First name of the shopper",
+			},
+			"client_last_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last name of the shopper",
+			},
+			"client_is_corporate": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the shopper bought as a company",
+			},
+			"client_corporate_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Company name of a corporate shopper",
+			},
+		},
+	}
+}
+
+func (d *VtexOrderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexOrderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexOrderDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orderID := data.OrderID.ValueString()
+
+	tflog.Debug(ctx, "Reading VTEX order", map[string]interface{}{
+		"order_id": orderID,
+	})
+
+	order, err := d.client.GetOrder(ctx, orderID)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("order_id"),
+			"VTEX Order Not Found",
+			fmt.Sprintf("No order with ID %q in the account", orderID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Order",
+			"Could not read order, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(order.OrderID)
+	data.Sequence = types.StringValue(order.Sequence)
+	data.Status = types.StringValue(order.Status)
+	data.StatusDescription = types.StringValue(order.StatusDescription)
+	data.SalesChannel = types.StringValue(order.SalesChannel)
+	data.Origin = types.StringValue(order.Origin)
+	data.CreationDate = types.StringValue(order.CreationDate)
+	data.LastChange = types.StringValue(order.LastChange)
+	data.Total = types.Float64Value(centsToAmount(order.Value))
+
+	totals := make(map[string]attr.Value, len(order.Totals))
+	for _, total := range order.Totals {
+		totals[total.ID] = types.Float64Value(centsToAmount(total.Value))
+	}
+	totalsMap, diags := types.MapValue(types.Float64Type, totals)
+	resp.Diagnostics.Append(diags...)
+	data.Totals = totalsMap
+
+	data.Items = make([]VtexOrderItemModel, 0, len(order.Items))
+	for _, item := range order.Items {
+		data.Items = append(data.Items, VtexOrderItemModel{
+			SKUID:        types.StringValue(item.ID),
+			ProductID:    types.StringValue(item.ProductID),
+			RefID:        types.StringValue(item.RefID),
+			Name:         types.StringValue(item.Name),
+			Quantity:     types.Int64Value(item.Quantity),
+			Seller:       types.StringValue(item.Seller),
+			Price:        types.Float64Value(centsToAmount(item.Price)),
+			SellingPrice: types.Float64Value(centsToAmount(item.SellingPrice)),
+		})
+	}
+
+	// Orders placed through some integrations have no client profile
+	profile := order.ClientProfileData
+	if profile == nil {
+		profile = &client.OrderClientProfile{}
+	}
+	data.ClientEmail = types.StringValue(profile.Email)
+	data.ClientFirstName = types.StringValue(profile.FirstName)
+	data.ClientLastName = types.StringValue(profile.LastName)
+	data.ClientIsCorporate = types.BoolValue(profile.IsCorporate)
+	data.ClientCorporateName = types.StringValue(profile.CorporateName)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexOrdersDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexOrdersDataSource{}
+
+// Default and largest number of orders the data source lists, the OMS lists 30 pages of 100
+const (
+	ordersDefaultLimit = 100
+	ordersMaxLimit     = 3000
+)
+
+func NewVtexOrdersDataSource() datasource.DataSource {
+	return &VtexOrdersDataSource{}
+}
+
+// VtexOrdersDataSource is the data source implementation
+type VtexOrdersDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexOrdersDataSourceModel is the data source data model
+type VtexOrdersDataSourceModel struct {
+	ID           types.String            `tfsdk:"id"`
+	CreatedFrom  types.String            `tfsdk:"created_from"`
+	CreatedTo    types.String            `tfsdk:"created_to"`
+	Statuses     types.Set               `tfsdk:"statuses"`
+	SalesChannel types.String            `tfsdk:"sales_channel"`
+	Limit        types.Int64             `tfsdk:"limit"`
+	Orders       []VtexOrderSummaryModel `tfsdk:"orders"`
+	TotalCount   types.Int64             `tfsdk:"total_count"`
+}
+
+// VtexOrderSummaryModel is an order in the list
+type VtexOrderSummaryModel struct {
+	ID                types.String  `tfsdk:"id"`
+	Sequence          types.String  `tfsdk:"sequence"`
+	Status            types.String  `tfsdk:"status"`
+	StatusDescription types.String  `tfsdk:"status_description"`
+	SalesChannel      types.String  `tfsdk:"sales_channel"`
+	Origin            types.String  `tfsdk:"origin"`
+	CreationDate      types.String  `tfsdk:"creation_date"`
+	LastChange        types.String  `tfsdk:"last_change"`
+	Total             types.Float64 `tfsdk:"total"`
+}
+
+func (d *VtexOrdersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orders"
+}
+
+func (d *VtexOrdersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the newest orders of the OMS, optionally filtered by creation date, status and trade policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Filters used, or \"all\"",
+			},
+			"created_from": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list orders placed at or after this date, as RFC 3339",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+			"created_to": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list orders placed at or before this date, as RFC 3339",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+			"statuses": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only list orders in one of these statuses, e.g. ready-for-handling",
+				Validators: []validator.Set{
+					setElementsValidator{inner: stringOneOfValidator{values: client.OrderStatuses}},
+				},
+			},
+			"sales_channel": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list orders placed in this trade policy",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Largest number of orders to list, up to %d (default: %d)", ordersMaxLimit, ordersDefaultLimit),
+			},
+			"orders": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Orders found, newest first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Order ID",
+						},
+						"sequence": schema.StringAttribute{
+							Computed:    true,
+							Description: "Sequence number of the order in the account",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Workflow status of the order",
+						},
+						"status_description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the order as shown in the admin",
+						},
+						"sales_channel": schema.StringAttribute{
+							Computed:    true,
+							Description: "Trade policy the order was placed in",
+						},
+						"origin": schema.StringAttribute{
+							Computed:    true,
+							Description: "Where the order was placed: Marketplace or Fulfillment",
+						},
+						"creation_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the order was placed",
+						},
+						"last_change": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the order last changed",
+						},
+						"total": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Value of the order",
+						},
+					},
+				},
+			},
+			"total_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of orders that match the filters, which can be more than the listed ones",
+			},
+		},
+	}
+}
+
+func (d *VtexOrdersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexOrdersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexOrdersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.Limit) && (data.Limit.ValueInt64() < 1 || data.Limit.ValueInt64() > ordersMaxLimit) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Invalid Limit",
+			fmt.Sprintf("Expected 1 to %d orders, got: %d", ordersMaxLimit, data.Limit.ValueInt64()),
+		)
+	}
+
+	// The attribute validators report invalid dates
+	if isKnown(data.CreatedFrom) && isKnown(data.CreatedTo) {
+		from, fromErr := parseDateTime(data.CreatedFrom.ValueString())
+		to, toErr := parseDateTime(data.CreatedTo.ValueString())
+		if fromErr == nil && toErr == nil && to.Before(from) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("created_to"),
+				"Invalid Date Range",
+				fmt.Sprintf("created_to (%s) is before created_from (%s)", data.CreatedTo.ValueString(), data.CreatedFrom.ValueString()),
+			)
+		}
+	}
+}
+
+func (d *VtexOrdersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexOrdersDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := client.OrderListFilter{SalesChannel: data.SalesChannel.ValueString()}
+	// The validators already checked the dates
+	if data.CreatedFrom.ValueString() != "" {
+		filter.CreatedFrom, _ = parseDateTime(data.CreatedFrom.ValueString())
+	}
+	if data.CreatedTo.ValueString() != "" {
+		filter.CreatedTo, _ = parseDateTime(data.CreatedTo.ValueString())
+	}
+	if !data.Statuses.IsNull() {
+		resp.Diagnostics.Append(data.Statuses.ElementsAs(ctx, &filter.Statuses, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		sort.Strings(filter.Statuses)
+	}
+
+	limit := int64(ordersDefaultLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Listing VTEX orders", map[string]interface{}{
+		"created_from":  data.CreatedFrom.ValueString(),
+		"created_to":    data.CreatedTo.ValueString(),
+		"statuses":      strings.Join(filter.Statuses, ","),
+		"sales_channel": filter.SalesChannel,
+		"limit":         limit,
+	})
+
+	orders, total, err := d.client.ListOrders(ctx, filter, int(limit))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Orders",
+			"Could not list orders, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Orders = make([]VtexOrderSummaryModel, 0, len(orders))
+	for _, order := range orders {
+		data.Orders = append(data.Orders, VtexOrderSummaryModel{
+			ID:                types.StringValue(order.OrderID),
+			Sequence:          types.StringValue(order.Sequence),
+			Status:            types.StringValue(order.Status),
+			StatusDescription: types.StringValue(order.StatusDescription),
+			SalesChannel:      types.StringValue(order.SalesChannel),
+			Origin:            types.StringValue(order.Origin),
+			CreationDate:      types.StringValue(order.CreationDate),
+			LastChange:        types.StringValue(order.LastChange),
+			Total:             types.Float64Value(centsToAmount(order.TotalValue)),
+		})
+	}
+	data.TotalCount = types.Int64Value(total)
+
+	data.ID = types.StringValue("all")
+	if !filter.CreatedFrom.IsZero() || !filter.CreatedTo.IsZero() || len(filter.Statuses) > 0 || filter.SalesChannel != "" || !data.Limit.IsNull() {
+		data.ID = types.StringValue(encodeID(
+			orderDateFilter(filter.CreatedFrom),
+			orderDateFilter(filter.CreatedTo),
+			strings.Join(filter.Statuses, ","),
+			filter.SalesChannel,
+			strconv.FormatInt(limit, 10),
+		))
+	}
+
+	tflog.Trace(ctx, "Listed VTEX orders", map[string]interface{}{
+		"count": len(data.Orders),
+		"total": total,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// orderDateFilter formats a date filter for the ID, empty when it is not set
+func orderDateFilter(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexOrdersHookResource{}
+var _ resource.ResourceWithImportState = &VtexOrdersHookResource{}
+var _ resource.ResourceWithValidateConfig = &VtexOrdersHookResource{}
+
+// ordersHookID is the ID of the only orders hook of an app key
+const ordersHookID = "hook"
+
+// Defaults and limits of the queue behind the orders hook, in seconds
+const (
+	ordersQueueDefaultVisibilityTimeout = 250
+	ordersQueueDefaultMessageRetention  = 345600
+	ordersQueueMaxVisibilityTimeout     = 43200
+	ordersQueueMinMessageRetention      = 60
+	ordersQueueMaxMessageRetention      = 1209600
+)
+
+func NewVtexOrdersHookResource() resource.Resource {
+	return &VtexOrdersHookResource{}
+}
+
+// VtexOrdersHookResource is the resource implementation
+type VtexOrdersHookResource struct {
+	client *client.VtexClient
+}
+
+// VtexOrdersHookResourceModel is the resource data model
+type VtexOrdersHookResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	URL                      types.String `tfsdk:"url"`
+	Headers                  types.Map    `tfsdk:"headers"`
+	Statuses                 types.Set    `tfsdk:"statuses"`
+	Expression               types.String `tfsdk:"expression"`
+	DisableSingleFire        types.Bool   `tfsdk:"disable_single_fire"`
+	VisibilityTimeoutSeconds types.Int64  `tfsdk:"visibility_timeout_seconds"`
+	MessageRetentionSeconds  types.Int64  `tfsdk:"message_retention_seconds"`
+}
+
+func (r *VtexOrdersHookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orders_hook"
+}
+
+func (r *VtexOrdersHookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the orders hook of the provider app key: the endpoint the OMS notifies when orders change. There is one per app key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always \"" + ordersHookID + "\"",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: "HTTPS endpoint notified of order changes. It must answer 200 to the ping the OMS sends when the hook is saved",
+			},
+			"headers": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Headers sent with each notification, e.g. a token the endpoint checks",
+			},
+			"statuses": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Order statuses that are notified, e.g. ready-for-handling. Conflicts with expression",
+				Validators: []validator.Set{
+					setElementsValidator{inner: stringOneOfValidator{values: client.OrderStatuses}},
+				},
+			},
+			"expression": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSONata expression the orders must match to be notified, e.g. status = \"invoiced\". Conflicts with statuses",
+			},
+			"disable_single_fire": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether an order is notified each time it matches the expression, not only the first time",
+			},
+			"visibility_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(ordersQueueDefaultVisibilityTimeout),
+				Description: "Seconds a notification stays hidden from other deliveries while it is handled (default: 250)",
+			},
+			"message_retention_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(ordersQueueDefaultMessageRetention),
+				Description: "Seconds an undelivered notification is kept before it is dropped (default: 345600, 4 days)",
+			},
+		},
+	}
+}
+
+func (r *VtexOrdersHookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexOrdersHookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexOrdersHookResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.URL) {
+		if u, err := url.Parse(data.URL.ValueString()); err != nil || u.Scheme != "https" || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"Invalid Hook URL",
+				fmt.Sprintf("Expected an https:// URL, got: %q", data.URL.ValueString()),
+			)
+		}
+	}
+
+	// Unknown filters are checked again at apply time
+	if !data.Statuses.IsUnknown() && !data.Expression.IsUnknown() {
+		hasStatuses := !data.Statuses.IsNull() && len(data.Statuses.Elements()) > 0
+		hasExpression := !data.Expression.IsNull() && data.Expression.ValueString() != ""
+		if hasStatuses == hasExpression {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("statuses"),
+				"Invalid Orders Hook Filter",
+				"Exactly one of statuses or expression must be set.",
+			)
+		}
+		if hasStatuses && isKnown(data.DisableSingleFire) && data.DisableSingleFire.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("disable_single_fire"),
+				"Invalid Orders Hook Filter",
+				"disable_single_fire only applies to an expression filter.",
+			)
+		}
+	}
+
+	if isKnown(data.VisibilityTimeoutSeconds) {
+		if v := data.VisibilityTimeoutSeconds.ValueInt64(); v < 0 || v > ordersQueueMaxVisibilityTimeout {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("visibility_timeout_seconds"),
+				"Invalid Visibility Timeout",
+				fmt.Sprintf("Expected 0 to %d seconds, got: %d", ordersQueueMaxVisibilityTimeout, v),
+			)
+		}
+	}
+	if isKnown(data.MessageRetentionSeconds) {
+		if v := data.MessageRetentionSeconds.ValueInt64(); v < ordersQueueMinMessageRetention || v > ordersQueueMaxMessageRetention {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("message_retention_seconds"),
+				"Invalid Message Retention",
+				fmt.Sprintf("Expected %d to %d seconds, got: %d", ordersQueueMinMessageRetention, ordersQueueMaxMessageRetention, v),
+			)
+		}
+	}
+}
+
+func (r *VtexOrdersHookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexOrdersHookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, diags := ordersHookFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX orders hook", map[string]interface{}{
+		"url":         hook.Hook.URL,
+		"filter_type": hook.Filter.Type,
+	})
+
+	result, err := r.client.SaveOrdersHook(ctx, hook)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Orders Hook",
+			"Could not save orders hook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ordersHookToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX orders hook")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexOrdersHookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexOrdersHookResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX orders hook")
+
+	hook, err := r.client.GetOrdersHook(ctx)
+	if client.IsNotFound(err) || (err == nil && hook.Hook.URL == "") {
+		tflog.Warn(ctx, "VTEX orders hook not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Orders Hook",
+			"Could not read orders hook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ordersHookToModel(ctx, hook, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexOrdersHookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexOrdersHookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, diags := ordersHookFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX orders hook", map[string]interface{}{
+		"url":         hook.Hook.URL,
+		"filter_type": hook.Filter.Type,
+	})
+
+	result, err := r.client.SaveOrdersHook(ctx, hook)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Orders Hook",
+			"Could not save orders hook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ordersHookToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexOrdersHookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting VTEX orders hook")
+
+	err := r.client.DeleteOrdersHook(ctx)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Orders Hook",
+			"Could not delete orders hook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX orders hook")
+}
+
+func (r *VtexOrdersHookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: "hook"
+	if req.ID != ordersHookID {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("expected %q, got: %q", ordersHookID, req.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ordersHookFromModel builds the API payload from the resource model
+func ordersHookFromModel(ctx context.Context, data VtexOrdersHookResourceModel) (client.OrdersHook, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hook := client.OrdersHook{
+		Hook: client.OrdersHookTarget{URL: data.URL.ValueString()},
+		Queue: &client.OrdersQueue{
+			VisibilityTimeoutInSeconds:      data.VisibilityTimeoutSeconds.ValueInt64(),
+			MessageRetentionPeriodInSeconds: data.MessageRetentionSeconds.ValueInt64(),
+		},
+	}
+
+	if !data.Headers.IsNull() {
+		diags.Append(data.Headers.ElementsAs(ctx, &hook.Hook.Headers, false)...)
+	}
+
+	if !data.Statuses.IsNull() {
+		hook.Filter.Type = client.OrderFilterFromWorkflow
+		diags.Append(data.Statuses.ElementsAs(ctx, &hook.Filter.Status, false)...)
+	} else {
+		hook.Filter.Type = client.OrderFilterFromOrders
+		hook.Filter.Expression = data.Expression.ValueString()
+		hook.Filter.DisableSingleFire = data.DisableSingleFire.ValueBool()
+	}
+	return hook, diags
+}
+
+// ordersHookToModel copies the API orders hook into the resource model
+func ordersHookToModel(ctx context.Context, hook *client.OrdersHook, data *VtexOrdersHookResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(ordersHookID)
+	data.URL = types.StringValue(hook.Hook.URL)
+
+	if len(hook.Hook.Headers) > 0 || !data.Headers.IsNull() {
+		headers, mapDiags := types.MapValueFrom(ctx, types.StringType, hook.Hook.Headers)
+		diags.Append(mapDiags...)
+		data.Headers = headers
+	}
+
+	statuses, setDiags := optionalStringSet(ctx, hook.Filter.Status, data.Statuses)
+	diags.Append(setDiags...)
+	data.Statuses = statuses
+	data.Expression = optionalString(hook.Filter.Expression, data.Expression)
+	data.DisableSingleFire = types.BoolValue(hook.Filter.DisableSingleFire)
+
+	// Hooks saved without queue settings use the defaults of the OMS
+	queue := client.OrdersQueue{
+		VisibilityTimeoutInSeconds:      ordersQueueDefaultVisibilityTimeout,
+		MessageRetentionPeriodInSeconds: ordersQueueDefaultMessageRetention,
+	}
+	if hook.Queue != nil {
+		queue = *hook.Queue
+	}
+	data.VisibilityTimeoutSeconds = types.Int64Value(queue.VisibilityTimeoutInSeconds)
+	data.MessageRetentionSeconds = types.Int64Value(queue.MessageRetentionPeriodInSeconds)
+	return diags
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexPaymentMethodsDataSource{}
+
+func NewVtexPaymentMethodsDataSource() datasource.DataSource {
+	return &VtexPaymentMethodsDataSource{}
+}
+
+// VtexPaymentMethodsDataSource is the data source implementation
+type VtexPaymentMethodsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexPaymentMethodsDataSourceModel is the data source data model
+type VtexPaymentMethodsDataSourceModel struct {
+	ID               types.String               `tfsdk:"id"`
+	SalesChannelID   types.String               `tfsdk:"sales_channel_id"`
+	Enabled          types.Bool                 `tfsdk:"enabled"`
+	PaymentMethods   []VtexPaymentMethodModel   `tfsdk:"payment_methods"`
+	PaymentRules     []VtexPaymentRuleItemModel `tfsdk:"payment_rules"`
+	EnabledMethodIDs []types.String             `tfsdk:"enabled_method_ids"`
+}
+
+// VtexPaymentMethodModel is a payment method in the list
+type VtexPaymentMethodModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Group   types.String `tfsdk:"group"`
+	Custom  types.Bool   `tfsdk:"custom"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+// VtexPaymentRuleItemModel is a payment rule in the list
+type VtexPaymentRuleItemModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	Enabled         types.Bool     `tfsdk:"enabled"`
+	PaymentMethodID types.String   `tfsdk:"payment_method_id"`
+	AffiliationID   types.String   `tfsdk:"affiliation_id"`
+	SalesChannels   []types.String `tfsdk:"sales_channels"`
+}
+
+func (d *VtexPaymentMethodsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_methods"
+}
+
+func (d *VtexPaymentMethodsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the payment methods available to the account and the payment rules that offer them, with their status.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Filters used, or \"all\"",
+			},
+			"sales_channel_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only consider the payment rules of this trade policy",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list enabled (true) or disabled (false) payment methods and rules",
+			},
+			"payment_methods": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Payment methods found, by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Payment system ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Payment method name, e.g. Visa",
+						},
+						"group": schema.StringAttribute{
+							Computed:    true,
+							Description: "Group of the payment method, e.g. creditCard or bankInvoice",
+						},
+						"custom": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the payment method is a custom payment of the account",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether an enabled payment rule offers the payment method",
+						},
+					},
+				},
+			},
+			"payment_rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Payment rules (payment conditions) found, by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Payment rule ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Payment rule name",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the payment rule is enabled",
+						},
+						"payment_method_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Payment system ID the rule offers",
+						},
+						"affiliation_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Affiliation that processes the payments, or null",
+						},
+						"sales_channels": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Trade policies the rule applies to",
+						},
+					},
+				},
+			},
+			"enabled_method_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the listed payment methods that are enabled",
+			},
+		},
+	}
+}
+
+func (d *VtexPaymentMethodsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexPaymentMethodsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexPaymentMethodsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	salesChannelID := data.SalesChannelID.ValueString()
+
+	tflog.Debug(ctx, "Listing VTEX payment methods", map[string]interface{}{
+		"sales_channel_id": salesChannelID,
+		"enabled":          data.Enabled.String(),
+	})
+
+	systems, err := d.client.ListPaymentSystems(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Payment Methods",
+			"Could not list payment systems, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	rules, err := d.client.ListPaymentRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Payment Methods",
+			"Could not list payment rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(systems, func(i, j int) bool { return systems[i].ID < systems[j].ID })
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Name != rules[j].Name {
+			return rules[i].Name < rules[j].Name
+		}
+		return rules[i].ID < rules[j].ID
+	})
+
+	// A payment method is enabled when an enabled rule of the trade policy offers it
+	enabledSystems := map[int64]bool{}
+	data.PaymentRules = make([]VtexPaymentRuleItemModel, 0, len(rules))
+	for _, rule := range rules {
+		salesChannels := make([]string, 0, len(rule.SalesChannels))
+		for _, salesChannel := range rule.SalesChannels {
+			salesChannels = append(salesChannels, salesChannel.ID)
+		}
+		if salesChannelID != "" && !slices.Contains(salesChannels, salesChannelID) {
+			continue
+		}
+		if rule.Enabled {
+			enabledSystems[rule.PaymentSystem.ID] = true
+		}
+		if !data.Enabled.IsNull() && rule.Enabled != data.Enabled.ValueBool() {
+			continue
+		}
+
+		affiliationID := types.StringNull()
+		if rule.Connector.AffiliationID != nil && *rule.Connector.AffiliationID != "" {
+			affiliationID = types.StringValue(*rule.Connector.AffiliationID)
+		}
+
+		data.PaymentRules = append(data.PaymentRules, VtexPaymentRuleItemModel{
+			ID:              types.StringValue(rule.ID),
+			Name:            types.StringValue(rule.Name),
+			Enabled:         types.BoolValue(rule.Enabled),
+			PaymentMethodID: types.StringValue(strconv.FormatInt(rule.PaymentSystem.ID, 10)),
+			AffiliationID:   affiliationID,
+			SalesChannels:   stringValues(salesChannels),
+		})
+	}
+
+	data.PaymentMethods = make([]VtexPaymentMethodModel, 0, len(systems))
+	enabledMethodIDs := []string{}
+	for _, system := range systems {
+		enabled := enabledSystems[system.ID]
+		if !data.Enabled.IsNull() && enabled != data.Enabled.ValueBool() {
+			continue
+		}
+
+		id := strconv.FormatInt(system.ID, 10)
+		if enabled {
+			enabledMethodIDs = append(enabledMethodIDs, id)
+		}
+
+		data.PaymentMethods = append(data.PaymentMethods, VtexPaymentMethodModel{
+			ID:      types.StringValue(id),
+			Name:    types.StringValue(system.Name),
+			Group:   types.StringValue(system.GroupName),
+			Custom:  types.BoolValue(system.IsCustom),
+			Enabled: types.BoolValue(enabled),
+		})
+	}
+	data.EnabledMethodIDs = stringValues(enabledMethodIDs)
+
+	data.ID = types.StringValue("all")
+	if salesChannelID != "" || !data.Enabled.IsNull() {
+		data.ID = types.StringValue(encodeID(salesChannelID, data.Enabled.String()))
+	}
+
+	tflog.Trace(ctx, "Listed VTEX payment methods", map[string]interface{}{
+		"count": len(data.PaymentMethods),
+		"rules": len(data.PaymentRules),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
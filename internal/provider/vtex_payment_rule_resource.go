@@ -0,0 +1,439 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexPaymentRuleResource{}
+var _ resource.ResourceWithImportState = &VtexPaymentRuleResource{}
+var _ resource.ResourceWithValidateConfig = &VtexPaymentRuleResource{}
+
+func NewVtexPaymentRuleResource() resource.Resource {
+	return &VtexPaymentRuleResource{}
+}
+
+// VtexPaymentRuleResource is the resource implementation
+type VtexPaymentRuleResource struct {
+	client *client.VtexClient
+}
+
+// VtexPaymentRuleResourceModel is the resource data model
+type VtexPaymentRuleResourceModel struct {
+	ID                     types.String  `tfsdk:"id"`
+	Name                   types.String  `tfsdk:"name"`
+	Enabled                types.Bool    `tfsdk:"enabled"`
+	PaymentSystemID        types.String  `tfsdk:"payment_system_id"`
+	PaymentSystemName      types.String  `tfsdk:"payment_system_name"`
+	SalesChannels          types.Set     `tfsdk:"sales_channels"`
+	AffiliationID          types.String  `tfsdk:"affiliation_id"`
+	AntifraudAffiliationID types.String  `tfsdk:"antifraud_affiliation_id"`
+	MinimumValue           types.Float64 `tfsdk:"minimum_value"`
+	BeginDate              types.String  `tfsdk:"begin_date"`
+	EndDate                types.String  `tfsdk:"end_date"`
+}
+
+func (r *VtexPaymentRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_rule"
+}
+
+func (r *VtexPaymentRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a payment rule (payment condition): which affiliation processes a payment system in which trade policies, from which amount, and which anti-fraud affiliation analyzes it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Payment rule ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Payment rule name",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether shoppers are offered the payment system",
+			},
+			"payment_system_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the payment system (card brand or method), e.g. 2 for Visa, or the payment_system_id of a vtex_custom_payment",
+			},
+			"payment_system_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the payment system, set by VTEX",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sales_channels": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the trade policies the rule applies to",
+			},
+			"affiliation_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the affiliation (acquirer or payment provider) that processes the payments",
+			},
+			"antifraud_affiliation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the anti-fraud affiliation that analyzes the payments before they are processed",
+			},
+			"minimum_value": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Smallest order value the payment system is offered for",
+			},
+			"begin_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "Start of the rule, as an RFC 3339 date (default: always)",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+			"end_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the rule, as an RFC 3339 date (default: no end)",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexPaymentRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexPaymentRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexPaymentRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.PaymentSystemID) {
+		if _, err := parseNumericID(data.PaymentSystemID.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("payment_system_id"), "Invalid Payment System ID", err.Error())
+		}
+	}
+
+	if isKnown(data.MinimumValue) && data.MinimumValue.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("minimum_value"),
+			"Invalid Minimum Value",
+			fmt.Sprintf("Expected 0 or more, got: %v", data.MinimumValue.ValueFloat64()),
+		)
+	}
+
+	if isKnown(data.BeginDate) && isKnown(data.EndDate) {
+		begin, errBegin := parseDateTime(data.BeginDate.ValueString())
+		end, errEnd := parseDateTime(data.EndDate.ValueString())
+		if errBegin == nil && errEnd == nil && !end.After(begin) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_date"),
+				"Invalid Payment Rule Dates",
+				"end_date must be after begin_date.",
+			)
+		}
+	}
+}
+
+func (r *VtexPaymentRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexPaymentRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rule client.PaymentRule
+	resp.Diagnostics.Append(r.paymentRuleFromModel(ctx, data, &rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX payment rule", map[string]interface{}{
+		"name":              rule.Name,
+		"payment_system_id": rule.PaymentSystem.ID,
+	})
+
+	result, err := r.client.CreatePaymentRule(ctx, rule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Payment Rule",
+			"Could not create payment rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(paymentRuleToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX payment rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPaymentRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexPaymentRuleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX payment rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	rule, err := r.client.GetPaymentRule(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX payment rule not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Payment Rule",
+			"Could not read payment rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(paymentRuleToModel(ctx, rule, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPaymentRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexPaymentRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX payment rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Start from the current rule, so installments and other settings the resource does not manage are kept
+	rule, err := r.client.GetPaymentRule(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Payment Rule",
+			"Could not read payment rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.paymentRuleFromModel(ctx, data, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.UpdatePaymentRule(ctx, data.ID.ValueString(), *rule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Payment Rule",
+			"Could not update payment rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(paymentRuleToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPaymentRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexPaymentRuleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX payment rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeletePaymentRule(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Payment Rule",
+			"Could not delete payment rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX payment rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexPaymentRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: payment rule ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// paymentRuleFromModel applies the resource model over a payment rule. The gateway needs the
+// implementation of each affiliation next to its ID, so the affiliations are read first.
+func (r *VtexPaymentRuleResource) paymentRuleFromModel(ctx context.Context, data VtexPaymentRuleResourceModel, rule *client.PaymentRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The validator already checked the ID
+	paymentSystemID, _ := parseNumericID(data.PaymentSystemID.ValueString())
+
+	salesChannels := []string{}
+	diags.Append(data.SalesChannels.ElementsAs(ctx, &salesChannels, false)...)
+
+	connector, err := r.ruleAffiliation(ctx, data.AffiliationID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("affiliation_id"), "Invalid Affiliation", err.Error())
+	}
+	antifraud, err := r.ruleAffiliation(ctx, data.AntifraudAffiliationID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("antifraud_affiliation_id"), "Invalid Anti-fraud Affiliation", err.Error())
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	rule.Name = data.Name.ValueString()
+	rule.Enabled = data.Enabled.ValueBool()
+	rule.PaymentSystem = client.PaymentRuleSystem{ID: paymentSystemID}
+	rule.SalesChannels = make([]client.PaymentRuleSalesChannel, 0, len(salesChannels))
+	for _, salesChannel := range salesChannels {
+		rule.SalesChannels = append(rule.SalesChannels, client.PaymentRuleSalesChannel{ID: salesChannel})
+	}
+	rule.Connector = connector
+	rule.Antifraud = antifraud
+
+	rule.MinimumValue = nil
+	if !data.MinimumValue.IsNull() {
+		minimumValue := data.MinimumValue.ValueFloat64()
+		rule.MinimumValue = &minimumValue
+	}
+	rule.BeginDate = nil
+	if !data.BeginDate.IsNull() {
+		beginDate := formatDateTimeRFC3339(data.BeginDate.ValueString())
+		rule.BeginDate = &beginDate
+	}
+	rule.EndDate = nil
+	if !data.EndDate.IsNull() {
+		endDate := formatDateTimeRFC3339(data.EndDate.ValueString())
+		rule.EndDate = &endDate
+	}
+	return diags
+}
+
+// ruleAffiliation returns the affiliation reference of a payment rule, empty when the ID is null
+func (r *VtexPaymentRuleResource) ruleAffiliation(ctx context.Context, affiliationID types.String) (client.PaymentRuleAffiliation, error) {
+	if affiliationID.IsNull() {
+		return client.PaymentRuleAffiliation{}, nil
+	}
+
+	affiliation, err := r.client.GetAffiliation(ctx, affiliationID.ValueString())
+	if client.IsNotFound(err) {
+		return client.PaymentRuleAffiliation{}, fmt.Errorf("affiliation %s does not exist", affiliationID.ValueString())
+	}
+	if err != nil {
+		return client.PaymentRuleAffiliation{}, fmt.Errorf("could not read affiliation %s: %w", affiliationID.ValueString(), err)
+	}
+
+	id := affiliation.ID
+	return client.PaymentRuleAffiliation{Implementation: &affiliation.Implementation, AffiliationID: &id}, nil
+}
+
+// paymentRuleToModel copies an API payment rule into the resource model
+func paymentRuleToModel(ctx context.Context, rule *client.PaymentRule, data *VtexPaymentRuleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(rule.ID)
+	data.Name = types.StringValue(rule.Name)
+	data.Enabled = types.BoolValue(rule.Enabled)
+	data.PaymentSystemID = types.StringValue(strconv.FormatInt(rule.PaymentSystem.ID, 10))
+	data.PaymentSystemName = types.StringValue(rule.PaymentSystem.Name)
+
+	salesChannels := make([]string, 0, len(rule.SalesChannels))
+	for _, salesChannel := range rule.SalesChannels {
+		salesChannels = append(salesChannels, salesChannel.ID)
+	}
+	set, setDiags := types.SetValueFrom(ctx, types.StringType, salesChannels)
+	diags.Append(setDiags...)
+	data.SalesChannels = set
+
+	data.AffiliationID = types.StringNull()
+	if rule.Connector.AffiliationID != nil {
+		data.AffiliationID = types.StringValue(*rule.Connector.AffiliationID)
+	}
+	data.AntifraudAffiliationID = types.StringNull()
+	if rule.Antifraud.AffiliationID != nil && *rule.Antifraud.AffiliationID != "" {
+		data.AntifraudAffiliationID = types.StringValue(*rule.Antifraud.AffiliationID)
+	}
+
+	data.MinimumValue = types.Float64Null()
+	if rule.MinimumValue != nil {
+		data.MinimumValue = types.Float64Value(*rule.MinimumValue)
+	}
+	var beginDate, endDate string
+	if rule.BeginDate != nil {
+		beginDate = *rule.BeginDate
+	}
+	if rule.EndDate != nil {
+		endDate = *rule.EndDate
+	}
+	data.BeginDate = dateTimeValue(beginDate, data.BeginDate)
+	data.EndDate = dateTimeValue(endDate, data.EndDate)
+	return diags
+}
@@ -0,0 +1,565 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexPickupPointResource{}
+var _ resource.ResourceWithImportState = &VtexPickupPointResource{}
+var _ resource.ResourceWithValidateConfig = &VtexPickupPointResource{}
+
+// coordinateTolerance is the difference between coordinates treated as the same point (about 10 cm)
+const coordinateTolerance = 1e-6
+
+func NewVtexPickupPointResource() resource.Resource {
+	return &VtexPickupPointResource{}
+}
+
+// VtexPickupPointResource is the resource implementation
+type VtexPickupPointResource struct {
+	client *client.VtexClient
+}
+
+// VtexPickupPointResourceModel is the resource data model
+type VtexPickupPointResourceModel struct {
+	ID               types.String                 `tfsdk:"id"`
+	PickupPointID    types.String                 `tfsdk:"pickup_point_id"`
+	Name             types.String                 `tfsdk:"name"`
+	Description      types.String                 `tfsdk:"description"`
+	Instructions     types.String                 `tfsdk:"instructions"`
+	Active           types.Bool                   `tfsdk:"active"`
+	Address          *VtexPickupPointAddressModel `tfsdk:"address"`
+	FormattedAddress types.String                 `tfsdk:"formatted_address"`
+	BusinessHours    types.List                   `tfsdk:"business_hours"`
+	Tags             types.Set                    `tfsdk:"tags"`
+}
+
+// VtexPickupPointAddressModel is the address of the pickup point
+type VtexPickupPointAddressModel struct {
+	PostalCode   types.String  `tfsdk:"postal_code"`
+	Country      types.String  `tfsdk:"country"`
+	State        types.String  `tfsdk:"state"`
+	City         types.String  `tfsdk:"city"`
+	Neighborhood types.String  `tfsdk:"neighborhood"`
+	Street       types.String  `tfsdk:"street"`
+	Number       types.String  `tfsdk:"number"`
+	Complement   types.String  `tfsdk:"complement"`
+	Reference    types.String  `tfsdk:"reference"`
+	Latitude     types.Float64 `tfsdk:"latitude"`
+	Longitude    types.Float64 `tfsdk:"longitude"`
+}
+
+// VtexPickupPointHoursModel are the opening hours of a day of the week
+type VtexPickupPointHoursModel struct {
+	DayOfWeek   types.Int64  `tfsdk:"day_of_week"`
+	OpeningTime types.String `tfsdk:"opening_time"`
+	ClosingTime types.String `tfsdk:"closing_time"`
+}
+
+// pickupPointHoursAttrTypes are the attribute types of a business_hours element
+var pickupPointHoursAttrTypes = map[string]attr.Type{
+	"day_of_week":  types.Int64Type,
+	"opening_time": types.StringType,
+	"closing_time": types.StringType,
+}
+
+func (r *VtexPickupPointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pickup_point"
+}
+
+func (r *VtexPickupPointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	addressString := func(description string, required bool) schema.StringAttribute {
+		return schema.StringAttribute{
+			Required:    required,
+			Optional:    !required,
+			Description: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Logistics pickup point, where shoppers collect their orders.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Pickup point ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pickup_point_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Pickup point ID, chosen by the account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Pickup point name shown to shoppers",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Pickup point description",
+			},
+			"instructions": schema.StringAttribute{
+				Optional:    true,
+				Description: "Instructions for shoppers collecting an order, like the documents to bring",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the pickup point is offered at checkout",
+			},
+			"address": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Pickup point address",
+				Attributes: map[string]schema.Attribute{
+					"postal_code":  addressString("Postal code", true),
+					"country":      addressString("Country, as an ISO 3166 alpha-3 code like BRA", true),
+					"state":        addressString("State or province", true),
+					"city":         addressString("City", true),
+					"neighborhood": addressString("Neighborhood", false),
+					"street":       addressString("Street", true),
+					"number":       addressString("Street number", false),
+					"complement":   addressString("Address complement, like the floor or suite", false),
+					"reference":    addressString("Reference point near the address", false),
+					"latitude": schema.Float64Attribute{
+						Required:    true,
+						Description: "Latitude of the address",
+					},
+					"longitude": schema.Float64Attribute{
+						Required:    true,
+						Description: "Longitude of the address",
+					},
+				},
+			},
+			"formatted_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Address formatted by VTEX",
+			},
+			"business_hours": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Opening hours, one entry per day of the week the pickup point is open",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day_of_week": schema.Int64Attribute{
+							Required:    true,
+							Description: "Day of the week, from 0 (Sunday) to 6 (Saturday)",
+						},
+						"opening_time": schema.StringAttribute{
+							Required:    true,
+							Description: "Opening time, like 08:00",
+							Validators: []validator.String{
+								timeOfDayValidator{},
+							},
+						},
+						"closing_time": schema.StringAttribute{
+							Required:    true,
+							Description: "Closing time, like 18:00",
+							Validators: []validator.String{
+								timeOfDayValidator{},
+							},
+						},
+					},
+				},
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Tags that shipping policies use to offer the pickup point in their trade policies",
+			},
+		},
+	}
+}
+
+func (r *VtexPickupPointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexPickupPointResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var businessHours types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("business_hours"), &businessHours)...)
+
+	// Unknown values are checked again at apply time
+	if resp.Diagnostics.HasError() || businessHours.IsNull() || businessHours.IsUnknown() {
+		return
+	}
+
+	var hours []VtexPickupPointHoursModel
+	resp.Diagnostics.Append(businessHours.ElementsAs(ctx, &hours, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[int64]bool, len(hours))
+	for i, h := range hours {
+		hoursPath := path.Root("business_hours").AtListIndex(i)
+
+		if !h.DayOfWeek.IsUnknown() {
+			day := h.DayOfWeek.ValueInt64()
+			if day < 0 || day > 6 {
+				resp.Diagnostics.AddAttributeError(
+					hoursPath.AtName("day_of_week"),
+					"Invalid Day of Week",
+					fmt.Sprintf("Expected a day from 0 (Sunday) to 6 (Saturday), got: %d", day),
+				)
+			} else if seen[day] {
+				resp.Diagnostics.AddAttributeError(
+					hoursPath.AtName("day_of_week"),
+					"Duplicate Business Hours",
+					fmt.Sprintf("Day %d has more than one entry.", day),
+				)
+			}
+			seen[day] = true
+		}
+
+		if h.OpeningTime.IsUnknown() || h.ClosingTime.IsUnknown() {
+			continue
+		}
+		opening, errOpening := parseTimeOfDay(h.OpeningTime.ValueString())
+		closing, errClosing := parseTimeOfDay(h.ClosingTime.ValueString())
+		if errOpening == nil && errClosing == nil && !closing.After(opening) {
+			resp.Diagnostics.AddAttributeError(
+				hoursPath.AtName("closing_time"),
+				"Invalid Business Hours",
+				"closing_time must be after opening_time.",
+			)
+		}
+	}
+}
+
+func (r *VtexPickupPointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexPickupPointResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pickupPoint, diags := pickupPointFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX pickup point", map[string]interface{}{
+		"pickup_point_id": pickupPoint.ID,
+		"name":            pickupPoint.Name,
+	})
+
+	result, err := r.savePickupPoint(ctx, pickupPoint)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Pickup Point",
+			"Could not create pickup point, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(pickupPointToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX pickup point", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPickupPointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexPickupPointResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX pickup point", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	pickupPoint, err := r.client.GetPickupPoint(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX pickup point not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Pickup Point",
+			"Could not read pickup point, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(pickupPointToModel(ctx, pickupPoint, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPickupPointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexPickupPointResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pickupPoint, diags := pickupPointFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX pickup point", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.savePickupPoint(ctx, pickupPoint)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Pickup Point",
+			"Could not update pickup point, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(pickupPointToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPickupPointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexPickupPointResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX pickup point", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeletePickupPoint(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Pickup Point",
+			"Could not delete pickup point, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX pickup point", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexPickupPointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: pickup point ID
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pickup_point_id"), req.ID)...)
+}
+
+// savePickupPoint creates or replaces a pickup point and reads it back, with the address formatted by VTEX
+func (r *VtexPickupPointResource) savePickupPoint(ctx context.Context, pickupPoint client.PickupPoint) (*client.PickupPoint, error) {
+	if err := r.client.SavePickupPoint(ctx, pickupPoint); err != nil {
+		return nil, err
+	}
+	return r.client.GetPickupPoint(ctx, pickupPoint.ID)
+}
+
+// addressValue returns the API value, or the current value when they only differ in case,
+// spaces or punctuation, since VTEX normalizes postal codes and state names
+func addressValue(apiValue string, current types.String) types.String {
+	if !current.IsNull() && !current.IsUnknown() && normalizeAddressValue(apiValue) == normalizeAddressValue(current.ValueString()) {
+		return current
+	}
+	return optionalString(apiValue, current)
+}
+
+// normalizeAddressValue lowercases an address value and keeps only its letters and digits
+func normalizeAddressValue(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, value)
+}
+
+// coordinateValue returns the API coordinate, or the current one when VTEX only rounded it
+func coordinateValue(apiValue float64, current types.Float64) types.Float64 {
+	if !current.IsNull() && !current.IsUnknown() && math.Abs(current.ValueFloat64()-apiValue) < coordinateTolerance {
+		return current
+	}
+	return types.Float64Value(apiValue)
+}
+
+// pickupPointFromModel builds the API payload from the resource model
+func pickupPointFromModel(ctx context.Context, data VtexPickupPointResourceModel) (client.PickupPoint, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var hours []VtexPickupPointHoursModel
+	if !data.BusinessHours.IsNull() {
+		diags.Append(data.BusinessHours.ElementsAs(ctx, &hours, false)...)
+	}
+	businessHours := make([]client.PickupBusinessHours, 0, len(hours))
+	for _, h := range hours {
+		// The validator already checked the times
+		opening, _ := parseTimeOfDay(h.OpeningTime.ValueString())
+		closing, _ := parseTimeOfDay(h.ClosingTime.ValueString())
+		businessHours = append(businessHours, client.PickupBusinessHours{
+			DayOfWeek:   h.DayOfWeek.ValueInt64(),
+			OpeningTime: opening.Format("15:04:05"),
+			ClosingTime: closing.Format("15:04:05"),
+		})
+	}
+
+	tags := []string{}
+	if !data.Tags.IsNull() {
+		diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	// The address is required, so it is set in plans
+	address := data.Address
+	pickupPoint := client.PickupPoint{
+		ID:           data.PickupPointID.ValueString(),
+		Name:         data.Name.ValueString(),
+		Description:  data.Description.ValueString(),
+		Instructions: data.Instructions.ValueString(),
+		IsActive:     data.Active.ValueBool(),
+		Address: client.PickupPointAddress{
+			PostalCode:   address.PostalCode.ValueString(),
+			Country:      client.AddressCountry{Acronym: strings.ToUpper(address.Country.ValueString())},
+			State:        address.State.ValueString(),
+			City:         address.City.ValueString(),
+			Neighborhood: address.Neighborhood.ValueString(),
+			Street:       address.Street.ValueString(),
+			Number:       address.Number.ValueString(),
+			Complement:   address.Complement.ValueString(),
+			Reference:    address.Reference.ValueString(),
+			Location: client.AddressLocation{
+				Latitude:  address.Latitude.ValueFloat64(),
+				Longitude: address.Longitude.ValueFloat64(),
+			},
+		},
+		BusinessHours: businessHours,
+		TagsLabel:     tags,
+	}
+	return pickupPoint, diags
+}
+
+// pickupPointToModel copies an API pickup point into the resource model
+func pickupPointToModel(ctx context.Context, pickupPoint *client.PickupPoint, data *VtexPickupPointResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(pickupPoint.ID)
+	data.PickupPointID = types.StringValue(pickupPoint.ID)
+	data.Name = types.StringValue(pickupPoint.Name)
+	data.Description = optionalString(pickupPoint.Description, data.Description)
+	data.Instructions = optionalString(pickupPoint.Instructions, data.Instructions)
+	data.Active = types.BoolValue(pickupPoint.IsActive)
+	data.FormattedAddress = types.StringValue(pickupPoint.FormattedAddress)
+
+	// Imported pickup points have no address in the state yet
+	address := pickupPoint.Address
+	current := data.Address
+	if current == nil {
+		current = &VtexPickupPointAddressModel{}
+	}
+	data.Address = &VtexPickupPointAddressModel{
+		PostalCode:   addressValue(address.PostalCode, current.PostalCode),
+		Country:      addressValue(address.Country.Acronym, current.Country),
+		State:        addressValue(address.State, current.State),
+		City:         addressValue(address.City, current.City),
+		Neighborhood: addressValue(address.Neighborhood, current.Neighborhood),
+		Street:       addressValue(address.Street, current.Street),
+		Number:       addressValue(address.Number, current.Number),
+		Complement:   addressValue(address.Complement, current.Complement),
+		Reference:    addressValue(address.Reference, current.Reference),
+		Latitude:     coordinateValue(address.Location.Latitude, current.Latitude),
+		Longitude:    coordinateValue(address.Location.Longitude, current.Longitude),
+	}
+
+	var currentHours []VtexPickupPointHoursModel
+	if !data.BusinessHours.IsNull() && !data.BusinessHours.IsUnknown() {
+		diags.Append(data.BusinessHours.ElementsAs(ctx, &currentHours, false)...)
+	}
+	if len(pickupPoint.BusinessHours) == 0 && data.BusinessHours.IsNull() {
+		data.BusinessHours = types.ListNull(types.ObjectType{AttrTypes: pickupPointHoursAttrTypes})
+	} else {
+		hours := make([]VtexPickupPointHoursModel, 0, len(pickupPoint.BusinessHours))
+		for i, h := range pickupPoint.BusinessHours {
+			model := VtexPickupPointHoursModel{
+				DayOfWeek:   types.Int64Value(h.DayOfWeek),
+				OpeningTime: types.StringValue(h.OpeningTime),
+				ClosingTime: types.StringValue(h.ClosingTime),
+			}
+			// Keep the configured notation of the times, like 08:00 for 08:00:00
+			if i < len(currentHours) && currentHours[i].DayOfWeek.ValueInt64() == h.DayOfWeek {
+				model.OpeningTime = timeOfDayValue(h.OpeningTime, currentHours[i].OpeningTime)
+				model.ClosingTime = timeOfDayValue(h.ClosingTime, currentHours[i].ClosingTime)
+			}
+			hours = append(hours, model)
+		}
+		list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pickupPointHoursAttrTypes}, hours)
+		diags.Append(listDiags...)
+		data.BusinessHours = list
+	}
+
+	tags, setDiags := optionalStringSet(ctx, pickupPoint.TagsLabel, data.Tags)
+	diags.Append(setDiags...)
+	data.Tags = tags
+	return diags
+}
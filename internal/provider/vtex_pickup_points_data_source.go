@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexPickupPointsDataSource{}
+
+func NewVtexPickupPointsDataSource() datasource.DataSource {
+	return &VtexPickupPointsDataSource{}
+}
+
+// VtexPickupPointsDataSource is the data source implementation
+type VtexPickupPointsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexPickupPointsDataSourceModel is the data source data model
+type VtexPickupPointsDataSourceModel struct {
+	ID           types.String               `tfsdk:"id"`
+	Tag          types.String               `tfsdk:"tag"`
+	SalesChannel types.String               `tfsdk:"sales_channel"`
+	ActiveOnly   types.Bool                 `tfsdk:"active_only"`
+	PickupPoints []VtexPickupPointItemModel `tfsdk:"pickup_points"`
+}
+
+// VtexPickupPointItemModel is a pickup point in the list
+type VtexPickupPointItemModel struct {
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Active           types.Bool     `tfsdk:"active"`
+	PostalCode       types.String   `tfsdk:"postal_code"`
+	Country          types.String   `tfsdk:"country"`
+	City             types.String   `tfsdk:"city"`
+	FormattedAddress types.String   `tfsdk:"formatted_address"`
+	Latitude         types.Float64  `tfsdk:"latitude"`
+	Longitude        types.Float64  `tfsdk:"longitude"`
+	Tags             []types.String `tfsdk:"tags"`
+}
+
+func (d *VtexPickupPointsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pickup_points"
+}
+
+func (d *VtexPickupPointsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Logistics pickup points of the account, optionally filtered by tag or sales channel.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Filters used, or \"all\"",
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list pickup points with this tag, the tag shipping policies use to offer them in a trade policy",
+			},
+			"sales_channel": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list pickup points served by a warehouse with a dock in this sales channel",
+			},
+			"active_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list active pickup points",
+			},
+			"pickup_points": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Pickup points found",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Pickup point ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Pickup point name",
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the pickup point is offered at checkout",
+						},
+						"postal_code": schema.StringAttribute{
+							Computed:    true,
+							Description: "Postal code of the address",
+						},
+						"country": schema.StringAttribute{
+							Computed:    true,
+							Description: "Country of the address, as an ISO 3166 alpha-3 code",
+						},
+						"city": schema.StringAttribute{
+							Computed:    true,
+							Description: "City of the address",
+						},
+						"formatted_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Address formatted by VTEX",
+						},
+						"latitude": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Latitude of the address",
+						},
+						"longitude": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Longitude of the address",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Pickup point tags",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexPickupPointsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexPickupPointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexPickupPointsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag := data.Tag.ValueString()
+	salesChannel := data.SalesChannel.ValueString()
+	activeOnly := data.ActiveOnly.ValueBool()
+
+	tflog.Debug(ctx, "Listing VTEX pickup points", map[string]interface{}{
+		"tag":           tag,
+		"sales_channel": salesChannel,
+		"active_only":   activeOnly,
+	})
+
+	pickupPoints, err := d.client.ListPickupPoints(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Pickup Points",
+			"Could not list pickup points, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var served map[string]bool
+	if salesChannel != "" {
+		served, err = d.pickupPointsInSalesChannel(ctx, salesChannel)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX Pickup Points",
+				"Could not resolve the pickup points of sales channel "+salesChannel+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	data.PickupPoints = make([]VtexPickupPointItemModel, 0, len(pickupPoints))
+	for _, pickupPoint := range pickupPoints {
+		if tag != "" && !slices.Contains(pickupPoint.TagsLabel, tag) {
+			continue
+		}
+		if served != nil && !served[pickupPoint.ID] {
+			continue
+		}
+		if activeOnly && !pickupPoint.IsActive {
+			continue
+		}
+
+		address := pickupPoint.Address
+		data.PickupPoints = append(data.PickupPoints, VtexPickupPointItemModel{
+			ID:               types.StringValue(pickupPoint.ID),
+			Name:             types.StringValue(pickupPoint.Name),
+			Active:           types.BoolValue(pickupPoint.IsActive),
+			PostalCode:       types.StringValue(address.PostalCode),
+			Country:          types.StringValue(address.Country.Acronym),
+			City:             types.StringValue(address.City),
+			FormattedAddress: types.StringValue(pickupPoint.FormattedAddress),
+			Latitude:         types.Float64Value(address.Location.Latitude),
+			Longitude:        types.Float64Value(address.Location.Longitude),
+			Tags:             stringValues(pickupPoint.TagsLabel),
+		})
+	}
+
+	data.ID = types.StringValue("all")
+	if tag != "" || salesChannel != "" || activeOnly {
+		data.ID = types.StringValue(encodeID(tag, salesChannel, fmt.Sprint(activeOnly)))
+	}
+
+	tflog.Trace(ctx, "Listed VTEX pickup points", map[string]interface{}{
+		"count": len(data.PickupPoints),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// pickupPointsInSalesChannel collects the pickup points of the warehouses
+// with at least one dock in the sales channel
+func (d *VtexPickupPointsDataSource) pickupPointsInSalesChannel(ctx context.Context, salesChannel string) (map[string]bool, error) {
+	docks, err := d.client.ListDocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	warehouses, err := d.client.ListWarehouses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inSalesChannel := map[string]bool{}
+	for _, dock := range docks {
+		if slices.Contains(dock.SalesChannels, salesChannel) {
+			inSalesChannel[dock.ID] = true
+		}
+	}
+
+	served := map[string]bool{}
+	for _, warehouse := range warehouses {
+		for _, dock := range warehouse.WarehouseDocks {
+			if !inSalesChannel[dock.DockID] {
+				continue
+			}
+			for _, id := range warehouse.PickupPointIDs {
+				served[id] = true
+			}
+			break
+		}
+	}
+	return served, nil
+}
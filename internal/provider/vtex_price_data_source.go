@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexPriceDataSource{}
+
+func NewVtexPriceDataSource() datasource.DataSource {
+	return &VtexPriceDataSource{}
+}
+
+// VtexPriceDataSource is the data source implementation
+type VtexPriceDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexPriceDataSourceModel is the data source data model
+type VtexPriceDataSourceModel struct {
+	ID              types.String                   `tfsdk:"id"`
+	SKUID           types.String                   `tfsdk:"sku_id"`
+	TradePolicyID   types.String                   `tfsdk:"trade_policy_id"`
+	Quantity        types.Int64                    `tfsdk:"quantity"`
+	BasePrice       types.Float64                  `tfsdk:"base_price"`
+	ListPrice       types.Float64                  `tfsdk:"list_price"`
+	CostPrice       types.Float64                  `tfsdk:"cost_price"`
+	Markup          types.Int64                    `tfsdk:"markup"`
+	FixedPrices     []VtexPriceFixedPriceItemModel `tfsdk:"fixed_prices"`
+	SellingPrice    types.Float64                  `tfsdk:"selling_price"`
+	PriceValidUntil types.String                   `tfsdk:"price_valid_until"`
+}
+
+// VtexPriceFixedPriceItemModel is a fixed price of the SKU
+type VtexPriceFixedPriceItemModel struct {
+	TradePolicyID types.String  `tfsdk:"trade_policy_id"`
+	Value         types.Float64 `tfsdk:"value"`
+	ListPrice     types.Float64 `tfsdk:"list_price"`
+	MinQuantity   types.Int64   `tfsdk:"min_quantity"`
+	DateFrom      types.String  `tfsdk:"date_from"`
+	DateTo        types.String  `tfsdk:"date_to"`
+}
+
+func (d *VtexPriceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price"
+}
+
+func (d *VtexPriceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the price of a SKU, and its computed selling price in a trade policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU ID",
+			},
+			"sku_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the SKU to read the price of",
+			},
+			"trade_policy_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Trade policy to compute selling_price in",
+			},
+			"quantity": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Quantity to compute selling_price for, which selects fixed price tiers (default: 1)",
+			},
+			"base_price": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Base selling price",
+			},
+			"list_price": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Suggested retail price; in the trade policy when trade_policy_id is set",
+			},
+			"cost_price": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Cost price",
+			},
+			"markup": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Markup percentage of the base price over cost_price",
+			},
+			"fixed_prices": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Fixed prices of the SKU in all trade policies",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"trade_policy_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Trade policy of the price",
+						},
+						"value": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Selling price",
+						},
+						"list_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Suggested retail price",
+						},
+						"min_quantity": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Minimum quantity for the price to apply",
+						},
+						"date_from": schema.StringAttribute{
+							Computed:    true,
+							Description: "Start of the price",
+						},
+						"date_to": schema.StringAttribute{
+							Computed:    true,
+							Description: "End of the price",
+						},
+					},
+				},
+			},
+			"selling_price": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Price charged in the trade policy after fixed prices and price rules (null without trade_policy_id)",
+			},
+			"price_valid_until": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date until which selling_price is valid (null without trade_policy_id)",
+			},
+		},
+	}
+}
+
+func (d *VtexPriceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexPriceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexPriceDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, err := parseNumericID(data.SKUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sku_id"), "Invalid SKU ID", err.Error())
+		return
+	}
+
+	quantity := int64(1)
+	if !data.Quantity.IsNull() {
+		quantity = data.Quantity.ValueInt64()
+	}
+	if quantity < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("quantity"), "Invalid Quantity", fmt.Sprintf("Expected a quantity of at least 1, got: %d", quantity))
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX price", map[string]interface{}{
+		"sku_id":          skuID,
+		"trade_policy_id": data.TradePolicyID.ValueString(),
+	})
+
+	price, err := d.client.GetPrice(ctx, skuID)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sku_id"),
+			"VTEX Price Not Found",
+			fmt.Sprintf("SKU %d has no price", skuID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Price",
+			"Could not read price, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(skuID, 10))
+	data.BasePrice = types.Float64PointerValue(price.BasePrice)
+	data.ListPrice = types.Float64PointerValue(price.ListPrice)
+	data.CostPrice = types.Float64PointerValue(price.CostPrice)
+	data.Markup = types.Int64PointerValue(price.Markup)
+	data.SellingPrice = types.Float64Null()
+	data.PriceValidUntil = types.StringNull()
+
+	data.FixedPrices = make([]VtexPriceFixedPriceItemModel, 0, len(price.FixedPrices))
+	for _, fixedPrice := range price.FixedPrices {
+		item := VtexPriceFixedPriceItemModel{
+			TradePolicyID: types.StringValue(fixedPrice.TradePolicyID),
+			Value:         types.Float64Value(fixedPrice.Value),
+			ListPrice:     types.Float64PointerValue(fixedPrice.ListPrice),
+			MinQuantity:   types.Int64Value(fixedPrice.MinQuantity),
+			DateFrom:      types.StringNull(),
+			DateTo:        types.StringNull(),
+		}
+		if fixedPrice.DateRange != nil {
+			item.DateFrom = types.StringValue(fixedPrice.DateRange.From)
+			item.DateTo = types.StringValue(fixedPrice.DateRange.To)
+		}
+		data.FixedPrices = append(data.FixedPrices, item)
+	}
+
+	if !data.TradePolicyID.IsNull() {
+		// Price rules match on the category path and brand, which the pricing API does not know
+		skuContext, err := d.client.GetSKUContext(ctx, skuID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX Price",
+				"Could not read SKU to compute its price, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		computed, err := d.client.GetComputedPrice(ctx, skuID, data.TradePolicyID.ValueString(), skuContext.CategoryIDs(), skuContext.BrandID, quantity)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX Price",
+				"Could not compute price in trade policy, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		data.SellingPrice = types.Float64Value(computed.SellingPrice)
+		data.ListPrice = types.Float64PointerValue(computed.ListPrice)
+		if computed.PriceValidUntil != "" {
+			data.PriceValidUntil = types.StringValue(computed.PriceValidUntil)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
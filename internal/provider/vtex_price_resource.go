@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexPriceResource{}
+var _ resource.ResourceWithImportState = &VtexPriceResource{}
+
+func NewVtexPriceResource() resource.Resource {
+	return &VtexPriceResource{}
+}
+
+// VtexPriceResource is the resource implementation
+type VtexPriceResource struct {
+	client *client.VtexClient
+}
+
+// VtexPriceResourceModel is the resource data model
+type VtexPriceResourceModel struct {
+	ID        types.String  `tfsdk:"id"`
+	SKUID     types.String  `tfsdk:"sku_id"`
+	BasePrice types.Float64 `tfsdk:"base_price"`
+	ListPrice types.Float64 `tfsdk:"list_price"`
+	CostPrice types.Float64 `tfsdk:"cost_price"`
+	Markup    types.Int64   `tfsdk:"markup"`
+}
+
+func (r *VtexPriceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price"
+}
+
+func (r *VtexPriceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the base price of a SKU in the Pricing module.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sku_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the SKU to price",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_price": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Base selling price (default: computed from cost_price and markup)",
+			},
+			"list_price": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Suggested retail price, shown crossed out next to the selling price",
+			},
+			"cost_price": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Cost price of the SKU",
+			},
+			"markup": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Markup percentage over cost_price (default: from the pricing configuration, or computed from base_price)",
+			},
+		},
+	}
+}
+
+func (r *VtexPriceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexPriceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexPriceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, err := parseNumericID(data.SKUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sku_id"), "Invalid SKU ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX price", map[string]interface{}{
+		"sku_id": skuID,
+	})
+
+	price, err := r.putPrice(ctx, skuID, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Price",
+			"Could not create price, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	priceToModel(skuID, price, &data)
+
+	tflog.Trace(ctx, "Created VTEX price", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPriceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexPriceResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Price ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX price", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	price, err := r.client.GetPrice(ctx, skuID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX price not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Price",
+			"Could not read price, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	priceToModel(skuID, price, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPriceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexPriceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Price ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX price", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	price, err := r.putPrice(ctx, skuID, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Price",
+			"Could not update price, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	priceToModel(skuID, price, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPriceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexPriceResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Price ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX price", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.client.DeletePrice(ctx, skuID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Price",
+			"Could not delete price, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX price", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexPriceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric SKU ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sku_id"), req.ID)...)
+}
+
+// putPrice sends the base price of the model and reads back the computed values.
+// The Pricing API replaces fixed prices along with the base price, so the
+// current fixed prices are sent back unchanged.
+func (r *VtexPriceResource) putPrice(ctx context.Context, skuID int64, data VtexPriceResourceModel) (*client.Price, error) {
+	fixedPrices := []client.FixedPrice{}
+	current, err := r.client.GetPrice(ctx, skuID)
+	if err != nil && !client.IsNotFound(err) {
+		return nil, err
+	}
+	if current != nil && current.FixedPrices != nil {
+		fixedPrices = current.FixedPrices
+	}
+
+	price := client.Price{
+		ListPrice:   data.ListPrice.ValueFloat64Pointer(),
+		CostPrice:   data.CostPrice.ValueFloat64Pointer(),
+		Markup:      data.Markup.ValueInt64Pointer(),
+		BasePrice:   data.BasePrice.ValueFloat64Pointer(),
+		FixedPrices: fixedPrices,
+	}
+	if err := r.client.PutPrice(ctx, skuID, price); err != nil {
+		return nil, err
+	}
+
+	return r.client.GetPrice(ctx, skuID)
+}
+
+// priceToModel copies an API price into the resource model
+func priceToModel(skuID int64, price *client.Price, data *VtexPriceResourceModel) {
+	data.ID = types.StringValue(strconv.FormatInt(skuID, 10))
+	data.SKUID = types.StringValue(strconv.FormatInt(skuID, 10))
+	data.BasePrice = types.Float64PointerValue(price.BasePrice)
+	data.ListPrice = types.Float64PointerValue(price.ListPrice)
+	data.CostPrice = types.Float64PointerValue(price.CostPrice)
+	data.Markup = types.Int64PointerValue(price.Markup)
+}
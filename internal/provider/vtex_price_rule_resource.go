@@ -0,0 +1,480 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexPriceRuleResource{}
+var _ resource.ResourceWithImportState = &VtexPriceRuleResource{}
+var _ resource.ResourceWithValidateConfig = &VtexPriceRuleResource{}
+
+func NewVtexPriceRuleResource() resource.Resource {
+	return &VtexPriceRuleResource{}
+}
+
+// VtexPriceRuleResource is the resource implementation
+type VtexPriceRuleResource struct {
+	client *client.VtexClient
+}
+
+// VtexPriceRuleResourceModel is the resource data model
+type VtexPriceRuleResourceModel struct {
+	ID            types.String             `tfsdk:"id"`
+	TradePolicyID types.String             `tfsdk:"trade_policy_id"`
+	Rules         []VtexPriceRuleItemModel `tfsdk:"rules"`
+}
+
+// VtexPriceRuleItemModel is a markup rule of the trade policy
+type VtexPriceRuleItemModel struct {
+	Markup      types.Float64 `tfsdk:"markup"`
+	CategoryIDs types.Set     `tfsdk:"category_ids"`
+	BrandIDs    types.Set     `tfsdk:"brand_ids"`
+	MarkupFrom  types.Float64 `tfsdk:"base_markup_from"`
+	MarkupTo    types.Float64 `tfsdk:"base_markup_to"`
+	DateFrom    types.String  `tfsdk:"date_from"`
+	DateTo      types.String  `tfsdk:"date_to"`
+}
+
+func (r *VtexPriceRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price_rule"
+}
+
+func (r *VtexPriceRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the pricing rules of a trade policy, which compute its prices from the base price by category, brand, markup range and date.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Trade policy ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"trade_policy_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the trade policy (price table) the rules compute prices for",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Rules, in the order the Pricing module evaluates them",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"markup": schema.Float64Attribute{
+							Required:    true,
+							Description: "Percentage applied to the base price (negative for a discount)",
+						},
+						"category_ids": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Only apply to SKUs of these categories (default: all)",
+						},
+						"brand_ids": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Only apply to SKUs of these brands (default: all)",
+						},
+						"base_markup_from": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Only apply to SKUs whose base price markup is at least this percentage. Requires base_markup_to",
+						},
+						"base_markup_to": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Only apply to SKUs whose base price markup is at most this percentage. Requires base_markup_from",
+						},
+						"date_from": schema.StringAttribute{
+							Optional:    true,
+							Description: "Start of the rule, as an RFC 3339 date. Requires date_to",
+							Validators: []validator.String{
+								dateTimeValidator{},
+							},
+						},
+						"date_to": schema.StringAttribute{
+							Optional:    true,
+							Description: "End of the rule, as an RFC 3339 date. Requires date_from",
+							Validators: []validator.String{
+								dateTimeValidator{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexPriceRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexPriceRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rules []VtexPriceRuleItemModel
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rules"), &rules)...)
+
+	if resp.Diagnostics.HasError() || rules == nil {
+		return
+	}
+
+	if len(rules) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rules"),
+			"Missing Price Rules",
+			"At least one rule is required. Remove the resource to delete the rules of the trade policy.",
+		)
+		return
+	}
+
+	for i, rule := range rules {
+		if !rule.MarkupFrom.IsUnknown() && !rule.MarkupTo.IsUnknown() && rule.MarkupFrom.IsNull() != rule.MarkupTo.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtListIndex(i),
+				"Incomplete Markup Range",
+				"base_markup_from and base_markup_to must be set together.",
+			)
+		}
+		if !rule.DateFrom.IsUnknown() && !rule.DateTo.IsUnknown() && rule.DateFrom.IsNull() != rule.DateTo.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtListIndex(i),
+				"Incomplete Date Range",
+				"date_from and date_to must be set together.",
+			)
+		}
+	}
+}
+
+func (r *VtexPriceRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexPriceRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tradePolicyID := data.TradePolicyID.ValueString()
+
+	tflog.Debug(ctx, "Creating VTEX price rules", map[string]interface{}{
+		"trade_policy_id": tradePolicyID,
+		"rules":           len(data.Rules),
+	})
+
+	rules, diags := r.priceRulesFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.putRules(ctx, tradePolicyID, rules)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Price Rule",
+			"Could not create price rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(priceRulesToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX price rules", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPriceRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexPriceRuleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX price rules", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	rules, err := r.client.GetPriceRules(ctx, data.TradePolicyID.ValueString())
+	if client.IsNotFound(err) || (err == nil && len(rules.Rules) == 0) {
+		tflog.Warn(ctx, "VTEX price rules not found, removing them from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Price Rule",
+			"Could not read price rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(priceRulesToModel(ctx, rules, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPriceRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexPriceRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX price rules", map[string]interface{}{
+		"id":    data.ID.ValueString(),
+		"rules": len(data.Rules),
+	})
+
+	rules, diags := r.priceRulesFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.putRules(ctx, data.TradePolicyID.ValueString(), rules)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Price Rule",
+			"Could not update price rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(priceRulesToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPriceRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexPriceRuleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX price rules", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Rules are deleted by replacing them with an empty list
+	err := r.client.PutPriceRules(ctx, data.TradePolicyID.ValueString(), client.PriceRules{Rules: []client.PriceRule{}})
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Price Rule",
+			"Could not delete price rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX price rules", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexPriceRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: trade policy ID
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("trade_policy_id"), req.ID)...)
+}
+
+// putRules replaces the rules of the trade policy and reads them back
+func (r *VtexPriceRuleResource) putRules(ctx context.Context, tradePolicyID string, rules client.PriceRules) (*client.PriceRules, error) {
+	if err := r.client.PutPriceRules(ctx, tradePolicyID, rules); err != nil {
+		return nil, err
+	}
+	result, err := r.client.GetPriceRules(ctx, tradePolicyID)
+	if err != nil {
+		return nil, err
+	}
+	result.TradePolicyID = tradePolicyID
+	return result, nil
+}
+
+// priceRulesFromModel builds the API payload from the resource model. The API
+// stores the names of the categories and brands next to their IDs, so they are
+// looked up in the catalog.
+func (r *VtexPriceRuleResource) priceRulesFromModel(ctx context.Context, data VtexPriceRuleResourceModel) (client.PriceRules, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	categoryNames := make(map[int64]string)
+	brandNames := make(map[int64]string)
+
+	rules := client.PriceRules{
+		TradePolicyID: data.TradePolicyID.ValueString(),
+		Rules:         make([]client.PriceRule, 0, len(data.Rules)),
+	}
+	for i, rule := range data.Rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+
+		var categoryIDs, brandIDs []string
+		diags.Append(rule.CategoryIDs.ElementsAs(ctx, &categoryIDs, false)...)
+		diags.Append(rule.BrandIDs.ElementsAs(ctx, &brandIDs, false)...)
+
+		priceRule := client.PriceRule{
+			// Rules are always sent as a whole, so their IDs are their positions
+			ID: int64(i + 1),
+			Context: client.PriceRuleContext{
+				Categories: make(map[string]string, len(categoryIDs)),
+				Brands:     make(map[string]string, len(brandIDs)),
+			},
+			Percentual: rule.Markup.ValueFloat64(),
+		}
+
+		for _, id := range categoryIDs {
+			categoryID, err := parseNumericID(id)
+			if err != nil {
+				diags.AddAttributeError(rulePath.AtName("category_ids"), "Invalid Category ID", err.Error())
+				continue
+			}
+			if _, ok := categoryNames[categoryID]; !ok {
+				category, err := r.client.GetCategory(ctx, categoryID)
+				if err != nil {
+					diags.AddAttributeError(rulePath.AtName("category_ids"), "Error Reading VTEX Category", fmt.Sprintf("Could not read category %d, unexpected error: %s", categoryID, err))
+					continue
+				}
+				categoryNames[categoryID] = category.Name
+			}
+			priceRule.Context.Categories[id] = categoryNames[categoryID]
+		}
+
+		for _, id := range brandIDs {
+			brandID, err := parseNumericID(id)
+			if err != nil {
+				diags.AddAttributeError(rulePath.AtName("brand_ids"), "Invalid Brand ID", err.Error())
+				continue
+			}
+			if _, ok := brandNames[brandID]; !ok {
+				brand, err := r.client.GetBrand(ctx, brandID)
+				if err != nil {
+					diags.AddAttributeError(rulePath.AtName("brand_ids"), "Error Reading VTEX Brand", fmt.Sprintf("Could not read brand %d, unexpected error: %s", brandID, err))
+					continue
+				}
+				brandNames[brandID] = brand.Name
+			}
+			priceRule.Context.Brands[id] = brandNames[brandID]
+		}
+
+		if !rule.MarkupFrom.IsNull() {
+			priceRule.Context.MarkupRange = &client.PriceRuleRange{
+				From: rule.MarkupFrom.ValueFloat64(),
+				To:   rule.MarkupTo.ValueFloat64(),
+			}
+		}
+		if !rule.DateFrom.IsNull() {
+			priceRule.Context.DateRange = &client.PriceDateRange{
+				From: formatDateTimeRFC3339(rule.DateFrom.ValueString()),
+				To:   formatDateTimeRFC3339(rule.DateTo.ValueString()),
+			}
+		}
+
+		rules.Rules = append(rules.Rules, priceRule)
+	}
+	return rules, diags
+}
+
+// priceRulesToModel copies the API price rules into the resource model.
+// Empty category and brand filters stay null when they are null in the model.
+func priceRulesToModel(ctx context.Context, rules *client.PriceRules, data *VtexPriceRuleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = data.TradePolicyID
+
+	// The API does not promise an order, so rules are sorted by ID (their configured position)
+	sorted := make([]client.PriceRule, len(rules.Rules))
+	copy(sorted, rules.Rules)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	current := data.Rules
+	data.Rules = make([]VtexPriceRuleItemModel, 0, len(sorted))
+	for i, rule := range sorted {
+		existing := VtexPriceRuleItemModel{
+			CategoryIDs: types.SetNull(types.StringType),
+			BrandIDs:    types.SetNull(types.StringType),
+			DateFrom:    types.StringNull(),
+			DateTo:      types.StringNull(),
+		}
+		if i < len(current) {
+			existing = current[i]
+		}
+
+		model := VtexPriceRuleItemModel{
+			Markup:      types.Float64Value(rule.Percentual),
+			CategoryIDs: priceRuleIDs(ctx, rule.Context.Categories, existing.CategoryIDs, &diags),
+			BrandIDs:    priceRuleIDs(ctx, rule.Context.Brands, existing.BrandIDs, &diags),
+			MarkupFrom:  types.Float64Null(),
+			MarkupTo:    types.Float64Null(),
+			DateFrom:    types.StringNull(),
+			DateTo:      types.StringNull(),
+		}
+		if rule.Context.MarkupRange != nil {
+			model.MarkupFrom = types.Float64Value(rule.Context.MarkupRange.From)
+			model.MarkupTo = types.Float64Value(rule.Context.MarkupRange.To)
+		}
+		if rule.Context.DateRange != nil {
+			model.DateFrom = dateTimeValue(rule.Context.DateRange.From, existing.DateFrom)
+			model.DateTo = dateTimeValue(rule.Context.DateRange.To, existing.DateTo)
+		}
+		data.Rules = append(data.Rules, model)
+	}
+	return diags
+}
+
+// priceRuleIDs returns the IDs of a rule filter as a set, or null when the
+// filter is empty and the current value is null
+func priceRuleIDs(ctx context.Context, names map[string]string, current types.Set, diags *diag.Diagnostics) types.Set {
+	ids := make([]string, 0, len(names))
+	for id := range names {
+		ids = append(ids, id)
+	}
+	value, setDiags := optionalStringSet(ctx, ids, current)
+	diags.Append(setDiags...)
+	return value
+}
@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexPriceSimulationDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexPriceSimulationDataSource{}
+
+// defaultSellerID is the seller ID of the account's own inventory
+const defaultSellerID = "1"
+
+func NewVtexPriceSimulationDataSource() datasource.DataSource {
+	return &VtexPriceSimulationDataSource{}
+}
+
+// VtexPriceSimulationDataSource is the data source implementation
+type VtexPriceSimulationDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexPriceSimulationDataSourceModel is the data source data model
+type VtexPriceSimulationDataSourceModel struct {
+	ID              types.String                     `tfsdk:"id"`
+	TradePolicyID   types.String                     `tfsdk:"trade_policy_id"`
+	PostalCode      types.String                     `tfsdk:"postal_code"`
+	Country         types.String                     `tfsdk:"country"`
+	Items           []VtexPriceSimulationItemModel   `tfsdk:"items"`
+	Results         []VtexPriceSimulationResultModel `tfsdk:"results"`
+	Totals          types.Map                        `tfsdk:"totals"`
+	Total           types.Float64                    `tfsdk:"total"`
+	DeliveryOptions []VtexPriceSimulationOptionModel `tfsdk:"delivery_options"`
+}
+
+// VtexPriceSimulationItemModel is an item of the simulated cart
+type VtexPriceSimulationItemModel struct {
+	SKUID    types.String `tfsdk:"sku_id"`
+	Quantity types.Int64  `tfsdk:"quantity"`
+	Seller   types.String `tfsdk:"seller"`
+}
+
+// VtexPriceSimulationResultModel is a priced item of the simulation
+type VtexPriceSimulationResultModel struct {
+	SKUID        types.String  `tfsdk:"sku_id"`
+	Seller       types.String  `tfsdk:"seller"`
+	Quantity     types.Int64   `tfsdk:"quantity"`
+	Price        types.Float64 `tfsdk:"price"`
+	ListPrice    types.Float64 `tfsdk:"list_price"`
+	SellingPrice types.Float64 `tfsdk:"selling_price"`
+	Availability types.String  `tfsdk:"availability"`
+}
+
+// VtexPriceSimulationOptionModel is a delivery option of a simulated item
+type VtexPriceSimulationOptionModel struct {
+	ItemIndex        types.Int64   `tfsdk:"item_index"`
+	ID               types.String  `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	Price            types.Float64 `tfsdk:"price"`
+	ShippingEstimate types.String  `tfsdk:"shipping_estimate"`
+	DeliveryChannel  types.String  `tfsdk:"delivery_channel"`
+}
+
+func (d *VtexPriceSimulationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price_simulation"
+}
+
+func (d *VtexPriceSimulationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Simulates a cart in checkout and returns its prices and delivery options.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Trade policy of the simulation",
+			},
+			"trade_policy_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Trade policy (sales channel) to simulate in (default: the default trade policy)",
+			},
+			"postal_code": schema.StringAttribute{
+				Optional:    true,
+				Description: "Postal code to compute delivery options for. Requires country",
+			},
+			"country": schema.StringAttribute{
+				Optional:    true,
+				Description: "Three-letter country code of the postal code, e.g. BRA",
+			},
+			"items": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Items of the cart",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sku_id": schema.StringAttribute{
+							Required:    true,
+							Description: "SKU ID",
+						},
+						"quantity": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Quantity (default: 1)",
+						},
+						"seller": schema.StringAttribute{
+							Optional:    true,
+							Description: "Seller ID (default: \"" + defaultSellerID + "\", the account itself)",
+						},
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Priced items, in the order of items",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sku_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "SKU ID",
+						},
+						"seller": schema.StringAttribute{
+							Computed:    true,
+							Description: "Seller ID",
+						},
+						"quantity": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Quantity available for the cart",
+						},
+						"price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Unit price before promotions",
+						},
+						"list_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Unit suggested retail price",
+						},
+						"selling_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Unit price after promotions",
+						},
+						"availability": schema.StringAttribute{
+							Computed:    true,
+							Description: "Availability of the item, e.g. available or withoutStock",
+						},
+					},
+				},
+			},
+			"totals": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Float64Type,
+				Description: "Totals of the cart by ID (Items, Discounts, Shipping, Tax)",
+			},
+			"total": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Sum of totals",
+			},
+			"delivery_options": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Delivery options of the items (empty without postal_code)",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_index": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Index of the item in items",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Shipping policy ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Shipping policy name",
+						},
+						"price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Delivery price of the item",
+						},
+						"shipping_estimate": schema.StringAttribute{
+							Computed:    true,
+							Description: "Delivery time, e.g. 3bd for three business days",
+						},
+						"delivery_channel": schema.StringAttribute{
+							Computed:    true,
+							Description: "delivery or pickup-in-point",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexPriceSimulationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexPriceSimulationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexPriceSimulationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PostalCode.IsNull() && !data.PostalCode.IsUnknown() && data.Country.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("country"),
+			"Missing Country",
+			"country is required with postal_code.",
+		)
+	}
+
+	if data.Items != nil && len(data.Items) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("items"),
+			"Missing Items",
+			"At least one item is required.",
+		)
+	}
+
+	for i, item := range data.Items {
+		if !item.Quantity.IsNull() && !item.Quantity.IsUnknown() && item.Quantity.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("items").AtListIndex(i).AtName("quantity"),
+				"Invalid Quantity",
+				fmt.Sprintf("Expected a quantity of at least 1, got: %d", item.Quantity.ValueInt64()),
+			)
+		}
+	}
+}
+
+func (d *VtexPriceSimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexPriceSimulationDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cart := client.SimulationRequest{
+		Items:      make([]client.SimulationRequestItem, 0, len(data.Items)),
+		PostalCode: data.PostalCode.ValueString(),
+		Country:    data.Country.ValueString(),
+	}
+	for _, item := range data.Items {
+		quantity := int64(1)
+		if !item.Quantity.IsNull() {
+			quantity = item.Quantity.ValueInt64()
+		}
+		seller := defaultSellerID
+		if !item.Seller.IsNull() {
+			seller = item.Seller.ValueString()
+		}
+		cart.Items = append(cart.Items, client.SimulationRequestItem{
+			ID:       item.SKUID.ValueString(),
+			Quantity: quantity,
+			Seller:   seller,
+		})
+	}
+
+	tflog.Debug(ctx, "Simulating VTEX cart", map[string]interface{}{
+		"trade_policy_id": data.TradePolicyID.ValueString(),
+		"items":           len(cart.Items),
+	})
+
+	simulation, err := d.client.SimulateCart(ctx, data.TradePolicyID.ValueString(), cart)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Simulating VTEX Cart",
+			"Could not simulate cart, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(data.TradePolicyID.ValueString())
+
+	data.Results = make([]VtexPriceSimulationResultModel, 0, len(simulation.Items))
+	for _, item := range simulation.Items {
+		data.Results = append(data.Results, VtexPriceSimulationResultModel{
+			SKUID:        types.StringValue(item.ID),
+			Seller:       types.StringValue(item.Seller),
+			Quantity:     types.Int64Value(item.Quantity),
+			Price:        types.Float64Value(centsToAmount(item.Price)),
+			ListPrice:    types.Float64Value(centsToAmount(item.ListPrice)),
+			SellingPrice: types.Float64Value(centsToAmount(item.SellingPrice)),
+			Availability: types.StringValue(item.Availability),
+		})
+	}
+
+	var total int64
+	totals := make(map[string]attr.Value, len(simulation.Totals))
+	for _, value := range simulation.Totals {
+		totals[value.ID] = types.Float64Value(centsToAmount(value.Value))
+		total += value.Value
+	}
+	totalsMap, diags := types.MapValue(types.Float64Type, totals)
+	resp.Diagnostics.Append(diags...)
+	data.Totals = totalsMap
+	data.Total = types.Float64Value(centsToAmount(total))
+
+	data.DeliveryOptions = make([]VtexPriceSimulationOptionModel, 0)
+	for _, info := range simulation.LogisticsInfo {
+		for _, sla := range info.SLAs {
+			data.DeliveryOptions = append(data.DeliveryOptions, VtexPriceSimulationOptionModel{
+				ItemIndex:        types.Int64Value(info.ItemIndex),
+				ID:               types.StringValue(sla.ID),
+				Name:             types.StringValue(sla.Name),
+				Price:            types.Float64Value(centsToAmount(sla.Price)),
+				ShippingEstimate: types.StringValue(sla.ShippingEstimate),
+				DeliveryChannel:  types.StringValue(sla.DeliveryChannel),
+			})
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// centsToAmount converts a checkout value in cents into a currency amount
+func centsToAmount(cents int64) float64 {
+	return float64(cents) / 100
+}
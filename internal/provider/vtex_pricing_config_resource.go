@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexPricingConfigResource{}
+var _ resource.ResourceWithImportState = &VtexPricingConfigResource{}
+
+// pricingConfigID is the ID of the only pricing configuration of an account
+const pricingConfigID = "pricing"
+
+// Rounding modes of the pricing configuration
+var pricingRoundingModes = []string{"none", "up", "down", "nearest"}
+
+func NewVtexPricingConfigResource() resource.Resource {
+	return &VtexPricingConfigResource{}
+}
+
+// VtexPricingConfigResource is the resource implementation
+type VtexPricingConfigResource struct {
+	client *client.VtexClient
+}
+
+// VtexPricingConfigResourceModel is the resource data model
+type VtexPricingConfigResourceModel struct {
+	ID                       types.String  `tfsdk:"id"`
+	PricingVersion           types.String  `tfsdk:"pricing_version"`
+	DefaultMarkup            types.Int64   `tfsdk:"default_markup"`
+	MinimumMarkup            types.Int64   `tfsdk:"minimum_markup"`
+	MinimumMarkupProtection  types.Bool    `tfsdk:"minimum_markup_protection"`
+	RoundingMode             types.String  `tfsdk:"rounding_mode"`
+	PriceVariationUpperLimit types.Float64 `tfsdk:"price_variation_upper_limit"`
+	PriceVariationLowerLimit types.Float64 `tfsdk:"price_variation_lower_limit"`
+}
+
+func (r *VtexPricingConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pricing_config"
+}
+
+func (r *VtexPricingConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the pricing configuration of the account. There is one per account; arguments that are not set keep their current value.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always \"" + pricingConfigID + "\"",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pricing_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the Pricing module used by the account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_markup": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Markup percentage used for SKUs whose price has no markup",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"minimum_markup": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Lowest markup percentage a price may have",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"minimum_markup_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether prices below minimum_markup are rejected",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rounding_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How computed prices are rounded: none, up, down or nearest",
+				Validators: []validator.String{
+					stringOneOfValidator{values: pricingRoundingModes},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"price_variation_upper_limit": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Largest price increase, in percent, allowed in one update (0 for no limit)",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"price_variation_lower_limit": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Largest price decrease, in percent, allowed in one update (0 for no limit)",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexPricingConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexPricingConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexPricingConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX pricing configuration")
+
+	config, err := r.updateConfig(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Pricing Configuration",
+			"Could not update pricing configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	pricingConfigToModel(config, &data)
+
+	tflog.Trace(ctx, "Created VTEX pricing configuration")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPricingConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexPricingConfigResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX pricing configuration")
+
+	config, err := r.client.GetPricingConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Pricing Configuration",
+			"Could not read pricing configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	pricingConfigToModel(config, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPricingConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexPricingConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX pricing configuration")
+
+	config, err := r.updateConfig(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Pricing Configuration",
+			"Could not update pricing configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	pricingConfigToModel(config, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexPricingConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The pricing configuration cannot be deleted, so it is only removed from state
+	tflog.Debug(ctx, "Removing VTEX pricing configuration from state, the account keeps its configuration")
+}
+
+func (r *VtexPricingConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: "pricing"
+	if req.ID != pricingConfigID {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("expected %q, got: %q", pricingConfigID, req.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateConfig applies the configured arguments over the current configuration
+// and reads the result back
+func (r *VtexPricingConfigResource) updateConfig(ctx context.Context, data VtexPricingConfigResourceModel) (*client.PricingConfig, error) {
+	config, err := r.client.GetPricingConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if isKnown(data.DefaultMarkup) {
+		config.DefaultMarkup = data.DefaultMarkup.ValueInt64()
+	}
+	if isKnown(data.MinimumMarkup) {
+		config.MinimumMarkup = data.MinimumMarkup.ValueInt64()
+	}
+	if isKnown(data.MinimumMarkupProtection) {
+		config.HasMinimumMarkupProtection = data.MinimumMarkupProtection.ValueBool()
+	}
+	if isKnown(data.RoundingMode) {
+		config.RoundingMode = data.RoundingMode.ValueString()
+	}
+	if isKnown(data.PriceVariationUpperLimit) {
+		config.PriceVariation.UpperLimit = data.PriceVariationUpperLimit.ValueFloat64()
+	}
+	if isKnown(data.PriceVariationLowerLimit) {
+		config.PriceVariation.LowerLimit = data.PriceVariationLowerLimit.ValueFloat64()
+	}
+
+	// The version is changed by a migration of the account, not by this call
+	config.PricingVersion = ""
+
+	if err := r.client.UpdatePricingConfig(ctx, *config); err != nil {
+		return nil, err
+	}
+	return r.client.GetPricingConfig(ctx)
+}
+
+// isKnown reports whether a planned value is set, i.e. neither null nor unknown
+func isKnown(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// pricingConfigToModel copies the API pricing configuration into the resource model
+func pricingConfigToModel(config *client.PricingConfig, data *VtexPricingConfigResourceModel) {
+	data.ID = types.StringValue(pricingConfigID)
+	data.PricingVersion = types.StringValue(config.PricingVersion)
+	data.DefaultMarkup = types.Int64Value(config.DefaultMarkup)
+	data.MinimumMarkup = types.Int64Value(config.MinimumMarkup)
+	data.MinimumMarkupProtection = types.BoolValue(config.HasMinimumMarkupProtection)
+	data.RoundingMode = types.StringValue(config.RoundingMode)
+	data.PriceVariationUpperLimit = types.Float64Value(config.PriceVariation.UpperLimit)
+	data.PriceVariationLowerLimit = types.Float64Value(config.PriceVariation.LowerLimit)
+}
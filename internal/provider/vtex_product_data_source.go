@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexProductDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexProductDataSource{}
+
+func NewVtexProductDataSource() datasource.DataSource {
+	return &VtexProductDataSource{}
+}
+
+// VtexProductDataSource is the data source implementation
+type VtexProductDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexProductDataSourceModel is the data source data model
+type VtexProductDataSourceModel struct {
+	ID             types.String                    `tfsdk:"id"`
+	RefID          types.String                    `tfsdk:"ref_id"`
+	Slug           types.String                    `tfsdk:"slug"`
+	Name           types.String                    `tfsdk:"name"`
+	Title          types.String                    `tfsdk:"title"`
+	Description    types.String                    `tfsdk:"description"`
+	Active         types.Bool                      `tfsdk:"active"`
+	Visible        types.Bool                      `tfsdk:"visible"`
+	DepartmentID   types.String                    `tfsdk:"department_id"`
+	CategoryID     types.String                    `tfsdk:"category_id"`
+	CategoryName   types.String                    `tfsdk:"category_name"`
+	BrandID        types.String                    `tfsdk:"brand_id"`
+	BrandName      types.String                    `tfsdk:"brand_name"`
+	SKUs           []VtexProductSKUModel           `tfsdk:"skus"`
+	Specifications []VtexProductSpecificationModel `tfsdk:"specifications"`
+}
+
+// VtexProductSKUModel is a SKU of the product
+type VtexProductSKUModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	RefID  types.String `tfsdk:"ref_id"`
+	Active types.Bool   `tfsdk:"active"`
+}
+
+// VtexProductSpecificationModel is a specification of the product
+type VtexProductSpecificationModel struct {
+	FieldID types.String   `tfsdk:"field_id"`
+	Name    types.String   `tfsdk:"name"`
+	Values  []types.String `tfsdk:"values"`
+}
+
+func (d *VtexProductDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product"
+}
+
+func (d *VtexProductDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a catalog product by ID, reference code or slug, with its SKUs and specifications.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Product ID to look up. Conflicts with ref_id and slug",
+			},
+			"ref_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Product reference code to look up. Conflicts with id and slug",
+			},
+			"slug": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Text of the product URL to look up (link ID). Conflicts with id and ref_id",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Product name",
+			},
+			"title": schema.StringAttribute{
+				Computed:    true,
+				Description: "Page title of the product",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Product description",
+			},
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the product is active",
+			},
+			"visible": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the product is shown in the store",
+			},
+			"department_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the department of the product",
+			},
+			"category_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the category of the product",
+			},
+			"category_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the category of the product",
+			},
+			"brand_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the brand of the product",
+			},
+			"brand_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the brand of the product",
+			},
+			"skus": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "SKUs of the product",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "SKU ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "SKU name",
+						},
+						"ref_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "SKU reference code",
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the SKU is active",
+						},
+					},
+				},
+			},
+			"specifications": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Product specifications",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Specification field ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Specification field name",
+						},
+						"values": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Specification values",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexProductDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexProductDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexProductDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if data.ID.IsUnknown() || data.RefID.IsUnknown() || data.Slug.IsUnknown() {
+		return
+	}
+
+	set := 0
+	for _, value := range []types.String{data.ID, data.RefID, data.Slug} {
+		if !value.IsNull() {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Product Lookup",
+			"Exactly one of id, ref_id or slug must be set.",
+		)
+	}
+}
+
+func (d *VtexProductDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexProductDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX product", map[string]interface{}{
+		"id":     data.ID.ValueString(),
+		"ref_id": data.RefID.ValueString(),
+		"slug":   data.Slug.ValueString(),
+	})
+
+	var product *client.Product
+	var err error
+	lookup := path.Root("id")
+	switch {
+	case !data.RefID.IsNull():
+		lookup = path.Root("ref_id")
+		product, err = d.client.GetProductByRefID(ctx, data.RefID.ValueString())
+	case !data.Slug.IsNull():
+		lookup = path.Root("slug")
+		product, err = d.client.GetProductBySlug(ctx, data.Slug.ValueString())
+	default:
+		productID, parseErr := parseNumericID(data.ID.ValueString())
+		if parseErr != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid VTEX Product ID", parseErr.Error())
+			return
+		}
+		product, err = d.client.GetProduct(ctx, productID)
+	}
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			lookup,
+			"VTEX Product Not Found",
+			"No product matches the lookup in the catalog",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Product",
+			"Could not read product, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skus, err := d.client.ListProductSKUs(ctx, product.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Product",
+			"Could not list product SKUs, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	specifications, err := d.client.ListProductSpecifications(ctx, product.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Product",
+			"Could not list product specifications, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	category, err := d.client.GetCategory(ctx, product.CategoryID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Product",
+			"Could not read product category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	brand, err := d.client.GetBrand(ctx, product.BrandID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Product",
+			"Could not read product brand, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(product.ID, 10))
+	data.RefID = types.StringValue(product.RefID)
+	data.Slug = types.StringValue(product.LinkID)
+	data.Name = types.StringValue(product.Name)
+	data.Title = types.StringValue(product.Title)
+	data.Description = types.StringValue(product.Description)
+	data.Active = types.BoolValue(product.IsActive)
+	data.Visible = types.BoolValue(product.IsVisible)
+	data.DepartmentID = types.StringValue(strconv.FormatInt(product.DepartmentID, 10))
+	data.CategoryID = types.StringValue(strconv.FormatInt(product.CategoryID, 10))
+	data.CategoryName = types.StringValue(category.Name)
+	data.BrandID = types.StringValue(strconv.FormatInt(product.BrandID, 10))
+	data.BrandName = types.StringValue(brand.Name)
+
+	data.SKUs = make([]VtexProductSKUModel, 0, len(skus))
+	for _, sku := range skus {
+		data.SKUs = append(data.SKUs, VtexProductSKUModel{
+			ID:     types.StringValue(strconv.FormatInt(sku.ID, 10)),
+			Name:   types.StringValue(sku.Name),
+			RefID:  types.StringValue(sku.RefID),
+			Active: types.BoolValue(sku.IsActive),
+		})
+	}
+
+	data.Specifications = make([]VtexProductSpecificationModel, 0, len(specifications))
+	for _, specification := range specifications {
+		values := make([]types.String, 0, len(specification.Value))
+		for _, value := range specification.Value {
+			values = append(values, types.StringValue(value))
+		}
+		data.Specifications = append(data.Specifications, VtexProductSpecificationModel{
+			FieldID: types.StringValue(strconv.FormatInt(specification.FieldID, 10)),
+			Name:    types.StringValue(specification.Name),
+			Values:  values,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexProductSpecificationResource{}
+var _ resource.ResourceWithImportState = &VtexProductSpecificationResource{}
+var _ resource.ResourceWithValidateConfig = &VtexProductSpecificationResource{}
+
+// Owner kinds used in vtex_product_specification IDs
+const (
+	specificationOwnerProduct = "product"
+	specificationOwnerSKU     = "sku"
+)
+
+func NewVtexProductSpecificationResource() resource.Resource {
+	return &VtexProductSpecificationResource{}
+}
+
+// VtexProductSpecificationResource is the resource implementation
+type VtexProductSpecificationResource struct {
+	client *client.VtexClient
+}
+
+// VtexProductSpecificationResourceModel is the resource data model
+type VtexProductSpecificationResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProductID    types.String `tfsdk:"product_id"`
+	SKUID        types.String `tfsdk:"sku_id"`
+	FieldID      types.String `tfsdk:"field_id"`
+	FieldValueID types.Int64  `tfsdk:"field_value_id"`
+	Text         types.String `tfsdk:"text"`
+}
+
+func (r *VtexProductSpecificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_specification"
+}
+
+func (r *VtexProductSpecificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Associates a specification value (or free text) with a product or SKU. Any change replaces the association.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier in the format product:product_id:value_id or sku:sku_id:value_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Product ID. Conflicts with sku_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sku_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "SKU ID, for SKU specifications. Conflicts with product_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Specification field ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_value_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Predefined value ID, for Combo, Radio and Checkbox fields. Conflicts with text",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"text": schema.StringAttribute{
+				Optional:    true,
+				Description: "Free text value, for Text and Number fields. Conflicts with field_value_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexProductSpecificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexProductSpecificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexProductSpecificationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if !data.ProductID.IsUnknown() && !data.SKUID.IsUnknown() && data.ProductID.IsNull() == data.SKUID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("product_id"),
+			"Invalid Specification Owner",
+			"Exactly one of product_id or sku_id must be set.",
+		)
+	}
+
+	if !data.FieldValueID.IsUnknown() && !data.Text.IsUnknown() && data.FieldValueID.IsNull() == data.Text.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("text"),
+			"Invalid Specification Value",
+			"Exactly one of field_value_id or text must be set.",
+		)
+	}
+}
+
+func (r *VtexProductSpecificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexProductSpecificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kind, ownerID, err := specificationOwner(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Specification Owner", err.Error())
+		return
+	}
+
+	fieldID, err := parseNumericID(data.FieldID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("field_id"), "Invalid Specification Field ID", err.Error())
+		return
+	}
+
+	value := client.SpecificationValue{
+		FieldID: fieldID,
+		Text:    data.Text.ValueString(),
+	}
+	if !data.FieldValueID.IsNull() {
+		fieldValueID := data.FieldValueID.ValueInt64()
+		value.FieldValueID = &fieldValueID
+	}
+
+	tflog.Debug(ctx, "Creating VTEX product specification", map[string]interface{}{
+		"owner":    kind,
+		"owner_id": ownerID,
+		"field_id": fieldID,
+	})
+
+	result, err := r.client.CreateSpecificationValue(ctx, kind == specificationOwnerSKU, ownerID, value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Product Specification",
+			"Could not associate specification, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(encodeID(kind, strconv.FormatInt(ownerID, 10), strconv.FormatInt(result.ID, 10)))
+
+	tflog.Trace(ctx, "Created VTEX product specification", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexProductSpecificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexProductSpecificationResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kind, ownerID, valueID, err := parseSpecificationValueID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Product Specification ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX product specification", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	values, err := r.client.ListSpecificationValues(ctx, kind == specificationOwnerSKU, ownerID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Product Specification",
+			"Could not read specifications, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var found *client.SpecificationValue
+	for i := range values {
+		if values[i].ID == valueID {
+			found = &values[i]
+			break
+		}
+	}
+
+	if found == nil {
+		tflog.Warn(ctx, "VTEX product specification not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	owner := types.StringValue(strconv.FormatInt(ownerID, 10))
+	if kind == specificationOwnerSKU {
+		data.SKUID = owner
+		data.ProductID = types.StringNull()
+	} else {
+		data.ProductID = owner
+		data.SKUID = types.StringNull()
+	}
+
+	data.FieldID = types.StringValue(strconv.FormatInt(found.FieldID, 10))
+	if found.FieldValueID != nil && *found.FieldValueID != 0 {
+		data.FieldValueID = types.Int64Value(*found.FieldValueID)
+		data.Text = types.StringNull()
+	} else {
+		data.FieldValueID = types.Int64Null()
+		data.Text = types.StringValue(found.Text)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexProductSpecificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All arguments force a new association, so there is nothing to update
+	var data VtexProductSpecificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexProductSpecificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexProductSpecificationResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kind, ownerID, valueID, err := parseSpecificationValueID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Product Specification ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX product specification", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.client.DeleteSpecificationValue(ctx, kind == specificationOwnerSKU, ownerID, valueID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Product Specification",
+			"Could not remove specification, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX product specification", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexProductSpecificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: product:product_id:value_id or sku:sku_id:value_id
+	if _, _, _, err := parseSpecificationValueID(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected product:product_id:value_id or sku:sku_id:value_id, "+err.Error(),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// specificationOwner returns the owner kind and ID of the association
+func specificationOwner(data VtexProductSpecificationResourceModel) (string, int64, error) {
+	if !data.SKUID.IsNull() {
+		id, err := parseNumericID(data.SKUID.ValueString())
+		return specificationOwnerSKU, id, err
+	}
+	id, err := parseNumericID(data.ProductID.ValueString())
+	return specificationOwnerProduct, id, err
+}
+
+// parseSpecificationValueID splits a vtex_product_specification ID
+func parseSpecificationValueID(id string) (string, int64, int64, error) {
+	parts, err := decodeID(id)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	if len(parts) != 3 || (parts[0] != specificationOwnerProduct && parts[0] != specificationOwnerSKU) {
+		return "", 0, 0, fmt.Errorf("got: %q", id)
+	}
+
+	ownerID, err := parseNumericID(parts[1])
+	if err != nil {
+		return "", 0, 0, err
+	}
+	valueID, err := parseNumericID(parts[2])
+	if err != nil {
+		return "", 0, 0, err
+	}
+	return parts[0], ownerID, valueID, nil
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexPromotionsDataSource{}
+
+func NewVtexPromotionsDataSource() datasource.DataSource {
+	return &VtexPromotionsDataSource{}
+}
+
+// VtexPromotionsDataSource is the data source implementation
+type VtexPromotionsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexPromotionsDataSourceModel is the data source data model
+type VtexPromotionsDataSourceModel struct {
+	ID               types.String             `tfsdk:"id"`
+	Active           types.Bool               `tfsdk:"active"`
+	Type             types.String             `tfsdk:"type"`
+	Name             types.String             `tfsdk:"name"`
+	Promotions       []VtexPromotionItemModel `tfsdk:"promotions"`
+	ExpiredActiveIDs []types.String           `tfsdk:"expired_active_ids"`
+}
+
+// VtexPromotionItemModel is a promotion in the list
+type VtexPromotionItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Active      types.Bool   `tfsdk:"active"`
+	BeginDate   types.String `tfsdk:"begin_date"`
+	EndDate     types.String `tfsdk:"end_date"`
+	Expired     types.Bool   `tfsdk:"expired"`
+	UtmSource   types.String `tfsdk:"utm_source"`
+	UtmCampaign types.String `tfsdk:"utm_campaign"`
+}
+
+func (d *VtexPromotionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_promotions"
+}
+
+func (d *VtexPromotionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the promotions of the account that are not archived, optionally filtered by status, type and name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Filters used, or \"all\"",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list active (true) or inactive (false) promotions",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list promotions of this type, e.g. regular or buyAndWin",
+				Validators: []validator.String{
+					stringOneOfValidator{values: client.PromotionTypes},
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list promotions whose name contains this text (case is ignored)",
+			},
+			"promotions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Promotions found, by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Promotion ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Promotion name",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Internal description of the promotion",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Promotion type",
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the promotion is active",
+						},
+						"begin_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Start of the promotion",
+						},
+						"end_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "End of the promotion, or null without an end",
+						},
+						"expired": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the end of the promotion is in the past",
+						},
+						"utm_source": schema.StringAttribute{
+							Computed:    true,
+							Description: "UTM source that triggers the promotion, or empty",
+						},
+						"utm_campaign": schema.StringAttribute{
+							Computed:    true,
+							Description: "UTM campaign that triggers the promotion, or empty",
+						},
+					},
+				},
+			},
+			"expired_active_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the listed promotions that are still active after their end",
+			},
+		},
+	}
+}
+
+func (d *VtexPromotionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexPromotionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexPromotionsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promotionType := data.Type.ValueString()
+	name := strings.ToLower(data.Name.ValueString())
+
+	tflog.Debug(ctx, "Listing VTEX promotions", map[string]interface{}{
+		"active": data.Active.String(),
+		"type":   promotionType,
+		"name":   name,
+	})
+
+	promotions, err := d.client.ListPromotions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Promotions",
+			"Could not list promotions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(promotions, func(i, j int) bool {
+		if promotions[i].Name != promotions[j].Name {
+			return promotions[i].Name < promotions[j].Name
+		}
+		return promotions[i].ID < promotions[j].ID
+	})
+
+	now := time.Now()
+	data.Promotions = make([]VtexPromotionItemModel, 0, len(promotions))
+	expiredActiveIDs := []string{}
+	for _, promotion := range promotions {
+		if promotion.IsArchived {
+			continue
+		}
+		if !data.Active.IsNull() && promotion.IsActive != data.Active.ValueBool() {
+			continue
+		}
+		if promotionType != "" && promotion.Type != promotionType {
+			continue
+		}
+		if name != "" && !strings.Contains(strings.ToLower(promotion.Name), name) {
+			continue
+		}
+
+		expired := promotionExpired(promotion, now)
+		if expired && promotion.IsActive {
+			expiredActiveIDs = append(expiredActiveIDs, promotion.ID)
+		}
+
+		data.Promotions = append(data.Promotions, VtexPromotionItemModel{
+			ID:          types.StringValue(promotion.ID),
+			Name:        types.StringValue(promotion.Name),
+			Description: types.StringValue(promotion.Description),
+			Type:        types.StringValue(promotion.Type),
+			Active:      types.BoolValue(promotion.IsActive),
+			BeginDate:   types.StringValue(promotion.BeginDateUTC),
+			EndDate:     dateTimeValue(promotion.EndDateUTC, types.StringNull()),
+			Expired:     types.BoolValue(expired),
+			UtmSource:   types.StringValue(promotion.UtmSource),
+			UtmCampaign: types.StringValue(promotion.UtmCampaign),
+		})
+	}
+	data.ExpiredActiveIDs = stringValues(expiredActiveIDs)
+
+	data.ID = types.StringValue("all")
+	if !data.Active.IsNull() || promotionType != "" || name != "" {
+		data.ID = types.StringValue(encodeID(data.Active.String(), promotionType, data.Name.ValueString()))
+	}
+
+	tflog.Trace(ctx, "Listed VTEX promotions", map[string]interface{}{
+		"count": len(data.Promotions),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// promotionExpired reports whether the promotion has an end and it is before now
+func promotionExpired(promotion client.Promotion, now time.Time) bool {
+	if promotion.EndDateUTC == "" {
+		return false
+	}
+	end, err := parseDateTime(promotion.EndDateUTC)
+	return err == nil && end.Before(now)
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexRoleDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexRoleDataSource{}
+
+func NewVtexRoleDataSource() datasource.DataSource {
+	return &VtexRoleDataSource{}
+}
+
+// VtexRoleDataSource is the data source implementation
+type VtexRoleDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexRoleDataSourceModel is the data source data model
+type VtexRoleDataSourceModel struct {
+	ID       types.String     `tfsdk:"id"`
+	Name     types.String     `tfsdk:"name"`
+	Grants   []VtexGrantModel `tfsdk:"grants"`
+	Products types.Set        `tfsdk:"products"`
+}
+
+func (d *VtexRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *VtexRoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a License Manager role by name or ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Role ID to look up. Conflicts with name",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Role name to look up. Conflicts with id",
+			},
+			"grants": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "License Manager resources granted by the role",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"product": schema.StringAttribute{
+							Computed:    true,
+							Description: "License Manager product",
+						},
+						"resource": schema.StringAttribute{
+							Computed:    true,
+							Description: "Resource key inside the product",
+						},
+					},
+				},
+			},
+			"products": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Products that the granted resources belong to",
+			},
+		},
+	}
+}
+
+func (d *VtexRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexRoleDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexRoleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Role Lookup",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+func (d *VtexRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexRoleDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Find the role ID by name first
+	var roleID int64
+	if !data.ID.IsNull() {
+		id, err := parseNumericID(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid VTEX Role ID", err.Error())
+			return
+		}
+		roleID = id
+	} else {
+		roles, err := d.client.ListRoles(ctx, "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX Role",
+				"Could not list roles, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		found := false
+		for _, role := range roles {
+			if role.Name == data.Name.ValueString() {
+				roleID = role.ID
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"VTEX Role Not Found",
+				fmt.Sprintf("No role named %q in the account", data.Name.ValueString()),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Reading VTEX role", map[string]interface{}{
+		"id": roleID,
+	})
+
+	role, err := d.client.GetRole(ctx, "", roleID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Role",
+			"Could not read role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(role.ID, 10))
+	data.Name = types.StringValue(role.Name)
+	data.Grants = grantsFromResources(role.Resources)
+	data.Products = grantProducts(data.Grants)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
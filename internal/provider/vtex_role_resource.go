@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexRoleResource{}
+var _ resource.ResourceWithImportState = &VtexRoleResource{}
+var _ resource.ResourceWithModifyPlan = &VtexRoleResource{}
+
+func NewVtexRoleResource() resource.Resource {
+	return &VtexRoleResource{}
+}
+
+// VtexRoleResource is the resource implementation
+type VtexRoleResource struct {
+	client *client.VtexClient
+}
+
+// VtexRoleResourceModel is the resource data model
+type VtexRoleResourceModel struct {
+	ID       types.String     `tfsdk:"id"`
+	Name     types.String     `tfsdk:"name"`
+	Grants   []VtexGrantModel `tfsdk:"grants"`
+	Products types.Set        `tfsdk:"products"`
+}
+
+// VtexGrantModel is one License Manager resource granted by a role
+type VtexGrantModel struct {
+	Product  types.String `tfsdk:"product"`
+	Resource types.String `tfsdk:"resource"`
+}
+
+func (r *VtexRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *VtexRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a License Manager custom role and the resources it grants.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "License Manager role ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Role name",
+				Validators: []validator.String{
+					stringLengthValidator{max: roleNameMaxLength},
+				},
+			},
+			"grants": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "License Manager resources granted by the role",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"product": schema.StringAttribute{
+							Required:    true,
+							Description: "License Manager product (e.g. Catalog, OMS)",
+						},
+						"resource": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource key inside the product",
+						},
+					},
+				},
+			},
+			"products": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Products that the granted resources belong to",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planGrants, stateGrants types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("grants"), &planGrants)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("grants"), &stateGrants)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The products come from the grants, they only change with them
+	if !planGrants.Equal(stateGrants) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("products"), types.SetUnknown(types.StringType))...)
+	}
+}
+
+func (r *VtexRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX role", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	role, err := r.client.CreateRole(ctx, "", roleRequestFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Role",
+			"Could not create role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(role.ID, 10))
+	data.Products = grantProducts(data.Grants)
+
+	tflog.Trace(ctx, "Created VTEX role", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexRoleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Role ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX role", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	role, err := r.client.GetRole(ctx, "", roleID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX role not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Role",
+			"Could not read role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Name = types.StringValue(role.Name)
+	data.Grants = grantsFromResources(role.Resources)
+	data.Products = grantProducts(data.Grants)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Role ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX role", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.client.UpdateRole(ctx, "", roleID, roleRequestFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Role",
+			"Could not update role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Products = grantProducts(data.Grants)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexRoleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Role ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX role", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.client.DeleteRole(ctx, "", roleID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Role",
+			"Could not delete role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX role", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric role ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// roleRequestFromModel builds the API payload from the resource model
+func roleRequestFromModel(data VtexRoleResourceModel) client.LicenseManagerRoleRequest {
+	resources := make([]client.LicenseManagerResource, 0, len(data.Grants))
+	for _, grant := range data.Grants {
+		resources = append(resources, client.LicenseManagerResource{
+			Product: grant.Product.ValueString(),
+			Key:     grant.Resource.ValueString(),
+		})
+	}
+	return client.LicenseManagerRoleRequest{
+		Name:      data.Name.ValueString(),
+		Resources: resources,
+	}
+}
+
+// grantsFromResources converts API resources into grant models
+func grantsFromResources(resources []client.LicenseManagerResource) []VtexGrantModel {
+	grants := make([]VtexGrantModel, 0, len(resources))
+	for _, res := range resources {
+		grants = append(grants, VtexGrantModel{
+			Product:  types.StringValue(res.Product),
+			Resource: types.StringValue(res.Key),
+		})
+	}
+	return grants
+}
+
+// grantProducts returns the distinct products of the grants
+func grantProducts(grants []VtexGrantModel) types.Set {
+	seen := make(map[string]bool)
+	var products []string
+	for _, grant := range grants {
+		product := grant.Product.ValueString()
+		if !seen[product] {
+			seen[product] = true
+			products = append(products, product)
+		}
+	}
+	sort.Strings(products)
+
+	values := make([]attr.Value, 0, len(products))
+	for _, product := range products {
+		values = append(values, types.StringValue(product))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
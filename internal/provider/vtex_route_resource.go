@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexRouteResource{}
+var _ resource.ResourceWithImportState = &VtexRouteResource{}
+
+// routePathPattern matches a storefront path, starting with / and without query string or fragment
+var routePathPattern = regexp.MustCompile(`^/[^\s?#]*$`)
+
+func NewVtexRouteResource() resource.Resource {
+	return &VtexRouteResource{}
+}
+
+// VtexRouteResource is the resource implementation
+type VtexRouteResource struct {
+	client *client.VtexClient
+}
+
+// VtexRouteResourceModel is the resource data model
+type VtexRouteResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Account    types.String `tfsdk:"account"`
+	Workspace  types.String `tfsdk:"workspace"`
+	Path       types.String `tfsdk:"path"`
+	Type       types.String `tfsdk:"type"`
+	ResourceID types.String `tfsdk:"resource_id"`
+	Declarer   types.String `tfsdk:"declarer"`
+	ResolveAs  types.String `tfsdk:"resolve_as"`
+	EndDate    types.String `tfsdk:"end_date"`
+}
+
+func (r *VtexRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route"
+}
+
+func (r *VtexRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an internal route of the store: a storefront path that renders a page or a product, brand or category.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account, workspace and path, as account:workspace:path",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account of the store (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(ioMasterWorkspace),
+				Description: "Workspace the route is saved in (default: master)",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: ioWorkspaceNamePattern, name: "workspace name", example: "master"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Storefront path of the route, e.g. /black-friday. Changing it creates a new route",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: routePathPattern, name: "storefront path", example: "/black-friday"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "What the path renders: userRoute for a page of the store theme, or product, brand, department, category or subcategory",
+			},
+			"resource_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of what the path renders: the page for userRoute (e.g. store.custom#black-friday), or the product, brand or category ID",
+			},
+			"declarer": schema.StringAttribute{
+				Optional:    true,
+				Description: "App that declares the page, e.g. vendor.store-theme@4.x",
+			},
+			"resolve_as": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path whose content the route shows, when it is not its own path",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: routePathPattern, name: "storefront path", example: "/sale"},
+				},
+			},
+			"end_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "When the route stops working, as an RFC 3339 date like 2024-11-29T00:00:00Z",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX route", map[string]interface{}{
+		"account":   data.Account.ValueString(),
+		"workspace": data.Workspace.ValueString(),
+		"path":      data.Path.ValueString(),
+		"type":      data.Type.ValueString(),
+	})
+
+	route, err := r.client.SaveInternalRoute(ctx, data.Account.ValueString(), data.Workspace.ValueString(), routeFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Route",
+			"Could not create route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	routeToModel(route, &data)
+
+	tflog.Trace(ctx, "Created VTEX route", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexRouteResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX route", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	route, err := r.client.GetInternalRoute(ctx, data.Account.ValueString(), data.Workspace.ValueString(), data.Path.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX route not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Route",
+			"Could not read route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	routeToModel(route, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX route", map[string]interface{}{
+		"id":   data.ID.ValueString(),
+		"type": data.Type.ValueString(),
+	})
+
+	route, err := r.client.SaveInternalRoute(ctx, data.Account.ValueString(), data.Workspace.ValueString(), routeFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Route",
+			"Could not update route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	routeToModel(route, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexRouteResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX route", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteInternalRoute(ctx, data.Account.ValueString(), data.Workspace.ValueString(), data.Path.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Route",
+			"Could not delete route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX route", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: account:workspace:path
+	parts, err := decodeID(req.ID)
+	if err == nil && len(parts) != 3 {
+		err = fmt.Errorf("expected account:workspace:path, got: %q", req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), parts[2])...)
+}
+
+// routeFromModel builds the API payload from the resource model
+func routeFromModel(data VtexRouteResourceModel) client.InternalRoute {
+	return client.InternalRoute{
+		From:      data.Path.ValueString(),
+		Type:      data.Type.ValueString(),
+		ID:        data.ResourceID.ValueString(),
+		Declarer:  data.Declarer.ValueString(),
+		ResolveAs: data.ResolveAs.ValueString(),
+		EndDate:   formatDateTimeRFC3339(data.EndDate.ValueString()),
+	}
+}
+
+// routeToModel copies an API route into the resource model
+func routeToModel(route *client.InternalRoute, data *VtexRouteResourceModel) {
+	data.ID = types.StringValue(encodeID(data.Account.ValueString(), data.Workspace.ValueString(), route.From))
+	data.Path = types.StringValue(route.From)
+	data.Type = types.StringValue(route.Type)
+	data.ResourceID = types.StringValue(route.ID)
+	data.Declarer = optionalString(route.Declarer, data.Declarer)
+	data.ResolveAs = optionalString(route.ResolveAs, data.ResolveAs)
+	data.EndDate = dateTimeValue(route.EndDate, data.EndDate)
+}
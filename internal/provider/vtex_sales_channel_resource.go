@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSalesChannelResource{}
+var _ resource.ResourceWithImportState = &VtexSalesChannelResource{}
+
+func NewVtexSalesChannelResource() resource.Resource {
+	return &VtexSalesChannelResource{}
+}
+
+// VtexSalesChannelResource is the resource implementation
+type VtexSalesChannelResource struct {
+	client *client.VtexClient
+}
+
+// VtexSalesChannelResourceModel is the resource data model
+type VtexSalesChannelResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Active                types.Bool   `tfsdk:"active"`
+	CountryCode           types.String `tfsdk:"country_code"`
+	CultureInfo           types.String `tfsdk:"culture_info"`
+	CurrencyCode          types.String `tfsdk:"currency_code"`
+	CurrencySymbol        types.String `tfsdk:"currency_symbol"`
+	CurrencyDecimalDigits types.Int64  `tfsdk:"currency_decimal_digits"`
+	TimeZone              types.String `tfsdk:"time_zone"`
+	ProductClusterID      types.String `tfsdk:"product_cluster_id"`
+}
+
+func (r *VtexSalesChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sales_channel"
+}
+
+func (r *VtexSalesChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a sales channel (trade policy), with the currency, country and culture of a storefront or marketplace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Sales channel ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Sales channel name",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the sales channel is active",
+			},
+			"country_code": schema.StringAttribute{
+				Required:    true,
+				Description: "Three-letter country code, e.g. BRA",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: countryCodePattern, name: "three-letter country code", example: "BRA"},
+				},
+			},
+			"culture_info": schema.StringAttribute{
+				Required:    true,
+				Description: "Language and region of the storefront, e.g. pt-BR",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: cultureInfoPattern, name: "culture", example: "pt-BR"},
+				},
+			},
+			"currency_code": schema.StringAttribute{
+				Required:    true,
+				Description: "ISO 4217 currency code, e.g. BRL",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: currencyCodePattern, name: "ISO 4217 currency code", example: "BRL"},
+				},
+			},
+			"currency_symbol": schema.StringAttribute{
+				Required:    true,
+				Description: "Currency symbol shown with prices, e.g. R$",
+			},
+			"currency_decimal_digits": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(2),
+				Description: "Number of decimal digits of amounts in the currency",
+			},
+			"time_zone": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Time zone of the sales channel, as a Windows time zone name like E. South America Standard Time (default: the account time zone)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"product_cluster_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the collection that limits the products sold in the sales channel (default: the whole catalog)",
+			},
+		},
+	}
+}
+
+func (r *VtexSalesChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSalesChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSalesChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var salesChannel client.SalesChannel
+	if err := salesChannelFromModel(data, &salesChannel); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("product_cluster_id"), "Invalid Collection ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX sales channel", map[string]interface{}{
+		"name": salesChannel.Name,
+	})
+
+	result, err := r.client.CreateSalesChannel(ctx, salesChannel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Sales Channel",
+			"Could not create sales channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	salesChannelToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX sales channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSalesChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSalesChannelResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	salesChannelID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Sales Channel ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX sales channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	salesChannel, err := r.client.GetSalesChannel(ctx, salesChannelID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX sales channel not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Sales Channel",
+			"Could not read sales channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	salesChannelToModel(salesChannel, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSalesChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexSalesChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	salesChannelID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Sales Channel ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX sales channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.updateSalesChannel(ctx, salesChannelID, func(current *client.SalesChannel) error {
+		return salesChannelFromModel(data, current)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Sales Channel",
+			"Could not update sales channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	salesChannelToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSalesChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSalesChannelResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	salesChannelID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Sales Channel ID", err.Error())
+		return
+	}
+
+	// The Catalog API cannot delete sales channels, so they are deactivated
+	tflog.Debug(ctx, "Deactivating VTEX sales channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err = r.updateSalesChannel(ctx, salesChannelID, func(current *client.SalesChannel) error {
+		current.IsActive = false
+		return nil
+	})
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Sales Channel",
+			"Could not deactivate sales channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deactivated VTEX sales channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSalesChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric sales channel ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateSalesChannel reads the sales channel, applies change and saves it, so the settings
+// the resource does not manage (currency format, origin, position) are kept
+func (r *VtexSalesChannelResource) updateSalesChannel(ctx context.Context, salesChannelID int64, change func(current *client.SalesChannel) error) (*client.SalesChannel, error) {
+	current, err := r.client.GetSalesChannel(ctx, salesChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(current); err != nil {
+		return nil, err
+	}
+	return r.client.UpdateSalesChannel(ctx, salesChannelID, *current)
+}
+
+// salesChannelFromModel applies the resource model over a sales channel
+func salesChannelFromModel(data VtexSalesChannelResourceModel, salesChannel *client.SalesChannel) error {
+	salesChannel.Name = data.Name.ValueString()
+	salesChannel.IsActive = data.Active.ValueBool()
+	salesChannel.CountryCode = data.CountryCode.ValueString()
+	salesChannel.CultureInfo = data.CultureInfo.ValueString()
+	salesChannel.CurrencyCode = data.CurrencyCode.ValueString()
+	salesChannel.CurrencySymbol = data.CurrencySymbol.ValueString()
+	salesChannel.CurrencyDecimalDigits = data.CurrencyDecimalDigits.ValueInt64()
+	if salesChannel.CurrencyFormatInfo != nil {
+		salesChannel.CurrencyFormatInfo.CurrencyDecimalDigits = data.CurrencyDecimalDigits.ValueInt64()
+	}
+	if isKnown(data.TimeZone) {
+		salesChannel.TimeZone = data.TimeZone.ValueString()
+	}
+
+	salesChannel.ProductClusterID = nil
+	if !data.ProductClusterID.IsNull() {
+		productClusterID, err := parseNumericID(data.ProductClusterID.ValueString())
+		if err != nil {
+			return err
+		}
+		salesChannel.ProductClusterID = &productClusterID
+	}
+	return nil
+}
+
+// salesChannelToModel copies an API sales channel into the resource model
+func salesChannelToModel(salesChannel *client.SalesChannel, data *VtexSalesChannelResourceModel) {
+	data.ID = types.StringValue(strconv.FormatInt(salesChannel.ID, 10))
+	data.Name = types.StringValue(salesChannel.Name)
+	data.Active = types.BoolValue(salesChannel.IsActive)
+	data.CountryCode = types.StringValue(salesChannel.CountryCode)
+	data.CultureInfo = types.StringValue(salesChannel.CultureInfo)
+	data.CurrencyCode = types.StringValue(salesChannel.CurrencyCode)
+	data.CurrencySymbol = types.StringValue(salesChannel.CurrencySymbol)
+	data.CurrencyDecimalDigits = types.Int64Value(salesChannel.CurrencyDecimalDigits)
+	data.TimeZone = types.StringValue(salesChannel.TimeZone)
+
+	data.ProductClusterID = types.StringNull()
+	if salesChannel.ProductClusterID != nil {
+		data.ProductClusterID = types.StringValue(strconv.FormatInt(*salesChannel.ProductClusterID, 10))
+	}
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexSalesChannelsDataSource{}
+
+func NewVtexSalesChannelsDataSource() datasource.DataSource {
+	return &VtexSalesChannelsDataSource{}
+}
+
+// VtexSalesChannelsDataSource is the data source implementation
+type VtexSalesChannelsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexSalesChannelsDataSourceModel is the data source data model
+type VtexSalesChannelsDataSourceModel struct {
+	ID            types.String                `tfsdk:"id"`
+	ActiveOnly    types.Bool                  `tfsdk:"active_only"`
+	SalesChannels []VtexSalesChannelItemModel `tfsdk:"sales_channels"`
+	IDs           map[string]types.String     `tfsdk:"ids"`
+}
+
+// VtexSalesChannelItemModel is a sales channel in the list
+type VtexSalesChannelItemModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Active           types.Bool   `tfsdk:"active"`
+	CountryCode      types.String `tfsdk:"country_code"`
+	CultureInfo      types.String `tfsdk:"culture_info"`
+	CurrencyCode     types.String `tfsdk:"currency_code"`
+	CurrencySymbol   types.String `tfsdk:"currency_symbol"`
+	TimeZone         types.String `tfsdk:"time_zone"`
+	ProductClusterID types.String `tfsdk:"product_cluster_id"`
+}
+
+func (d *VtexSalesChannelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sales_channels"
+}
+
+func (d *VtexSalesChannelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the sales channels (trade policies) of the account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "\"active\" when only active sales channels are listed, else \"all\"",
+			},
+			"active_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list active sales channels",
+			},
+			"sales_channels": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Sales channels found, by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Sales channel ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Sales channel name",
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the sales channel is active",
+						},
+						"country_code": schema.StringAttribute{
+							Computed:    true,
+							Description: "Three-letter country code",
+						},
+						"culture_info": schema.StringAttribute{
+							Computed:    true,
+							Description: "Language and region, e.g. pt-BR",
+						},
+						"currency_code": schema.StringAttribute{
+							Computed:    true,
+							Description: "ISO 4217 currency code",
+						},
+						"currency_symbol": schema.StringAttribute{
+							Computed:    true,
+							Description: "Currency symbol",
+						},
+						"time_zone": schema.StringAttribute{
+							Computed:    true,
+							Description: "Windows time zone name",
+						},
+						"product_cluster_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the collection that limits the catalog, or null",
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sales channel IDs by name. With duplicate names, the lowest ID wins",
+			},
+		},
+	}
+}
+
+func (d *VtexSalesChannelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexSalesChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexSalesChannelsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activeOnly := data.ActiveOnly.ValueBool()
+
+	tflog.Debug(ctx, "Listing VTEX sales channels", map[string]interface{}{
+		"active_only": activeOnly,
+	})
+
+	salesChannels, err := d.client.ListSalesChannels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Sales Channels",
+			"Could not list sales channels, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(salesChannels, func(i, j int) bool { return salesChannels[i].ID < salesChannels[j].ID })
+
+	data.SalesChannels = make([]VtexSalesChannelItemModel, 0, len(salesChannels))
+	data.IDs = make(map[string]types.String, len(salesChannels))
+	for _, salesChannel := range salesChannels {
+		if activeOnly && !salesChannel.IsActive {
+			continue
+		}
+
+		id := strconv.FormatInt(salesChannel.ID, 10)
+		productClusterID := types.StringNull()
+		if salesChannel.ProductClusterID != nil {
+			productClusterID = types.StringValue(strconv.FormatInt(*salesChannel.ProductClusterID, 10))
+		}
+
+		data.SalesChannels = append(data.SalesChannels, VtexSalesChannelItemModel{
+			ID:               types.StringValue(id),
+			Name:             types.StringValue(salesChannel.Name),
+			Active:           types.BoolValue(salesChannel.IsActive),
+			CountryCode:      types.StringValue(salesChannel.CountryCode),
+			CultureInfo:      types.StringValue(salesChannel.CultureInfo),
+			CurrencyCode:     types.StringValue(salesChannel.CurrencyCode),
+			CurrencySymbol:   types.StringValue(salesChannel.CurrencySymbol),
+			TimeZone:         types.StringValue(salesChannel.TimeZone),
+			ProductClusterID: productClusterID,
+		})
+		if _, ok := data.IDs[salesChannel.Name]; !ok {
+			data.IDs[salesChannel.Name] = types.StringValue(id)
+		}
+	}
+
+	data.ID = types.StringValue("all")
+	if activeOnly {
+		data.ID = types.StringValue("active")
+	}
+
+	tflog.Trace(ctx, "Listed VTEX sales channels", map[string]interface{}{
+		"count": len(data.SalesChannels),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,412 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSearchMerchandisingRuleResource{}
+var _ resource.ResourceWithImportState = &VtexSearchMerchandisingRuleResource{}
+var _ resource.ResourceWithValidateConfig = &VtexSearchMerchandisingRuleResource{}
+
+func NewVtexSearchMerchandisingRuleResource() resource.Resource {
+	return &VtexSearchMerchandisingRuleResource{}
+}
+
+// VtexSearchMerchandisingRuleResource is the resource implementation
+type VtexSearchMerchandisingRuleResource struct {
+	client *client.VtexClient
+}
+
+// VtexSearchMerchandisingRuleResourceModel is the resource data model
+type VtexSearchMerchandisingRuleResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Active             types.Bool   `tfsdk:"active"`
+	TriggerTerms       types.Set    `tfsdk:"trigger_terms"`
+	PromotedProductIDs types.List   `tfsdk:"promoted_product_ids"`
+	HiddenProductIDs   types.Set    `tfsdk:"hidden_product_ids"`
+	Filters            types.Map    `tfsdk:"filters"`
+	StartDate          types.String `tfsdk:"start_date"`
+	EndDate            types.String `tfsdk:"end_date"`
+}
+
+func (r *VtexSearchMerchandisingRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_merchandising_rule"
+}
+
+func (r *VtexSearchMerchandisingRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Intelligent Search merchandising rule: products promoted or hidden in the results of some search terms.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Merchandising rule ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Merchandising rule name",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the rule changes the search results (default: true)",
+			},
+			"trigger_terms": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Search terms the rule applies to",
+			},
+			"promoted_product_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Product IDs shown first in the results, in this order",
+			},
+			"hidden_product_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Product IDs left out of the results",
+			},
+			"filters": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Facet values the results are narrowed to, by facet key, e.g. { brand = \"acme\" }",
+			},
+			"start_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "When the rule starts to apply, as an RFC 3339 date like 2024-11-29T00:00:00Z",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+			"end_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "When the rule stops applying, as an RFC 3339 date like 2024-12-02T00:00:00Z",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexSearchMerchandisingRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSearchMerchandisingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexSearchMerchandisingRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if isKnown(data.TriggerTerms) {
+		var terms []types.String
+		resp.Diagnostics.Append(data.TriggerTerms.ElementsAs(ctx, &terms, false)...)
+		if len(terms) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("trigger_terms"), "Missing Trigger Terms", "The rule needs at least one search term.")
+		}
+		for _, term := range terms {
+			if isKnown(term) && term.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(path.Root("trigger_terms"), "Invalid Trigger Term", "Search terms cannot be empty.")
+			}
+		}
+	}
+
+	if isKnown(data.PromotedProductIDs) && isKnown(data.HiddenProductIDs) {
+		var promoted, hidden []types.String
+		resp.Diagnostics.Append(data.PromotedProductIDs.ElementsAs(ctx, &promoted, false)...)
+		resp.Diagnostics.Append(data.HiddenProductIDs.ElementsAs(ctx, &hidden, false)...)
+		for _, productID := range promoted {
+			for _, hiddenID := range hidden {
+				if isKnown(productID) && productID.Equal(hiddenID) {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("hidden_product_ids"),
+						"Conflicting Product IDs",
+						fmt.Sprintf("Product %q cannot be both promoted and hidden.", productID.ValueString()),
+					)
+				}
+			}
+		}
+	}
+
+	if isKnown(data.StartDate) && isKnown(data.EndDate) {
+		start, errStart := parseDateTime(data.StartDate.ValueString())
+		end, errEnd := parseDateTime(data.EndDate.ValueString())
+		if errStart == nil && errEnd == nil && !end.After(start) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_date"),
+				"Invalid Merchandising Rule Dates",
+				"end_date must be after start_date.",
+			)
+		}
+	}
+}
+
+func (r *VtexSearchMerchandisingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSearchMerchandisingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := merchandisingRuleFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX search merchandising rule", map[string]interface{}{
+		"name":  rule.Name,
+		"terms": len(rule.Terms),
+	})
+
+	created, err := r.client.CreateMerchandisingRule(ctx, rule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Search Merchandising Rule",
+			"Could not create search merchandising rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(merchandisingRuleToModel(ctx, created, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX search merchandising rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSearchMerchandisingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSearchMerchandisingRuleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX search merchandising rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	rule, err := r.client.GetMerchandisingRule(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX search merchandising rule not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Search Merchandising Rule",
+			"Could not read search merchandising rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(merchandisingRuleToModel(ctx, rule, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSearchMerchandisingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexSearchMerchandisingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := merchandisingRuleFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX search merchandising rule", map[string]interface{}{
+		"id":   data.ID.ValueString(),
+		"name": rule.Name,
+	})
+
+	updated, err := r.client.UpdateMerchandisingRule(ctx, data.ID.ValueString(), rule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Search Merchandising Rule",
+			"Could not update search merchandising rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(merchandisingRuleToModel(ctx, updated, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSearchMerchandisingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSearchMerchandisingRuleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX search merchandising rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteMerchandisingRule(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Search Merchandising Rule",
+			"Could not delete search merchandising rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX search merchandising rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSearchMerchandisingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: merchandising rule ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// merchandisingRuleFromModel builds the API payload from the resource model
+func merchandisingRuleFromModel(ctx context.Context, data VtexSearchMerchandisingRuleResourceModel) (client.MerchandisingRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rule := client.MerchandisingRule{
+		Name:           data.Name.ValueString(),
+		Active:         data.Active.ValueBool(),
+		Terms:          []string{},
+		PinnedProducts: []string{},
+		HiddenProducts: []string{},
+		Filters:        []client.MerchandisingRuleFilter{},
+		StartDate:      formatDateTimeRFC3339(data.StartDate.ValueString()),
+		EndDate:        formatDateTimeRFC3339(data.EndDate.ValueString()),
+	}
+
+	diags.Append(data.TriggerTerms.ElementsAs(ctx, &rule.Terms, false)...)
+	sort.Strings(rule.Terms)
+
+	if !data.PromotedProductIDs.IsNull() {
+		diags.Append(data.PromotedProductIDs.ElementsAs(ctx, &rule.PinnedProducts, false)...)
+	}
+
+	if !data.HiddenProductIDs.IsNull() {
+		diags.Append(data.HiddenProductIDs.ElementsAs(ctx, &rule.HiddenProducts, false)...)
+		sort.Strings(rule.HiddenProducts)
+	}
+
+	if !data.Filters.IsNull() {
+		filters := map[string]string{}
+		diags.Append(data.Filters.ElementsAs(ctx, &filters, false)...)
+		for key, value := range filters {
+			rule.Filters = append(rule.Filters, client.MerchandisingRuleFilter{Key: key, Value: value})
+		}
+		sort.Slice(rule.Filters, func(i, j int) bool { return rule.Filters[i].Key < rule.Filters[j].Key })
+	}
+
+	return rule, diags
+}
+
+// merchandisingRuleToModel copies an API merchandising rule into the resource model
+func merchandisingRuleToModel(ctx context.Context, rule *client.MerchandisingRule, data *VtexSearchMerchandisingRuleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(rule.ID)
+	data.Name = types.StringValue(rule.Name)
+	data.Active = types.BoolValue(rule.Active)
+	data.StartDate = dateTimeValue(rule.StartDate, data.StartDate)
+	data.EndDate = dateTimeValue(rule.EndDate, data.EndDate)
+
+	terms := rule.Terms
+	if terms == nil {
+		terms = []string{}
+	}
+	triggerTerms, setDiags := types.SetValueFrom(ctx, types.StringType, terms)
+	diags.Append(setDiags...)
+	data.TriggerTerms = triggerTerms
+
+	if len(rule.PinnedProducts) == 0 && data.PromotedProductIDs.IsNull() {
+		data.PromotedProductIDs = types.ListNull(types.StringType)
+	} else {
+		pinned := rule.PinnedProducts
+		if pinned == nil {
+			pinned = []string{}
+		}
+		list, listDiags := types.ListValueFrom(ctx, types.StringType, pinned)
+		diags.Append(listDiags...)
+		data.PromotedProductIDs = list
+	}
+
+	hidden, setDiags := optionalStringSet(ctx, rule.HiddenProducts, data.HiddenProductIDs)
+	diags.Append(setDiags...)
+	data.HiddenProductIDs = hidden
+
+	if len(rule.Filters) > 0 || !data.Filters.IsNull() {
+		filters := make(map[string]string, len(rule.Filters))
+		for _, filter := range rule.Filters {
+			filters[filter.Key] = filter.Value
+		}
+		filtersMap, mapDiags := types.MapValueFrom(ctx, types.StringType, filters)
+		diags.Append(mapDiags...)
+		data.Filters = filtersMap
+	}
+
+	return diags
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSearchRedirectResource{}
+var _ resource.ResourceWithImportState = &VtexSearchRedirectResource{}
+var _ resource.ResourceWithValidateConfig = &VtexSearchRedirectResource{}
+
+func NewVtexSearchRedirectResource() resource.Resource {
+	return &VtexSearchRedirectResource{}
+}
+
+// VtexSearchRedirectResource is the resource implementation
+type VtexSearchRedirectResource struct {
+	client *client.VtexClient
+}
+
+// VtexSearchRedirectResourceModel is the resource data model
+type VtexSearchRedirectResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Query  types.String `tfsdk:"query"`
+	URL    types.String `tfsdk:"url"`
+	Active types.Bool   `tfsdk:"active"`
+}
+
+func (r *VtexSearchRedirectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_redirect"
+}
+
+func (r *VtexSearchRedirectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Intelligent Search redirect: searches for a query open a page instead of the search results.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Redirect ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query": schema.StringAttribute{
+				Required:    true,
+				Description: "Search query that is redirected, e.g. black friday",
+			},
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: "Page the query opens: a storefront path like /black-friday or an http(s):// URL",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether searches for the query are redirected (default: true)",
+			},
+		},
+	}
+}
+
+func (r *VtexSearchRedirectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSearchRedirectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexSearchRedirectResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if isKnown(data.Query) && strings.TrimSpace(data.Query.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("query"), "Invalid Query", "The query cannot be empty.")
+	}
+
+	if isKnown(data.URL) && !strings.HasPrefix(data.URL.ValueString(), "/") {
+		if u, err := url.Parse(data.URL.ValueString()); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"Invalid Redirect URL",
+				fmt.Sprintf("Expected a storefront path starting with / or an http(s):// URL, got: %q", data.URL.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *VtexSearchRedirectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSearchRedirectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX search redirect", map[string]interface{}{
+		"query": data.Query.ValueString(),
+		"url":   data.URL.ValueString(),
+	})
+
+	redirect, err := r.client.CreateSearchRedirect(ctx, searchRedirectFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Search Redirect",
+			"Could not create search redirect, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	searchRedirectToModel(redirect, &data)
+
+	tflog.Trace(ctx, "Created VTEX search redirect", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSearchRedirectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSearchRedirectResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX search redirect", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	redirect, err := r.client.GetSearchRedirect(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX search redirect not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Search Redirect",
+			"Could not read search redirect, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	searchRedirectToModel(redirect, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSearchRedirectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexSearchRedirectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX search redirect", map[string]interface{}{
+		"id":    data.ID.ValueString(),
+		"query": data.Query.ValueString(),
+		"url":   data.URL.ValueString(),
+	})
+
+	redirect, err := r.client.UpdateSearchRedirect(ctx, data.ID.ValueString(), searchRedirectFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Search Redirect",
+			"Could not update search redirect, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	searchRedirectToModel(redirect, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSearchRedirectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSearchRedirectResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX search redirect", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteSearchRedirect(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Search Redirect",
+			"Could not delete search redirect, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX search redirect", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSearchRedirectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: redirect ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// searchRedirectFromModel builds the API payload from the resource model
+func searchRedirectFromModel(data VtexSearchRedirectResourceModel) client.SearchRedirect {
+	return client.SearchRedirect{
+		Query:  data.Query.ValueString(),
+		URL:    data.URL.ValueString(),
+		Active: data.Active.ValueBool(),
+	}
+}
+
+// searchRedirectToModel copies an API search redirect into the resource model
+func searchRedirectToModel(redirect *client.SearchRedirect, data *VtexSearchRedirectResourceModel) {
+	data.ID = types.StringValue(redirect.ID)
+	data.Query = types.StringValue(redirect.Query)
+	data.URL = types.StringValue(redirect.URL)
+	data.Active = types.BoolValue(redirect.Active)
+}
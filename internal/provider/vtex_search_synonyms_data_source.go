@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexSearchSynonymsDataSource{}
+
+func NewVtexSearchSynonymsDataSource() datasource.DataSource {
+	return &VtexSearchSynonymsDataSource{}
+}
+
+// VtexSearchSynonymsDataSource is the data source implementation
+type VtexSearchSynonymsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexSearchSynonymsDataSourceModel is the data source data model
+type VtexSearchSynonymsDataSourceModel struct {
+	ID               types.String                 `tfsdk:"id"`
+	Term             types.String                 `tfsdk:"term"`
+	Type             types.String                 `tfsdk:"type"`
+	Synonyms         []VtexSearchSynonymItemModel `tfsdk:"synonyms"`
+	ConflictingTerms []types.String               `tfsdk:"conflicting_terms"`
+}
+
+// VtexSearchSynonymItemModel is a synonym set in the list
+type VtexSearchSynonymItemModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Type     types.String   `tfsdk:"type"`
+	Term     types.String   `tfsdk:"term"`
+	Synonyms []types.String `tfsdk:"synonyms"`
+}
+
+func (d *VtexSearchSynonymsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_synonyms"
+}
+
+func (d *VtexSearchSynonymsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Intelligent Search synonyms, optionally only the ones with a term, to audit conflicting or outdated synonym sets.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Filters used, or \"all\"",
+			},
+			"term": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list synonym sets with a term containing this (case insensitive)",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list synonym sets of this type: bidirectional or unidirectional",
+				Validators: []validator.String{
+					stringOneOfValidator{values: []string{client.SearchSynonymBidirectional, client.SearchSynonymUnidirectional}},
+				},
+			},
+			"synonyms": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Synonym sets found, sorted by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Synonym set ID",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "bidirectional when all the terms are equivalent, unidirectional when only searches for term find the synonyms",
+						},
+						"term": schema.StringAttribute{
+							Computed:    true,
+							Description: "Main term of unidirectional synonyms, empty for bidirectional ones",
+						},
+						"synonyms": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Synonym terms",
+						},
+					},
+				},
+			},
+			"conflicting_terms": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Terms, in lower case and sorted, that are in more than one of the synonym sets found",
+			},
+		},
+	}
+}
+
+func (d *VtexSearchSynonymsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexSearchSynonymsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexSearchSynonymsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	term := strings.ToLower(data.Term.ValueString())
+	synonymType := data.Type.ValueString()
+
+	tflog.Debug(ctx, "Listing VTEX search synonyms", map[string]interface{}{
+		"term": term,
+		"type": synonymType,
+	})
+
+	synonyms, err := d.client.ListSearchSynonyms(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Search Synonyms",
+			"Could not list search synonyms, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(synonyms, func(i, j int) bool { return synonyms[i].ID < synonyms[j].ID })
+
+	data.Synonyms = make([]VtexSearchSynonymItemModel, 0, len(synonyms))
+	sets := map[string]int{}
+	for _, synonym := range synonyms {
+		if synonymType != "" && synonym.Type != synonymType {
+			continue
+		}
+
+		terms := synonym.Synonyms
+		if synonym.Term != "" {
+			terms = append([]string{synonym.Term}, terms...)
+		}
+		if term != "" && !slices.ContainsFunc(terms, func(t string) bool { return strings.Contains(strings.ToLower(t), term) }) {
+			continue
+		}
+
+		data.Synonyms = append(data.Synonyms, VtexSearchSynonymItemModel{
+			ID:       types.StringValue(synonym.ID),
+			Type:     types.StringValue(synonym.Type),
+			Term:     types.StringValue(synonym.Term),
+			Synonyms: stringValues(synonym.Synonyms),
+		})
+
+		// Each term counts once per set, whatever its case
+		seen := map[string]bool{}
+		for _, t := range terms {
+			t = strings.ToLower(strings.TrimSpace(t))
+			if !seen[t] {
+				seen[t] = true
+				sets[t]++
+			}
+		}
+	}
+
+	conflicting := []string{}
+	for t, count := range sets {
+		if count > 1 {
+			conflicting = append(conflicting, t)
+		}
+	}
+	sort.Strings(conflicting)
+	data.ConflictingTerms = stringValues(conflicting)
+
+	data.ID = types.StringValue("all")
+	if term != "" || synonymType != "" {
+		data.ID = types.StringValue(encodeID(term, synonymType))
+	}
+
+	tflog.Trace(ctx, "Listed VTEX search synonyms", map[string]interface{}{
+		"count":       len(data.Synonyms),
+		"conflicting": len(conflicting),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,663 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSellerPortalProductResource{}
+var _ resource.ResourceWithImportState = &VtexSellerPortalProductResource{}
+var _ resource.ResourceWithValidateConfig = &VtexSellerPortalProductResource{}
+
+// Seller Portal product status values
+const (
+	sellerPortalStatusActive   = "active"
+	sellerPortalStatusInactive = "inactive"
+)
+
+func NewVtexSellerPortalProductResource() resource.Resource {
+	return &VtexSellerPortalProductResource{}
+}
+
+// VtexSellerPortalProductResource is the resource implementation
+type VtexSellerPortalProductResource struct {
+	client *client.VtexClient
+}
+
+// VtexSellerPortalProductResourceModel is the resource data model
+type VtexSellerPortalProductResourceModel struct {
+	ID          types.String                     `tfsdk:"id"`
+	ExternalID  types.String                     `tfsdk:"external_id"`
+	Name        types.String                     `tfsdk:"name"`
+	BrandID     types.String                     `tfsdk:"brand_id"`
+	CategoryIDs []types.String                   `tfsdk:"category_ids"`
+	Active      types.Bool                       `tfsdk:"active"`
+	Slug        types.String                     `tfsdk:"slug"`
+	Specs       []VtexSellerPortalSpecModel      `tfsdk:"specs"`
+	Attributes  []VtexSellerPortalAttributeModel `tfsdk:"attributes"`
+	Images      []VtexSellerPortalImageModel     `tfsdk:"images"`
+	SKUs        []VtexSellerPortalSKUModel       `tfsdk:"skus"`
+	SKUIDs      types.Map                        `tfsdk:"sku_ids"`
+}
+
+// VtexSellerPortalSpecModel is a product specification with its allowed SKU values
+type VtexSellerPortalSpecModel struct {
+	Name   types.String   `tfsdk:"name"`
+	Values []types.String `tfsdk:"values"`
+}
+
+// VtexSellerPortalAttributeModel is a free text product attribute
+type VtexSellerPortalAttributeModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+// VtexSellerPortalImageModel is a product image
+type VtexSellerPortalImageModel struct {
+	ID  types.String `tfsdk:"id"`
+	URL types.String `tfsdk:"url"`
+	Alt types.String `tfsdk:"alt"`
+}
+
+// VtexSellerPortalSKUModel is a SKU of the product
+type VtexSellerPortalSKUModel struct {
+	ExternalID types.String  `tfsdk:"external_id"`
+	Name       types.String  `tfsdk:"name"`
+	EAN        types.String  `tfsdk:"ean"`
+	Active     types.Bool    `tfsdk:"active"`
+	Weight     types.Float64 `tfsdk:"weight"`
+	Width      types.Float64 `tfsdk:"width"`
+	Height     types.Float64 `tfsdk:"height"`
+	Length     types.Float64 `tfsdk:"length"`
+	Specs      types.Map     `tfsdk:"specs"`
+	Images     types.List    `tfsdk:"images"`
+}
+
+func (r *VtexSellerPortalProductResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_seller_portal_product"
+}
+
+func (r *VtexSellerPortalProductResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a product and its SKUs in the Seller Portal catalog (Catalog API v2).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Seller Portal product ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Product ID in the seller's own system",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Product name",
+			},
+			"brand_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the product brand",
+			},
+			"category_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the category path of the product, from the department to the leaf category",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the product is active",
+			},
+			"slug": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Text of the product URL (default: generated from the name)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"specs": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Specifications that tell the SKUs apart (e.g. Color), with their allowed values",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Specification name",
+						},
+						"values": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "Allowed values",
+						},
+					},
+				},
+			},
+			"attributes": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Free text attributes shown on the product page",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Attribute name",
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "Attribute value",
+						},
+					},
+				},
+			},
+			"images": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Product images, referenced by ID from the SKUs",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "Image ID inside the product",
+						},
+						"url": schema.StringAttribute{
+							Required:    true,
+							Description: "Public URL of the image",
+						},
+						"alt": schema.StringAttribute{
+							Optional:    true,
+							Description: "Alternative text",
+						},
+					},
+				},
+			},
+			"skus": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "SKUs of the product",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Required:    true,
+							Description: "SKU ID in the seller's own system, unique in the product",
+						},
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "SKU name",
+						},
+						"ean": schema.StringAttribute{
+							Optional:    true,
+							Description: "EAN/GTIN barcode",
+						},
+						"active": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether the SKU is active (default true)",
+						},
+						"weight": schema.Float64Attribute{
+							Required:    true,
+							Description: "Package weight in grams",
+						},
+						"width": schema.Float64Attribute{
+							Required:    true,
+							Description: "Package width in centimeters",
+						},
+						"height": schema.Float64Attribute{
+							Required:    true,
+							Description: "Package height in centimeters",
+						},
+						"length": schema.Float64Attribute{
+							Required:    true,
+							Description: "Package length in centimeters",
+						},
+						"specs": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Specification values of the SKU, by specification name",
+						},
+						"images": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the product images shown for the SKU",
+						},
+					},
+				},
+			},
+			"sku_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Seller Portal SKU IDs by SKU external_id",
+			},
+		},
+	}
+}
+
+func (r *VtexSellerPortalProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSellerPortalProductResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var skus []VtexSellerPortalSKUModel
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("skus"), &skus)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// SKUs are matched to their Seller Portal IDs by external_id
+	seen := make(map[string]bool, len(skus))
+	for i, sku := range skus {
+		if sku.ExternalID.IsUnknown() || sku.ExternalID.IsNull() {
+			continue
+		}
+		if seen[sku.ExternalID.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("skus").AtListIndex(i).AtName("external_id"),
+				"Duplicate SKU External ID",
+				fmt.Sprintf("SKU external_id %q is used more than once in the product.", sku.ExternalID.ValueString()),
+			)
+		}
+		seen[sku.ExternalID.ValueString()] = true
+	}
+}
+
+func (r *VtexSellerPortalProductResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSellerPortalProductResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	product, diags := sellerPortalProductFromModel(ctx, data, nil)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX Seller Portal product", map[string]interface{}{
+		"name": data.Name.ValueString(),
+		"skus": len(product.SKUs),
+	})
+
+	result, err := r.client.CreateSellerPortalProduct(ctx, product)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Seller Portal Product",
+			"Could not create Seller Portal product, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(sellerPortalProductToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX Seller Portal product", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSellerPortalProductResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSellerPortalProductResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX Seller Portal product", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	product, err := r.client.GetSellerPortalProduct(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX Seller Portal product not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Seller Portal Product",
+			"Could not read Seller Portal product, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(sellerPortalProductToModel(ctx, product, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSellerPortalProductResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexSellerPortalProductResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Existing SKUs keep their Seller Portal IDs, so they are updated instead of recreated
+	skuIDs := make(map[string]string)
+	resp.Diagnostics.Append(state.SKUIDs.ElementsAs(ctx, &skuIDs, false)...)
+
+	product, diags := sellerPortalProductFromModel(ctx, plan, skuIDs)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX Seller Portal product", map[string]interface{}{
+		"id":   state.ID.ValueString(),
+		"skus": len(product.SKUs),
+	})
+
+	result, err := r.client.UpdateSellerPortalProduct(ctx, state.ID.ValueString(), product)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Seller Portal Product",
+			"Could not update Seller Portal product, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(sellerPortalProductToModel(ctx, result, &plan)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexSellerPortalProductResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSellerPortalProductResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX Seller Portal product", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// The Seller Portal catalog cannot delete products, so they are deactivated
+	product, err := r.client.GetSellerPortalProduct(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Seller Portal Product",
+			"Could not read Seller Portal product, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	product.Status = sellerPortalStatusInactive
+	for i := range product.SKUs {
+		product.SKUs[i].IsActive = false
+	}
+
+	_, err = r.client.UpdateSellerPortalProduct(ctx, data.ID.ValueString(), *product)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Seller Portal Product",
+			"Could not deactivate Seller Portal product, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX Seller Portal product", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSellerPortalProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: Seller Portal product ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// sellerPortalProductFromModel builds the API payload from the resource model.
+// skuIDs maps SKU external IDs to the Seller Portal IDs already assigned.
+func sellerPortalProductFromModel(ctx context.Context, data VtexSellerPortalProductResourceModel, skuIDs map[string]string) (client.SellerPortalProduct, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	product := client.SellerPortalProduct{
+		ExternalID:  data.ExternalID.ValueString(),
+		Status:      sellerPortalStatusActive,
+		Name:        data.Name.ValueString(),
+		BrandID:     data.BrandID.ValueString(),
+		CategoryIDs: make([]string, 0, len(data.CategoryIDs)),
+		Slug:        data.Slug.ValueString(),
+		Specs:       make([]client.SellerPortalSpec, 0, len(data.Specs)),
+		Attributes:  make([]client.SellerPortalAttribute, 0, len(data.Attributes)),
+		Images:      make([]client.SellerPortalImage, 0, len(data.Images)),
+		SKUs:        make([]client.SellerPortalSKU, 0, len(data.SKUs)),
+	}
+	if !data.Active.ValueBool() {
+		product.Status = sellerPortalStatusInactive
+	}
+	for _, id := range data.CategoryIDs {
+		product.CategoryIDs = append(product.CategoryIDs, id.ValueString())
+	}
+	for _, spec := range data.Specs {
+		values := make([]string, 0, len(spec.Values))
+		for _, value := range spec.Values {
+			values = append(values, value.ValueString())
+		}
+		product.Specs = append(product.Specs, client.SellerPortalSpec{Name: spec.Name.ValueString(), Values: values})
+	}
+	for _, attribute := range data.Attributes {
+		product.Attributes = append(product.Attributes, client.SellerPortalAttribute{
+			Name:  attribute.Name.ValueString(),
+			Value: attribute.Value.ValueString(),
+		})
+	}
+	for _, image := range data.Images {
+		product.Images = append(product.Images, client.SellerPortalImage{
+			ID:  image.ID.ValueString(),
+			URL: image.URL.ValueString(),
+			Alt: image.Alt.ValueString(),
+		})
+	}
+
+	for _, sku := range data.SKUs {
+		specs := make(map[string]string)
+		diags.Append(sku.Specs.ElementsAs(ctx, &specs, false)...)
+		images := make([]string, 0)
+		diags.Append(sku.Images.ElementsAs(ctx, &images, false)...)
+
+		// Map iteration order is random, send the specs sorted so payloads are stable
+		names := make([]string, 0, len(specs))
+		for name := range specs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		skuSpecs := make([]client.SellerPortalSKUSpec, 0, len(names))
+		for _, name := range names {
+			skuSpecs = append(skuSpecs, client.SellerPortalSKUSpec{Name: name, Value: specs[name]})
+		}
+
+		product.SKUs = append(product.SKUs, client.SellerPortalSKU{
+			ID:         skuIDs[sku.ExternalID.ValueString()],
+			ExternalID: sku.ExternalID.ValueString(),
+			Name:       sku.Name.ValueString(),
+			EAN:        sku.EAN.ValueString(),
+			IsActive:   sku.Active.IsNull() || sku.Active.ValueBool(),
+			Weight:     sku.Weight.ValueFloat64(),
+			Dimensions: client.SellerPortalDimensions{
+				Width:  sku.Width.ValueFloat64(),
+				Height: sku.Height.ValueFloat64(),
+				Length: sku.Length.ValueFloat64(),
+			},
+			Specs:  skuSpecs,
+			Images: images,
+		})
+	}
+	return product, diags
+}
+
+// sellerPortalProductToModel copies an API product into the resource model.
+// Optional values the API returns empty stay null when they are null in the model,
+// so unset arguments do not show as a diff.
+func sellerPortalProductToModel(ctx context.Context, product *client.SellerPortalProduct, data *VtexSellerPortalProductResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(product.ID)
+	data.ExternalID = optionalString(product.ExternalID, data.ExternalID)
+	data.Name = types.StringValue(product.Name)
+	data.BrandID = types.StringValue(product.BrandID)
+	data.Active = types.BoolValue(product.Status != sellerPortalStatusInactive)
+	data.Slug = types.StringValue(product.Slug)
+
+	data.CategoryIDs = make([]types.String, 0, len(product.CategoryIDs))
+	for _, id := range product.CategoryIDs {
+		data.CategoryIDs = append(data.CategoryIDs, types.StringValue(id))
+	}
+
+	if len(product.Specs) > 0 || data.Specs != nil {
+		data.Specs = make([]VtexSellerPortalSpecModel, 0, len(product.Specs))
+		for _, spec := range product.Specs {
+			values := make([]types.String, 0, len(spec.Values))
+			for _, value := range spec.Values {
+				values = append(values, types.StringValue(value))
+			}
+			data.Specs = append(data.Specs, VtexSellerPortalSpecModel{Name: types.StringValue(spec.Name), Values: values})
+		}
+	}
+
+	if len(product.Attributes) > 0 || data.Attributes != nil {
+		data.Attributes = make([]VtexSellerPortalAttributeModel, 0, len(product.Attributes))
+		for _, attribute := range product.Attributes {
+			data.Attributes = append(data.Attributes, VtexSellerPortalAttributeModel{
+				Name:  types.StringValue(attribute.Name),
+				Value: types.StringValue(attribute.Value),
+			})
+		}
+	}
+
+	if len(product.Images) > 0 || data.Images != nil {
+		currentAlt := make(map[string]types.String, len(data.Images))
+		for _, image := range data.Images {
+			currentAlt[image.ID.ValueString()] = image.Alt
+		}
+		data.Images = make([]VtexSellerPortalImageModel, 0, len(product.Images))
+		for _, image := range product.Images {
+			alt, ok := currentAlt[image.ID]
+			if !ok {
+				alt = types.StringNull()
+			}
+			data.Images = append(data.Images, VtexSellerPortalImageModel{
+				ID:  types.StringValue(image.ID),
+				URL: types.StringValue(image.URL),
+				Alt: optionalString(image.Alt, alt),
+			})
+		}
+	}
+
+	current := make(map[string]VtexSellerPortalSKUModel, len(data.SKUs))
+	for _, sku := range data.SKUs {
+		current[sku.ExternalID.ValueString()] = sku
+	}
+
+	skuIDs := make(map[string]attr.Value, len(product.SKUs))
+	data.SKUs = make([]VtexSellerPortalSKUModel, 0, len(product.SKUs))
+	for _, sku := range product.SKUs {
+		skuIDs[sku.ExternalID] = types.StringValue(sku.ID)
+
+		// New SKUs, e.g. on import, start from null optional values
+		existing, ok := current[sku.ExternalID]
+		if !ok {
+			existing = VtexSellerPortalSKUModel{
+				EAN:    types.StringNull(),
+				Active: types.BoolNull(),
+				Specs:  types.MapNull(types.StringType),
+				Images: types.ListNull(types.StringType),
+			}
+		}
+
+		active := types.BoolValue(sku.IsActive)
+		if existing.Active.IsNull() && sku.IsActive {
+			active = types.BoolNull()
+		}
+
+		specs := existing.Specs
+		if len(sku.Specs) > 0 || !specs.IsNull() {
+			values := make(map[string]attr.Value, len(sku.Specs))
+			for _, spec := range sku.Specs {
+				values[spec.Name] = types.StringValue(spec.Value)
+			}
+			mapValue, mapDiags := types.MapValue(types.StringType, values)
+			diags.Append(mapDiags...)
+			specs = mapValue
+		}
+
+		images := existing.Images
+		if len(sku.Images) > 0 || !images.IsNull() {
+			listValue, listDiags := types.ListValueFrom(ctx, types.StringType, sku.Images)
+			diags.Append(listDiags...)
+			images = listValue
+		}
+
+		data.SKUs = append(data.SKUs, VtexSellerPortalSKUModel{
+			ExternalID: types.StringValue(sku.ExternalID),
+			Name:       types.StringValue(sku.Name),
+			EAN:        optionalString(sku.EAN, existing.EAN),
+			Active:     active,
+			Weight:     types.Float64Value(sku.Weight),
+			Width:      types.Float64Value(sku.Dimensions.Width),
+			Height:     types.Float64Value(sku.Dimensions.Height),
+			Length:     types.Float64Value(sku.Dimensions.Length),
+			Specs:      specs,
+			Images:     images,
+		})
+	}
+
+	ids, mapDiags := types.MapValue(types.StringType, skuIDs)
+	diags.Append(mapDiags...)
+	data.SKUIDs = ids
+	return diags
+}
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexShippingSimulationDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexShippingSimulationDataSource{}
+
+func NewVtexShippingSimulationDataSource() datasource.DataSource {
+	return &VtexShippingSimulationDataSource{}
+}
+
+// VtexShippingSimulationDataSource is the data source implementation
+type VtexShippingSimulationDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexShippingSimulationDataSourceModel is the data source data model
+type VtexShippingSimulationDataSourceModel struct {
+	ID              types.String                        `tfsdk:"id"`
+	SKUID           types.String                        `tfsdk:"sku_id"`
+	Quantity        types.Int64                         `tfsdk:"quantity"`
+	PostalCode      types.String                        `tfsdk:"postal_code"`
+	Country         types.String                        `tfsdk:"country"`
+	SalesChannel    types.String                        `tfsdk:"sales_channel"`
+	Availability    types.String                        `tfsdk:"availability"`
+	Available       types.Bool                          `tfsdk:"available"`
+	DeliveryOptions []VtexShippingSimulationOptionModel `tfsdk:"delivery_options"`
+}
+
+// VtexShippingSimulationOptionModel is a delivery option of the simulation
+type VtexShippingSimulationOptionModel struct {
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Price            types.Float64  `tfsdk:"price"`
+	ShippingEstimate types.String   `tfsdk:"shipping_estimate"`
+	DeliveryChannel  types.String   `tfsdk:"delivery_channel"`
+	PickupPointID    types.String   `tfsdk:"pickup_point_id"`
+	PickupPointName  types.String   `tfsdk:"pickup_point_name"`
+	WarehouseIDs     []types.String `tfsdk:"warehouse_ids"`
+	DockIDs          []types.String `tfsdk:"dock_ids"`
+}
+
+func (d *VtexShippingSimulationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shipping_simulation"
+}
+
+func (d *VtexShippingSimulationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Simulates the fulfillment of a SKU to a postal code and returns the delivery options Logistics offers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU, postal code, country and sales channel of the simulation",
+			},
+			"sku_id": schema.StringAttribute{
+				Required:    true,
+				Description: "SKU ID",
+			},
+			"quantity": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Quantity (default: 1)",
+			},
+			"postal_code": schema.StringAttribute{
+				Required:    true,
+				Description: "Postal code to deliver to",
+			},
+			"country": schema.StringAttribute{
+				Required:    true,
+				Description: "Three-letter country code of the postal code, e.g. BRA",
+			},
+			"sales_channel": schema.StringAttribute{
+				Optional:    true,
+				Description: "Trade policy ID to simulate in (default: the default trade policy)",
+			},
+			"availability": schema.StringAttribute{
+				Computed:    true,
+				Description: "Availability of the SKU, e.g. available or withoutStock",
+			},
+			"available": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the SKU can be delivered to or picked up near the postal code",
+			},
+			"delivery_options": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Delivery options (SLAs) for the postal code",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Delivery option ID, the shipping policy ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Delivery option name",
+						},
+						"price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Delivery price",
+						},
+						"shipping_estimate": schema.StringAttribute{
+							Computed:    true,
+							Description: "Delivery time, e.g. 3bd for three business days",
+						},
+						"delivery_channel": schema.StringAttribute{
+							Computed:    true,
+							Description: "delivery or pickup-in-point",
+						},
+						"pickup_point_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Pickup point ID of a pickup-in-point option, else empty",
+						},
+						"pickup_point_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Pickup point name of a pickup-in-point option, else empty",
+						},
+						"warehouse_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Warehouses the option ships from",
+						},
+						"dock_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Docks the option ships from",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexShippingSimulationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexShippingSimulationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexShippingSimulationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Quantity.IsNull() && !data.Quantity.IsUnknown() && data.Quantity.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("quantity"),
+			"Invalid Quantity",
+			fmt.Sprintf("Expected a quantity of at least 1, got: %d", data.Quantity.ValueInt64()),
+		)
+	}
+}
+
+func (d *VtexShippingSimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexShippingSimulationDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quantity := int64(1)
+	if !data.Quantity.IsNull() {
+		quantity = data.Quantity.ValueInt64()
+	}
+	salesChannel := data.SalesChannel.ValueString()
+
+	cart := client.SimulationRequest{
+		Items: []client.SimulationRequestItem{{
+			ID:       data.SKUID.ValueString(),
+			Quantity: quantity,
+			Seller:   defaultSellerID,
+		}},
+		PostalCode: data.PostalCode.ValueString(),
+		Country:    data.Country.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Simulating VTEX fulfillment", map[string]interface{}{
+		"sku_id":        data.SKUID.ValueString(),
+		"postal_code":   cart.PostalCode,
+		"sales_channel": salesChannel,
+	})
+
+	simulation, err := d.client.SimulateFulfillment(ctx, salesChannel, cart)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Simulating VTEX Fulfillment",
+			"Could not simulate fulfillment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(encodeID(data.SKUID.ValueString(), cart.PostalCode, cart.Country, salesChannel))
+
+	availability := ""
+	if len(simulation.Items) > 0 {
+		availability = simulation.Items[0].Availability
+	}
+	data.Availability = types.StringValue(availability)
+
+	data.DeliveryOptions = make([]VtexShippingSimulationOptionModel, 0)
+	for _, info := range simulation.LogisticsInfo {
+		for _, sla := range info.SLAs {
+			warehouseIDs := make([]string, 0, len(sla.DeliveryIDs))
+			dockIDs := make([]string, 0, len(sla.DeliveryIDs))
+			for _, delivery := range sla.DeliveryIDs {
+				warehouseIDs = append(warehouseIDs, delivery.WarehouseID)
+				dockIDs = append(dockIDs, delivery.DockID)
+			}
+
+			data.DeliveryOptions = append(data.DeliveryOptions, VtexShippingSimulationOptionModel{
+				ID:               types.StringValue(sla.ID),
+				Name:             types.StringValue(sla.Name),
+				Price:            types.Float64Value(centsToAmount(sla.Price)),
+				ShippingEstimate: types.StringValue(sla.ShippingEstimate),
+				DeliveryChannel:  types.StringValue(sla.DeliveryChannel),
+				PickupPointID:    types.StringValue(sla.PickupPointID),
+				PickupPointName:  types.StringValue(sla.PickupStoreInfo.FriendlyName),
+				WarehouseIDs:     stringValues(warehouseIDs),
+				DockIDs:          stringValues(dockIDs),
+			})
+		}
+	}
+	data.Available = types.BoolValue(len(data.DeliveryOptions) > 0)
+
+	tflog.Trace(ctx, "Simulated VTEX fulfillment", map[string]interface{}{
+		"delivery_options": len(data.DeliveryOptions),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
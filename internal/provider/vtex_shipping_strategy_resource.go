@@ -0,0 +1,591 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexShippingStrategyResource{}
+var _ resource.ResourceWithImportState = &VtexShippingStrategyResource{}
+var _ resource.ResourceWithValidateConfig = &VtexShippingStrategyResource{}
+
+func NewVtexShippingStrategyResource() resource.Resource {
+	return &VtexShippingStrategyResource{}
+}
+
+// VtexShippingStrategyResource is the resource implementation
+type VtexShippingStrategyResource struct {
+	client *client.VtexClient
+}
+
+// VtexShippingStrategyResourceModel is the resource data model
+type VtexShippingStrategyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	WarehouseID types.String `tfsdk:"warehouse_id"`
+	Name        types.String `tfsdk:"name"`
+	Active      types.Bool   `tfsdk:"active"`
+	Docks       types.List   `tfsdk:"docks"`
+}
+
+// VtexShippingStrategyDockModel is a dock of the warehouse with its shipping policies
+type VtexShippingStrategyDockModel struct {
+	DockID            types.String  `tfsdk:"dock_id"`
+	Name              types.String  `tfsdk:"name"`
+	Priority          types.Int64   `tfsdk:"priority"`
+	TimeOverhead      types.String  `tfsdk:"time_overhead"`
+	SalesChannels     types.Set     `tfsdk:"sales_channels"`
+	ShippingPolicyIDs types.Set     `tfsdk:"shipping_policy_ids"`
+	Time              types.String  `tfsdk:"time"`
+	Cost              types.Float64 `tfsdk:"cost"`
+}
+
+// shippingStrategyDockAttrTypes are the attribute types of a docks element
+var shippingStrategyDockAttrTypes = map[string]attr.Type{
+	"dock_id":             types.StringType,
+	"name":                types.StringType,
+	"priority":            types.Int64Type,
+	"time_overhead":       types.StringType,
+	"sales_channels":      types.SetType{ElemType: types.StringType},
+	"shipping_policy_ids": types.SetType{ElemType: types.StringType},
+	"time":                types.StringType,
+	"cost":                types.Float64Type,
+}
+
+func (r *VtexShippingStrategyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shipping_strategy"
+}
+
+func (r *VtexShippingStrategyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Logistics warehouse with its docks and their shipping policies, saving them in dependency order.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Warehouse ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"warehouse_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Warehouse ID, chosen by the account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Warehouse name",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the warehouse is active",
+			},
+			"docks": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Docks the orders of the warehouse leave from. The resource owns these docks",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dock_id": schema.StringAttribute{
+							Required:    true,
+							Description: "Dock ID, chosen by the account",
+						},
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Dock name",
+						},
+						"priority": schema.Int64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+							Description: "Priority of the dock when more than one can ship an order (lower first)",
+						},
+						"time_overhead": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("0s"),
+							Description: "Time added to the delivery estimate for orders to leave the dock, as a Go duration like 24h",
+							Validators: []validator.String{
+								durationValidator{},
+							},
+						},
+						"sales_channels": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the trade policies (sales channels) the dock serves",
+						},
+						"shipping_policy_ids": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the shipping policies (freight tables) the dock ships with. They must exist",
+						},
+						"time": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("0s"),
+							Description: "Time to move orders from the warehouse to the dock, as a Go duration",
+							Validators: []validator.String{
+								durationValidator{},
+							},
+						},
+						"cost": schema.Float64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     float64default.StaticFloat64(0),
+							Description: "Cost to move orders from the warehouse to the dock",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexShippingStrategyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexShippingStrategyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var docksList types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("docks"), &docksList)...)
+
+	// Unknown docks cannot be checked yet
+	if resp.Diagnostics.HasError() || docksList.IsNull() || docksList.IsUnknown() {
+		return
+	}
+
+	var docks []VtexShippingStrategyDockModel
+	resp.Diagnostics.Append(docksList.ElementsAs(ctx, &docks, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(docks) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("docks"),
+			"Missing Docks",
+			"At least one dock is required.",
+		)
+	}
+
+	seen := make(map[string]bool, len(docks))
+	for i, dock := range docks {
+		if dock.DockID.IsUnknown() {
+			continue
+		}
+		id := dock.DockID.ValueString()
+		if seen[id] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("docks").AtListIndex(i).AtName("dock_id"),
+				"Duplicate Dock",
+				fmt.Sprintf("Dock %q is listed more than once.", id),
+			)
+		}
+		seen[id] = true
+	}
+}
+
+func (r *VtexShippingStrategyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexShippingStrategyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warehouse, docks, diags := shippingStrategyFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX shipping strategy", map[string]interface{}{
+		"warehouse_id": warehouse.ID,
+		"docks":        len(docks),
+	})
+
+	result, resultDocks, err := r.saveShippingStrategy(ctx, warehouse, docks, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Shipping Strategy",
+			"Could not create shipping strategy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(shippingStrategyToModel(ctx, result, resultDocks, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX shipping strategy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexShippingStrategyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexShippingStrategyResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX shipping strategy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	warehouse, err := r.client.GetWarehouse(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX shipping strategy not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err == nil {
+		var docks map[string]*client.Dock
+		docks, err = r.readDocks(ctx, warehouse)
+		if err == nil {
+			resp.Diagnostics.Append(shippingStrategyToModel(ctx, warehouse, docks, &data)...)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Shipping Strategy",
+			"Could not read shipping strategy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexShippingStrategyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state VtexShippingStrategyResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warehouse, docks, diags := shippingStrategyFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	_, stateDocks, diags := shippingStrategyFromModel(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := make(map[string]bool, len(docks))
+	for _, dock := range docks {
+		planned[dock.ID] = true
+	}
+	var removedDockIDs []string
+	for _, dock := range stateDocks {
+		if !planned[dock.ID] {
+			removedDockIDs = append(removedDockIDs, dock.ID)
+		}
+	}
+
+	tflog.Debug(ctx, "Updating VTEX shipping strategy", map[string]interface{}{
+		"id":            data.ID.ValueString(),
+		"docks":         len(docks),
+		"removed_docks": len(removedDockIDs),
+	})
+
+	result, resultDocks, err := r.saveShippingStrategy(ctx, warehouse, docks, removedDockIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Shipping Strategy",
+			"Could not update shipping strategy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(shippingStrategyToModel(ctx, result, resultDocks, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexShippingStrategyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexShippingStrategyResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, docks, diags := shippingStrategyFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX shipping strategy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// The warehouse goes first, so no warehouse references the docks when they are deleted
+	err := r.client.DeleteWarehouse(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Shipping Strategy",
+			"Could not delete warehouse, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	for _, dock := range docks {
+		err := r.client.DeleteDock(ctx, dock.ID)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting VTEX Shipping Strategy",
+				fmt.Sprintf("Could not delete dock %s, unexpected error: %s", dock.ID, err),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX shipping strategy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexShippingStrategyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: warehouse ID. Read adopts the docks of the warehouse
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("warehouse_id"), req.ID)...)
+}
+
+// saveShippingStrategy saves the objects in dependency order: it checks that the
+// shipping policies exist, saves the docks, points the warehouse at them, and only
+// then deletes the docks the warehouse no longer uses. It reads everything back,
+// since the save calls return no body.
+func (r *VtexShippingStrategyResource) saveShippingStrategy(ctx context.Context, warehouse client.Warehouse, docks []client.Dock, removedDockIDs []string) (*client.Warehouse, map[string]*client.Dock, error) {
+	checked := map[string]bool{}
+	for _, dock := range docks {
+		for _, id := range dock.FreightTableIDs {
+			if checked[id] {
+				continue
+			}
+			checked[id] = true
+			_, err := r.client.GetShippingPolicy(ctx, id)
+			if client.IsNotFound(err) {
+				return nil, nil, fmt.Errorf("shipping policy %s of dock %s does not exist", id, dock.ID)
+			}
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not read shipping policy %s: %w", id, err)
+			}
+		}
+	}
+
+	for _, dock := range docks {
+		if err := r.client.SaveDock(ctx, dock); err != nil {
+			return nil, nil, fmt.Errorf("could not save dock %s: %w", dock.ID, err)
+		}
+	}
+
+	// Pickup points are linked to the warehouse elsewhere, keep them
+	existing, err := r.client.GetWarehouse(ctx, warehouse.ID)
+	if err != nil && !client.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("could not read warehouse: %w", err)
+	}
+	warehouse.PickupPointIDs = []string{}
+	if existing != nil && existing.PickupPointIDs != nil {
+		warehouse.PickupPointIDs = existing.PickupPointIDs
+	}
+
+	if err := r.client.SaveWarehouse(ctx, warehouse); err != nil {
+		return nil, nil, fmt.Errorf("could not save warehouse: %w", err)
+	}
+
+	for _, id := range removedDockIDs {
+		if err := r.client.DeleteDock(ctx, id); err != nil && !client.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("could not delete dock %s: %w", id, err)
+		}
+	}
+
+	result, err := r.client.GetWarehouse(ctx, warehouse.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read warehouse: %w", err)
+	}
+	resultDocks, err := r.readDocks(ctx, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, resultDocks, nil
+}
+
+// readDocks gets the docks of a warehouse by ID. Deleted docks are left out.
+func (r *VtexShippingStrategyResource) readDocks(ctx context.Context, warehouse *client.Warehouse) (map[string]*client.Dock, error) {
+	docks := make(map[string]*client.Dock, len(warehouse.WarehouseDocks))
+	for _, warehouseDock := range warehouse.WarehouseDocks {
+		dock, err := r.client.GetDock(ctx, warehouseDock.DockID)
+		if client.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read dock %s: %w", warehouseDock.DockID, err)
+		}
+		docks[dock.ID] = dock
+	}
+	return docks, nil
+}
+
+// shippingStrategyFromModel builds the API payloads from the resource model
+func shippingStrategyFromModel(ctx context.Context, data VtexShippingStrategyResourceModel) (client.Warehouse, []client.Dock, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var models []VtexShippingStrategyDockModel
+	diags.Append(data.Docks.ElementsAs(ctx, &models, false)...)
+
+	warehouse := client.Warehouse{
+		ID:             data.WarehouseID.ValueString(),
+		Name:           data.Name.ValueString(),
+		IsActive:       data.Active.ValueBool(),
+		WarehouseDocks: make([]client.WarehouseDock, 0, len(models)),
+	}
+	docks := make([]client.Dock, 0, len(models))
+
+	for _, model := range models {
+		// The validators already checked the durations
+		overhead, _ := time.ParseDuration(model.TimeOverhead.ValueString())
+		transfer, _ := time.ParseDuration(model.Time.ValueString())
+
+		salesChannels := []string{}
+		diags.Append(model.SalesChannels.ElementsAs(ctx, &salesChannels, false)...)
+		shippingPolicyIDs := []string{}
+		diags.Append(model.ShippingPolicyIDs.ElementsAs(ctx, &shippingPolicyIDs, false)...)
+
+		docks = append(docks, client.Dock{
+			ID:              model.DockID.ValueString(),
+			Name:            model.Name.ValueString(),
+			Priority:        model.Priority.ValueInt64(),
+			DockTimeFake:    formatTimeSpan(overhead),
+			SalesChannels:   salesChannels,
+			FreightTableIDs: shippingPolicyIDs,
+		})
+		warehouse.WarehouseDocks = append(warehouse.WarehouseDocks, client.WarehouseDock{
+			DockID: model.DockID.ValueString(),
+			Time:   formatTimeSpan(transfer),
+			Cost:   model.Cost.ValueFloat64(),
+		})
+	}
+	return warehouse, docks, diags
+}
+
+// shippingStrategyToModel copies an API warehouse and its docks into the resource model.
+// Docks keep the order of the model; docks added outside Terraform go last.
+func shippingStrategyToModel(ctx context.Context, warehouse *client.Warehouse, docks map[string]*client.Dock, data *VtexShippingStrategyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(warehouse.ID)
+	data.WarehouseID = types.StringValue(warehouse.ID)
+	data.Name = types.StringValue(warehouse.Name)
+	data.Active = types.BoolValue(warehouse.IsActive)
+
+	var current []VtexShippingStrategyDockModel
+	if !data.Docks.IsNull() && !data.Docks.IsUnknown() {
+		diags.Append(data.Docks.ElementsAs(ctx, &current, false)...)
+	}
+	currentByID := make(map[string]VtexShippingStrategyDockModel, len(current))
+	for _, model := range current {
+		currentByID[model.DockID.ValueString()] = model
+	}
+
+	linked := make(map[string]client.WarehouseDock, len(warehouse.WarehouseDocks))
+	order := make([]string, 0, len(warehouse.WarehouseDocks))
+	for _, model := range current {
+		linked[model.DockID.ValueString()] = client.WarehouseDock{}
+		order = append(order, model.DockID.ValueString())
+	}
+	for _, warehouseDock := range warehouse.WarehouseDocks {
+		if _, ok := linked[warehouseDock.DockID]; !ok {
+			order = append(order, warehouseDock.DockID)
+		}
+		linked[warehouseDock.DockID] = warehouseDock
+	}
+
+	models := make([]VtexShippingStrategyDockModel, 0, len(order))
+	for _, id := range order {
+		dock, ok := docks[id]
+		if !ok {
+			// Deleted, or no longer linked to the warehouse
+			continue
+		}
+		model := currentByID[id]
+
+		salesChannels, setDiags := types.SetValueFrom(ctx, types.StringType, append([]string{}, dock.SalesChannels...))
+		diags.Append(setDiags...)
+		shippingPolicyIDs, setDiags := types.SetValueFrom(ctx, types.StringType, append([]string{}, dock.FreightTableIDs...))
+		diags.Append(setDiags...)
+
+		models = append(models, VtexShippingStrategyDockModel{
+			DockID:            types.StringValue(dock.ID),
+			Name:              types.StringValue(dock.Name),
+			Priority:          types.Int64Value(dock.Priority),
+			TimeOverhead:      timeSpanValue(dock.DockTimeFake, model.TimeOverhead),
+			SalesChannels:     salesChannels,
+			ShippingPolicyIDs: shippingPolicyIDs,
+			Time:              timeSpanValue(linked[id].Time, model.Time),
+			Cost:              types.Float64Value(linked[id].Cost),
+		})
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: shippingStrategyDockAttrTypes}, models)
+	diags.Append(listDiags...)
+	data.Docks = list
+	return diags
+}
@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSKUAttachmentResource{}
+var _ resource.ResourceWithImportState = &VtexSKUAttachmentResource{}
+
+func NewVtexSKUAttachmentResource() resource.Resource {
+	return &VtexSKUAttachmentResource{}
+}
+
+// VtexSKUAttachmentResource is the resource implementation
+type VtexSKUAttachmentResource struct {
+	client *client.VtexClient
+}
+
+// VtexSKUAttachmentResourceModel is the resource data model
+type VtexSKUAttachmentResourceModel struct {
+	ID       types.String                `tfsdk:"id"`
+	Name     types.String                `tfsdk:"name"`
+	Required types.Bool                  `tfsdk:"required"`
+	Active   types.Bool                  `tfsdk:"active"`
+	Domains  []VtexAttachmentDomainModel `tfsdk:"domains"`
+	SKUIDs   types.Set                   `tfsdk:"sku_ids"`
+}
+
+// VtexAttachmentDomainModel is one field of an attachment
+type VtexAttachmentDomainModel struct {
+	FieldName     types.String `tfsdk:"field_name"`
+	MaxCharacters types.Int64  `tfsdk:"max_characters"`
+	DomainValues  types.String `tfsdk:"domain_values"`
+}
+
+func (r *VtexSKUAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sku_attachment"
+}
+
+func (r *VtexSKUAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a catalog attachment (customization like engraving or gift message) and the SKUs it is associated with.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Attachment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Attachment name",
+			},
+			"required": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether shoppers must fill the attachment to buy the SKU",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the attachment is active",
+			},
+			"domains": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Fields of the attachment",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_name": schema.StringAttribute{
+							Required:    true,
+							Description: "Field name",
+						},
+						"max_characters": schema.Int64Attribute{
+							Required:    true,
+							Description: "Maximum length of the value",
+						},
+						"domain_values": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+							Description: "Allowed values, like [1-2]#option1;option2 (empty for free text)",
+						},
+					},
+				},
+			},
+			"sku_ids": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.Int64Type, nil)),
+				Description: "SKU IDs associated with the attachment",
+			},
+		},
+	}
+}
+
+func (r *VtexSKUAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSKUAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSKUAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var skuIDs []int64
+	resp.Diagnostics.Append(data.SKUIDs.ElementsAs(ctx, &skuIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX SKU attachment", map[string]interface{}{
+		"name": data.Name.ValueString(),
+		"skus": len(skuIDs),
+	})
+
+	result, err := r.client.CreateAttachment(ctx, attachmentFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX SKU Attachment",
+			"Could not create attachment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(result.ID, 10))
+
+	err = forEachConcurrently(ctx, skuIDs, func(ctx context.Context, skuID int64) error {
+		if err := r.client.AddSKUAttachment(ctx, skuID, result.ID); err != nil {
+			return fmt.Errorf("SKU %d: %w", skuID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX SKU Attachment",
+			"Attachment was created but could not be associated with the SKUs, unexpected error: "+err.Error(),
+		)
+		// Keep the attachment in state so it is not orphaned, the next apply associates the SKUs
+		data.SKUIDs = types.SetValueMust(types.Int64Type, nil)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Trace(ctx, "Created VTEX SKU attachment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSKUAttachmentResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachmentID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Attachment ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX SKU attachment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	attachment, err := r.client.GetAttachment(ctx, attachmentID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX attachment not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX SKU Attachment",
+			"Could not read attachment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(attachmentToModel(attachment, &data)...)
+
+	// Only the SKUs in state can be checked, the API does not list SKUs by attachment
+	skuIDs, diags := r.associatedSKUs(ctx, attachmentID, data.SKUIDs)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SKUIDs = skuIDs
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexSKUAttachmentResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachmentID, err := parseNumericID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Attachment ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX SKU attachment", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	_, err = r.client.UpdateAttachment(ctx, attachmentID, attachmentFromModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX SKU Attachment",
+			"Could not update attachment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var planned, current []int64
+	resp.Diagnostics.Append(plan.SKUIDs.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.SKUIDs.ElementsAs(ctx, &current, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = forEachConcurrently(ctx, subtractInt64s(planned, current), func(ctx context.Context, skuID int64) error {
+		if err := r.client.AddSKUAttachment(ctx, skuID, attachmentID); err != nil {
+			return fmt.Errorf("SKU %d: %w", skuID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX SKU Attachment",
+			"Could not associate SKUs, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = forEachConcurrently(ctx, subtractInt64s(current, planned), func(ctx context.Context, skuID int64) error {
+		err := r.client.RemoveSKUAttachment(ctx, skuID, attachmentID)
+		if err != nil && !client.IsNotFound(err) {
+			return fmt.Errorf("SKU %d: %w", skuID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX SKU Attachment",
+			"Could not remove SKU associations, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexSKUAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSKUAttachmentResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachmentID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Attachment ID", err.Error())
+		return
+	}
+
+	var skuIDs []int64
+	resp.Diagnostics.Append(data.SKUIDs.ElementsAs(ctx, &skuIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX SKU attachment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Associations must be removed before the attachment can be deleted
+	err = forEachConcurrently(ctx, skuIDs, func(ctx context.Context, skuID int64) error {
+		err := r.client.RemoveSKUAttachment(ctx, skuID, attachmentID)
+		if err != nil && !client.IsNotFound(err) {
+			return fmt.Errorf("SKU %d: %w", skuID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX SKU Attachment",
+			"Could not remove SKU associations, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteAttachment(ctx, attachmentID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX SKU Attachment",
+			"Could not delete attachment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX SKU attachment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSKUAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric attachment ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// SKUs cannot be listed by attachment, so they start empty
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sku_ids"), types.SetValueMust(types.Int64Type, nil))...)
+}
+
+// associatedSKUs returns the SKUs of known that still have the attachment
+func (r *VtexSKUAttachmentResource) associatedSKUs(ctx context.Context, attachmentID int64, known types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var skuIDs []int64
+	if !known.IsNull() && !known.IsUnknown() {
+		diags.Append(known.ElementsAs(ctx, &skuIDs, false)...)
+	}
+
+	var associated []int64
+	for _, skuID := range skuIDs {
+		attachments, err := r.client.ListSKUAttachments(ctx, skuID)
+		if client.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			diags.AddError(
+				"Error Reading VTEX SKU Attachment",
+				fmt.Sprintf("Could not read attachments of SKU %d, unexpected error: %s", skuID, err.Error()),
+			)
+			return known, diags
+		}
+
+		for _, a := range attachments {
+			if a.AttachmentID == attachmentID {
+				associated = append(associated, skuID)
+				break
+			}
+		}
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, types.Int64Type, associated)
+	diags.Append(setDiags...)
+	return set, diags
+}
+
+// attachmentFromModel builds the API payload from the resource model
+func attachmentFromModel(data VtexSKUAttachmentResourceModel) client.Attachment {
+	domains := make([]client.AttachmentDomain, 0, len(data.Domains))
+	for _, d := range data.Domains {
+		domains = append(domains, client.AttachmentDomain{
+			FieldName:    d.FieldName.ValueString(),
+			MaxCaracters: strconv.FormatInt(d.MaxCharacters.ValueInt64(), 10),
+			DomainValues: d.DomainValues.ValueString(),
+		})
+	}
+	return client.Attachment{
+		Name:       data.Name.ValueString(),
+		IsRequired: data.Required.ValueBool(),
+		IsActive:   data.Active.ValueBool(),
+		Domains:    domains,
+	}
+}
+
+// attachmentToModel copies an API attachment into the resource model
+func attachmentToModel(attachment *client.Attachment, data *VtexSKUAttachmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringValue(attachment.Name)
+	data.Required = types.BoolValue(attachment.IsRequired)
+	data.Active = types.BoolValue(attachment.IsActive)
+
+	data.Domains = make([]VtexAttachmentDomainModel, 0, len(attachment.Domains))
+	for _, d := range attachment.Domains {
+		maxCharacters, err := strconv.ParseInt(d.MaxCaracters, 10, 64)
+		if err != nil {
+			diags.AddError(
+				"Error Reading VTEX SKU Attachment",
+				fmt.Sprintf("Unexpected max characters %q in field %s", d.MaxCaracters, d.FieldName),
+			)
+			return diags
+		}
+		data.Domains = append(data.Domains, VtexAttachmentDomainModel{
+			FieldName:     types.StringValue(d.FieldName),
+			MaxCharacters: types.Int64Value(maxCharacters),
+			DomainValues:  types.StringValue(d.DomainValues),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexSKUByEANDataSource{}
+
+func NewVtexSKUByEANDataSource() datasource.DataSource {
+	return &VtexSKUByEANDataSource{}
+}
+
+// VtexSKUByEANDataSource is the data source implementation
+type VtexSKUByEANDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexSKUByEANDataSourceModel is the data source data model
+type VtexSKUByEANDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	EAN       types.String `tfsdk:"ean"`
+	ProductID types.String `tfsdk:"product_id"`
+	Name      types.String `tfsdk:"name"`
+	RefID     types.String `tfsdk:"ref_id"`
+	Active    types.Bool   `tfsdk:"active"`
+}
+
+func (d *VtexSKUByEANDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sku_by_ean"
+}
+
+func (d *VtexSKUByEANDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resolves a catalog SKU by its EAN/GTIN barcode.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU ID",
+			},
+			"ean": schema.StringAttribute{
+				Required:    true,
+				Description: "EAN/GTIN barcode of the SKU",
+			},
+			"product_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the product the SKU belongs to",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU name",
+			},
+			"ref_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU reference code",
+			},
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the SKU is active",
+			},
+		},
+	}
+}
+
+func (d *VtexSKUByEANDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexSKUByEANDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexSKUByEANDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX SKU by EAN", map[string]interface{}{
+		"ean": data.EAN.ValueString(),
+	})
+
+	sku, err := d.client.GetSKUByEAN(ctx, data.EAN.ValueString())
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ean"),
+			"VTEX SKU Not Found",
+			fmt.Sprintf("No SKU with EAN %q in the catalog", data.EAN.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX SKU",
+			"Could not read SKU by EAN, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(sku.ID, 10))
+	data.ProductID = types.StringValue(strconv.FormatInt(sku.ProductID, 10))
+	data.Name = types.StringValue(sku.Name)
+	data.RefID = types.StringValue(sku.RefID)
+	data.Active = types.BoolValue(sku.IsActive)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
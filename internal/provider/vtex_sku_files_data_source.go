@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexSKUFilesDataSource{}
+
+func NewVtexSKUFilesDataSource() datasource.DataSource {
+	return &VtexSKUFilesDataSource{}
+}
+
+// VtexSKUFilesDataSource is the data source implementation
+type VtexSKUFilesDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexSKUFilesDataSourceModel is the data source data model
+type VtexSKUFilesDataSourceModel struct {
+	ID         types.String           `tfsdk:"id"`
+	SKUID      types.String           `tfsdk:"sku_id"`
+	Files      []VtexSKUFileItemModel `tfsdk:"files"`
+	MainFileID types.String           `tfsdk:"main_file_id"`
+}
+
+// VtexSKUFileItemModel is an image attached to the SKU
+type VtexSKUFileItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	ArchiveID types.String `tfsdk:"archive_id"`
+	Name      types.String `tfsdk:"name"`
+	Label     types.String `tfsdk:"label"`
+	Main      types.Bool   `tfsdk:"main"`
+	URL       types.String `tfsdk:"url"`
+}
+
+func (d *VtexSKUFilesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sku_files"
+}
+
+func (d *VtexSKUFilesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the images attached to a catalog SKU.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU ID",
+			},
+			"sku_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the SKU to list the images of",
+			},
+			"files": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Images of the SKU, in display order",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "SKU file ID",
+						},
+						"archive_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the stored image",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Image name",
+						},
+						"label": schema.StringAttribute{
+							Computed:    true,
+							Description: "Image label",
+						},
+						"main": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the image is the main image of the SKU",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "Public URL of the image (null while the image is not published)",
+						},
+					},
+				},
+			},
+			"main_file_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the main image (null when the SKU has none)",
+			},
+		},
+	}
+}
+
+func (d *VtexSKUFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexSKUFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexSKUFilesDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skuID, err := parseNumericID(data.SKUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sku_id"), "Invalid SKU ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX SKU files", map[string]interface{}{
+		"sku_id": skuID,
+	})
+
+	// The SKU context has the image URLs, and tells a missing SKU from a SKU without images
+	skuContext, err := d.client.GetSKUContext(ctx, skuID)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sku_id"),
+			"VTEX SKU Not Found",
+			fmt.Sprintf("No SKU with ID %d in the catalog", skuID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX SKU Files",
+			"Could not read SKU images, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	files, err := d.client.ListSKUFiles(ctx, skuID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX SKU Files",
+			"Could not list SKU files, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	urls := make(map[int64]string, len(skuContext.Images))
+	for _, image := range skuContext.Images {
+		urls[image.FileID] = image.ImageURL
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(skuID, 10))
+	data.MainFileID = types.StringNull()
+	data.Files = make([]VtexSKUFileItemModel, 0, len(files))
+	for _, file := range files {
+		imageURL := types.StringNull()
+		if u, ok := urls[file.ArchiveID]; ok {
+			imageURL = types.StringValue(u)
+		}
+		data.Files = append(data.Files, VtexSKUFileItemModel{
+			ID:        types.StringValue(strconv.FormatInt(file.ID, 10)),
+			ArchiveID: types.StringValue(strconv.FormatInt(file.ArchiveID, 10)),
+			Name:      types.StringValue(file.Name),
+			Label:     types.StringValue(file.Label),
+			Main:      types.BoolValue(file.IsMain),
+			URL:       imageURL,
+		})
+		if file.IsMain {
+			data.MainFileID = types.StringValue(strconv.FormatInt(file.ID, 10))
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSKUServiceResource{}
+var _ resource.ResourceWithImportState = &VtexSKUServiceResource{}
+
+func NewVtexSKUServiceResource() resource.Resource {
+	return &VtexSKUServiceResource{}
+}
+
+// VtexSKUServiceResource is the resource implementation
+type VtexSKUServiceResource struct {
+	client *client.VtexClient
+}
+
+// VtexSKUServiceResourceModel is the resource data model
+type VtexSKUServiceResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	SKUID          types.String `tfsdk:"sku_id"`
+	ServiceTypeID  types.String `tfsdk:"service_type_id"`
+	ServiceValueID types.String `tfsdk:"service_value_id"`
+	Name           types.String `tfsdk:"name"`
+	Text           types.String `tfsdk:"text"`
+	Active         types.Bool   `tfsdk:"active"`
+}
+
+func (r *VtexSKUServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sku_service"
+}
+
+func (r *VtexSKUServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Offers a SKU service type and value on a SKU.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU service ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sku_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the SKU the service is offered on",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_type_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the service type",
+			},
+			"service_value_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the service value, which sets the price",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Service name shown to shoppers",
+			},
+			"text": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Internal description of the service",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the service is offered",
+			},
+		},
+	}
+}
+
+func (r *VtexSKUServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSKUServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSKUServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, diags := skuServiceFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX SKU service", map[string]interface{}{
+		"sku_id":           data.SKUID.ValueString(),
+		"service_value_id": data.ServiceValueID.ValueString(),
+	})
+
+	result, err := r.client.CreateSKUService(ctx, service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX SKU Service",
+			"Could not create SKU service, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skuServiceToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX SKU service", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSKUServiceResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX SKU Service ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX SKU service", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	service, err := r.client.GetSKUService(ctx, serviceID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX SKU service not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX SKU Service",
+			"Could not read SKU service, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skuServiceToModel(service, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexSKUServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX SKU Service ID", err.Error())
+		return
+	}
+
+	service, diags := skuServiceFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX SKU service", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.UpdateSKUService(ctx, serviceID, service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX SKU Service",
+			"Could not update SKU service, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skuServiceToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSKUServiceResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX SKU Service ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX SKU service", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.client.DeleteSKUService(ctx, serviceID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX SKU Service",
+			"Could not delete SKU service, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX SKU service", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSKUServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric SKU service ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// skuServiceFromModel builds the API service from the resource model
+func skuServiceFromModel(data VtexSKUServiceResourceModel) (client.SKUService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	skuID, err := parseNumericID(data.SKUID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("sku_id"), "Invalid SKU ID", err.Error())
+	}
+	serviceTypeID, err := parseNumericID(data.ServiceTypeID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("service_type_id"), "Invalid Service Type ID", err.Error())
+	}
+	serviceValueID, err := parseNumericID(data.ServiceValueID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("service_value_id"), "Invalid Service Value ID", err.Error())
+	}
+	if diags.HasError() {
+		return client.SKUService{}, diags
+	}
+
+	return client.SKUService{
+		SkuID:             skuID,
+		SkuServiceTypeID:  serviceTypeID,
+		SkuServiceValueID: serviceValueID,
+		Name:              data.Name.ValueString(),
+		Text:              data.Text.ValueString(),
+		IsActive:          data.Active.ValueBool(),
+	}, diags
+}
+
+// skuServiceToModel copies an API SKU service into the resource model
+func skuServiceToModel(service *client.SKUService, data *VtexSKUServiceResourceModel) {
+	data.ID = types.StringValue(strconv.FormatInt(service.ID, 10))
+	data.SKUID = types.StringValue(strconv.FormatInt(service.SkuID, 10))
+	data.ServiceTypeID = types.StringValue(strconv.FormatInt(service.SkuServiceTypeID, 10))
+	data.ServiceValueID = types.StringValue(strconv.FormatInt(service.SkuServiceValueID, 10))
+	data.Name = types.StringValue(service.Name)
+	data.Text = types.StringValue(service.Text)
+	data.Active = types.BoolValue(service.IsActive)
+}
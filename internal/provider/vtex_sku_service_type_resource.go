@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSKUServiceTypeResource{}
+var _ resource.ResourceWithImportState = &VtexSKUServiceTypeResource{}
+
+func NewVtexSKUServiceTypeResource() resource.Resource {
+	return &VtexSKUServiceTypeResource{}
+}
+
+// VtexSKUServiceTypeResource is the resource implementation
+type VtexSKUServiceTypeResource struct {
+	client *client.VtexClient
+}
+
+// VtexSKUServiceTypeResourceModel is the resource data model
+type VtexSKUServiceTypeResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Active                types.Bool   `tfsdk:"active"`
+	ShowOnProductFront    types.Bool   `tfsdk:"show_on_product_front"`
+	ShowOnCartFront       types.Bool   `tfsdk:"show_on_cart_front"`
+	ShowOnAttachmentFront types.Bool   `tfsdk:"show_on_attachment_front"`
+	ShowOnFileUpload      types.Bool   `tfsdk:"show_on_file_upload"`
+	GiftCard              types.Bool   `tfsdk:"gift_card"`
+	Required              types.Bool   `tfsdk:"required"`
+}
+
+func (r *VtexSKUServiceTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sku_service_type"
+}
+
+func (r *VtexSKUServiceTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a catalog SKU service type, such as a warranty or gift wrapping.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU service type ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Service type name shown to shoppers",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the service type is active",
+			},
+			"show_on_product_front": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the service is offered on the product page",
+			},
+			"show_on_cart_front": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the service is offered in the cart",
+			},
+			"show_on_attachment_front": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the service is shown as an attachment",
+			},
+			"show_on_file_upload": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the service accepts a file upload",
+			},
+			"gift_card": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the service is a gift card",
+			},
+			"required": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether shoppers must pick the service",
+			},
+		},
+	}
+}
+
+func (r *VtexSKUServiceTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSKUServiceTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSKUServiceTypeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceType := skuServiceTypeFromModel(data)
+
+	tflog.Debug(ctx, "Creating VTEX SKU service type", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	result, err := r.client.CreateSKUServiceType(ctx, serviceType)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX SKU Service Type",
+			"Could not create SKU service type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skuServiceTypeToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX SKU service type", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUServiceTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSKUServiceTypeResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceTypeID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX SKU Service Type ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX SKU service type", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	serviceType, err := r.client.GetSKUServiceType(ctx, serviceTypeID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX SKU service type not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX SKU Service Type",
+			"Could not read SKU service type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skuServiceTypeToModel(serviceType, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUServiceTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexSKUServiceTypeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceTypeID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX SKU Service Type ID", err.Error())
+		return
+	}
+
+	serviceType := skuServiceTypeFromModel(data)
+
+	tflog.Debug(ctx, "Updating VTEX SKU service type", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.UpdateSKUServiceType(ctx, serviceTypeID, serviceType)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX SKU Service Type",
+			"Could not update SKU service type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skuServiceTypeToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUServiceTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSKUServiceTypeResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceTypeID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX SKU Service Type ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX SKU service type", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.client.DeleteSKUServiceType(ctx, serviceTypeID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX SKU Service Type",
+			"Could not delete SKU service type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX SKU service type", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSKUServiceTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric SKU service type ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// skuServiceTypeFromModel builds the API serviceType from the resource model
+func skuServiceTypeFromModel(data VtexSKUServiceTypeResourceModel) client.SKUServiceType {
+	return client.SKUServiceType{
+		Name:                  data.Name.ValueString(),
+		IsActive:              data.Active.ValueBool(),
+		ShowOnProductFront:    data.ShowOnProductFront.ValueBool(),
+		ShowOnCartFront:       data.ShowOnCartFront.ValueBool(),
+		ShowOnAttachmentFront: data.ShowOnAttachmentFront.ValueBool(),
+		ShowOnFileUpload:      data.ShowOnFileUpload.ValueBool(),
+		IsGiftCard:            data.GiftCard.ValueBool(),
+		IsRequired:            data.Required.ValueBool(),
+	}
+}
+
+// skuServiceTypeToModel copies an API SKU service type into the resource model
+func skuServiceTypeToModel(serviceType *client.SKUServiceType, data *VtexSKUServiceTypeResourceModel) {
+	data.ID = types.StringValue(strconv.FormatInt(serviceType.ID, 10))
+	data.Name = types.StringValue(serviceType.Name)
+	data.Active = types.BoolValue(serviceType.IsActive)
+	data.ShowOnProductFront = types.BoolValue(serviceType.ShowOnProductFront)
+	data.ShowOnCartFront = types.BoolValue(serviceType.ShowOnCartFront)
+	data.ShowOnAttachmentFront = types.BoolValue(serviceType.ShowOnAttachmentFront)
+	data.ShowOnFileUpload = types.BoolValue(serviceType.ShowOnFileUpload)
+	data.GiftCard = types.BoolValue(serviceType.IsGiftCard)
+	data.Required = types.BoolValue(serviceType.IsRequired)
+}
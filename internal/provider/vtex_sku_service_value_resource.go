@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSKUServiceValueResource{}
+var _ resource.ResourceWithImportState = &VtexSKUServiceValueResource{}
+
+func NewVtexSKUServiceValueResource() resource.Resource {
+	return &VtexSKUServiceValueResource{}
+}
+
+// VtexSKUServiceValueResource is the resource implementation
+type VtexSKUServiceValueResource struct {
+	client *client.VtexClient
+}
+
+// VtexSKUServiceValueResourceModel is the resource data model
+type VtexSKUServiceValueResourceModel struct {
+	ID            types.String  `tfsdk:"id"`
+	ServiceTypeID types.String  `tfsdk:"service_type_id"`
+	Name          types.String  `tfsdk:"name"`
+	Value         types.Float64 `tfsdk:"value"`
+	Cost          types.Float64 `tfsdk:"cost"`
+}
+
+func (r *VtexSKUServiceValueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sku_service_value"
+}
+
+func (r *VtexSKUServiceValueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a priced option of a SKU service type, such as a 12 month warranty.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SKU service value ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_type_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the service type the value belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Value name shown to shoppers",
+			},
+			"value": schema.Float64Attribute{
+				Required:    true,
+				Description: "Price charged to the shopper for the service",
+			},
+			"cost": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     float64default.StaticFloat64(0),
+				Description: "Cost of the service to the store",
+			},
+		},
+	}
+}
+
+func (r *VtexSKUServiceValueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSKUServiceValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSKUServiceValueResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, diags := skuServiceValueFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX SKU service value", map[string]interface{}{
+		"service_type_id": data.ServiceTypeID.ValueString(),
+		"name":            data.Name.ValueString(),
+	})
+
+	result, err := r.client.CreateSKUServiceValue(ctx, value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX SKU Service Value",
+			"Could not create SKU service value, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skuServiceValueToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX SKU service value", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUServiceValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSKUServiceValueResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valueID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX SKU Service Value ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX SKU service value", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	value, err := r.client.GetSKUServiceValue(ctx, valueID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX SKU service value not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX SKU Service Value",
+			"Could not read SKU service value, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skuServiceValueToModel(value, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUServiceValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexSKUServiceValueResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valueID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX SKU Service Value ID", err.Error())
+		return
+	}
+
+	value, diags := skuServiceValueFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX SKU service value", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.UpdateSKUServiceValue(ctx, valueID, value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX SKU Service Value",
+			"Could not update SKU service value, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	skuServiceValueToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSKUServiceValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSKUServiceValueResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valueID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX SKU Service Value ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX SKU service value", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.client.DeleteSKUServiceValue(ctx, valueID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX SKU Service Value",
+			"Could not delete SKU service value, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX SKU service value", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSKUServiceValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric SKU service value ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// skuServiceValueFromModel builds the API value from the resource model
+func skuServiceValueFromModel(data VtexSKUServiceValueResourceModel) (client.SKUServiceValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceTypeID, err := parseNumericID(data.ServiceTypeID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("service_type_id"), "Invalid Service Type ID", err.Error())
+		return client.SKUServiceValue{}, diags
+	}
+
+	return client.SKUServiceValue{
+		SkuServiceTypeID: serviceTypeID,
+		Name:             data.Name.ValueString(),
+		Value:            data.Value.ValueFloat64(),
+		Cost:             data.Cost.ValueFloat64(),
+	}, diags
+}
+
+// skuServiceValueToModel copies an API SKU service value into the resource model
+func skuServiceValueToModel(value *client.SKUServiceValue, data *VtexSKUServiceValueResourceModel) {
+	data.ID = types.StringValue(strconv.FormatInt(value.ID, 10))
+	data.ServiceTypeID = types.StringValue(strconv.FormatInt(value.SkuServiceTypeID, 10))
+	data.Name = types.StringValue(value.Name)
+	data.Value = types.Float64Value(value.Value)
+	data.Cost = types.Float64Value(value.Cost)
+}
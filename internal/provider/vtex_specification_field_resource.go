@@ -0,0 +1,445 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSpecificationFieldResource{}
+var _ resource.ResourceWithImportState = &VtexSpecificationFieldResource{}
+
+// specificationFieldTypes maps field_type names to Catalog API field type IDs
+var specificationFieldTypes = map[string]int64{
+	"Text":                    1,
+	"Multi-Line Text":         2,
+	"Number":                  4,
+	"Combo":                   5,
+	"Radio":                   6,
+	"Checkbox":                7,
+	"Indexed Text":            8,
+	"Indexed Multi-Line Text": 9,
+}
+
+func NewVtexSpecificationFieldResource() resource.Resource {
+	return &VtexSpecificationFieldResource{}
+}
+
+// VtexSpecificationFieldResource is the resource implementation
+type VtexSpecificationFieldResource struct {
+	client *client.VtexClient
+}
+
+// VtexSpecificationFieldResourceModel is the resource data model
+type VtexSpecificationFieldResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	GroupID      types.String `tfsdk:"group_id"`
+	CategoryID   types.String `tfsdk:"category_id"`
+	Name         types.String `tfsdk:"name"`
+	FieldType    types.String `tfsdk:"field_type"`
+	SKU          types.Bool   `tfsdk:"sku"`
+	Description  types.String `tfsdk:"description"`
+	Position     types.Int64  `tfsdk:"position"`
+	Filterable   types.Bool   `tfsdk:"filterable"`
+	Required     types.Bool   `tfsdk:"required"`
+	Visible      types.Bool   `tfsdk:"visible"`
+	Active       types.Bool   `tfsdk:"active"`
+	TopMenuLink  types.Bool   `tfsdk:"top_menu_link"`
+	SideMenuLink types.Bool   `tfsdk:"side_menu_link"`
+	DefaultValue types.String `tfsdk:"default_value"`
+}
+
+func (r *VtexSpecificationFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_specification_field"
+}
+
+func (r *VtexSpecificationFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	fieldTypes := make([]string, 0, len(specificationFieldTypes))
+	for name := range specificationFieldTypes {
+		fieldTypes = append(fieldTypes, name)
+	}
+	sort.Strings(fieldTypes)
+
+	flag := func(description string, value bool) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(value),
+			Description: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a product or SKU specification field of a catalog category.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Specification field ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the specification group of the field",
+			},
+			"category_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the category of the field (default: the category of the group)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Field name",
+			},
+			"field_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Field type. Changing it forces a new field",
+				Validators: []validator.String{
+					stringOneOfValidator{values: fieldTypes},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sku": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the field is a SKU specification instead of a product specification. Changing it forces a new field",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Field help text",
+			},
+			"position": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Display order of the field in its group",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"filterable":     flag("Whether the field is a search filter", false),
+			"required":       flag("Whether products must fill the field", false),
+			"visible":        flag("Whether the field is shown on the product page", true),
+			"active":         flag("Whether the field is active", true),
+			"top_menu_link":  flag("Whether field values are shown as links in the top menu", false),
+			"side_menu_link": flag("Whether field values are shown as links in the side menu", false),
+			"default_value": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Default value of the field",
+			},
+		},
+	}
+}
+
+func (r *VtexSpecificationFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSpecificationFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSpecificationFieldResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, diags := specificationFieldFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If category is not given, take it from the group
+	if data.CategoryID.IsUnknown() || data.CategoryID.IsNull() {
+		group, err := r.client.GetSpecificationGroup(ctx, field.FieldGroupID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating VTEX Specification Field",
+				"Could not read specification group, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		field.CategoryID = group.CategoryID
+	}
+
+	tflog.Debug(ctx, "Creating VTEX specification field", map[string]interface{}{
+		"group_id": data.GroupID.ValueString(),
+		"name":     data.Name.ValueString(),
+	})
+
+	result, err := r.client.CreateSpecificationField(ctx, field)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Specification Field",
+			"Could not create specification field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	specificationFieldToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX specification field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSpecificationFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSpecificationFieldResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Specification Field ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX specification field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	field, err := r.client.GetSpecificationField(ctx, fieldID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX specification field not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Specification Field",
+			"Could not read specification field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	specificationFieldToModel(field, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSpecificationFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexSpecificationFieldResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Specification Field ID", err.Error())
+		return
+	}
+
+	field, diags := specificationFieldFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX specification field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.UpdateSpecificationField(ctx, fieldID, field)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Specification Field",
+			"Could not update specification field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	specificationFieldToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSpecificationFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSpecificationFieldResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Specification Field ID", err.Error())
+		return
+	}
+
+	field, diags := specificationFieldFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Catalog API cannot delete specification fields, so they are deactivated
+	field.IsActive = false
+
+	tflog.Debug(ctx, "Deactivating VTEX specification field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err = r.client.UpdateSpecificationField(ctx, fieldID, field)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Specification Field",
+			"Could not deactivate specification field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deactivated VTEX specification field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSpecificationFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric specification field ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// specificationFieldFromModel builds the API payload from the resource model
+func specificationFieldFromModel(data VtexSpecificationFieldResourceModel) (client.SpecificationField, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groupID, err := parseNumericID(data.GroupID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("group_id"), "Invalid Specification Group ID", err.Error())
+		return client.SpecificationField{}, diags
+	}
+
+	field := client.SpecificationField{
+		FieldTypeID:          specificationFieldTypes[data.FieldType.ValueString()],
+		FieldGroupID:         groupID,
+		Name:                 data.Name.ValueString(),
+		Description:          data.Description.ValueString(),
+		IsFilter:             data.Filterable.ValueBool(),
+		IsRequired:           data.Required.ValueBool(),
+		IsOnProductDetails:   data.Visible.ValueBool(),
+		IsStockKeepingUnit:   data.SKU.ValueBool(),
+		IsActive:             data.Active.ValueBool(),
+		IsTopMenuLinkActive:  data.TopMenuLink.ValueBool(),
+		IsSideMenuLinkActive: data.SideMenuLink.ValueBool(),
+		DefaultValue:         data.DefaultValue.ValueString(),
+	}
+
+	if !data.CategoryID.IsNull() && !data.CategoryID.IsUnknown() {
+		categoryID, err := parseNumericID(data.CategoryID.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("category_id"), "Invalid Category ID", err.Error())
+			return field, diags
+		}
+		field.CategoryID = categoryID
+	}
+
+	if !data.Position.IsNull() && !data.Position.IsUnknown() {
+		position := data.Position.ValueInt64()
+		field.Position = &position
+	}
+
+	return field, diags
+}
+
+// specificationFieldToModel copies an API specification field into the resource model
+func specificationFieldToModel(field *client.SpecificationField, data *VtexSpecificationFieldResourceModel) {
+	data.ID = types.StringValue(strconv.FormatInt(field.ID, 10))
+	data.GroupID = types.StringValue(strconv.FormatInt(field.FieldGroupID, 10))
+	data.CategoryID = types.StringValue(strconv.FormatInt(field.CategoryID, 10))
+	data.Name = types.StringValue(field.Name)
+	data.SKU = types.BoolValue(field.IsStockKeepingUnit)
+	data.Description = types.StringValue(field.Description)
+	data.Filterable = types.BoolValue(field.IsFilter)
+	data.Required = types.BoolValue(field.IsRequired)
+	data.Visible = types.BoolValue(field.IsOnProductDetails)
+	data.Active = types.BoolValue(field.IsActive)
+	data.TopMenuLink = types.BoolValue(field.IsTopMenuLinkActive)
+	data.SideMenuLink = types.BoolValue(field.IsSideMenuLinkActive)
+	data.DefaultValue = types.StringValue(field.DefaultValue)
+
+	for name, id := range specificationFieldTypes {
+		if id == field.FieldTypeID {
+			data.FieldType = types.StringValue(name)
+		}
+	}
+
+	if field.Position != nil {
+		data.Position = types.Int64Value(*field.Position)
+	} else {
+		data.Position = types.Int64Null()
+	}
+}
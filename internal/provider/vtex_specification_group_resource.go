@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSpecificationGroupResource{}
+var _ resource.ResourceWithImportState = &VtexSpecificationGroupResource{}
+
+func NewVtexSpecificationGroupResource() resource.Resource {
+	return &VtexSpecificationGroupResource{}
+}
+
+// VtexSpecificationGroupResource is the resource implementation
+type VtexSpecificationGroupResource struct {
+	client *client.VtexClient
+}
+
+// VtexSpecificationGroupResourceModel is the resource data model
+type VtexSpecificationGroupResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	CategoryID types.String `tfsdk:"category_id"`
+	Name       types.String `tfsdk:"name"`
+	Position   types.Int64  `tfsdk:"position"`
+}
+
+func (r *VtexSpecificationGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_specification_group"
+}
+
+func (r *VtexSpecificationGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a specification group of a catalog category.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Specification group ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"category_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the category that owns the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Group name",
+			},
+			"position": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Display order of the group on the product page",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexSpecificationGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSpecificationGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSpecificationGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := specificationGroupFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX specification group", map[string]interface{}{
+		"category_id": data.CategoryID.ValueString(),
+		"name":        data.Name.ValueString(),
+	})
+
+	result, err := r.client.CreateSpecificationGroup(ctx, group)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Specification Group",
+			"Could not create specification group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	specificationGroupToModel(result, &data)
+
+	tflog.Trace(ctx, "Created VTEX specification group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSpecificationGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSpecificationGroupResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Specification Group ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX specification group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	group, err := r.client.GetSpecificationGroup(ctx, groupID)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX specification group not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Specification Group",
+			"Could not read specification group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	specificationGroupToModel(group, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSpecificationGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexSpecificationGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Specification Group ID", err.Error())
+		return
+	}
+
+	group, diags := specificationGroupFromModel(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX specification group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	result, err := r.client.UpdateSpecificationGroup(ctx, groupID, group)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Specification Group",
+			"Could not update specification group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	specificationGroupToModel(result, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSpecificationGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSpecificationGroupResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, err := parseNumericID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid VTEX Specification Group ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX specification group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.client.DeleteSpecificationGroup(ctx, groupID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Specification Group",
+			"Could not delete specification group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX specification group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexSpecificationGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: numeric specification group ID
+	if _, err := parseNumericID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// specificationGroupFromModel builds the API payload from the resource model
+func specificationGroupFromModel(data VtexSpecificationGroupResourceModel) (client.SpecificationGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	categoryID, err := parseNumericID(data.CategoryID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("category_id"), "Invalid Category ID", err.Error())
+		return client.SpecificationGroup{}, diags
+	}
+
+	group := client.SpecificationGroup{
+		CategoryID: categoryID,
+		Name:       data.Name.ValueString(),
+	}
+	if !data.Position.IsNull() && !data.Position.IsUnknown() {
+		position := data.Position.ValueInt64()
+		group.Position = &position
+	}
+	return group, diags
+}
+
+// specificationGroupToModel copies an API specification group into the resource model
+func specificationGroupToModel(group *client.SpecificationGroup, data *VtexSpecificationGroupResourceModel) {
+	data.ID = types.StringValue(strconv.FormatInt(group.ID, 10))
+	data.CategoryID = types.StringValue(strconv.FormatInt(group.CategoryID, 10))
+	data.Name = types.StringValue(group.Name)
+	if group.Position != nil {
+		data.Position = types.Int64Value(*group.Position)
+	} else {
+		data.Position = types.Int64Null()
+	}
+}
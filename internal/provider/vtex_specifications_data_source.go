@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexSpecificationsDataSource{}
+
+// specificationTypesWithValues are the field types that use predefined values (Combo, Radio, Checkbox)
+var specificationTypesWithValues = map[int64]bool{5: true, 6: true, 7: true}
+
+func NewVtexSpecificationsDataSource() datasource.DataSource {
+	return &VtexSpecificationsDataSource{}
+}
+
+// VtexSpecificationsDataSource is the data source implementation
+type VtexSpecificationsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexSpecificationsDataSourceModel is the data source data model
+type VtexSpecificationsDataSourceModel struct {
+	ID         types.String                      `tfsdk:"id"`
+	CategoryID types.String                      `tfsdk:"category_id"`
+	Groups     []VtexSpecificationGroupItemModel `tfsdk:"groups"`
+	Fields     []VtexSpecificationFieldItemModel `tfsdk:"fields"`
+	FieldIDs   types.Map                         `tfsdk:"field_ids"`
+}
+
+// VtexSpecificationGroupItemModel is a specification group of the category
+type VtexSpecificationGroupItemModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Position types.Int64  `tfsdk:"position"`
+}
+
+// VtexSpecificationFieldItemModel is a specification field of the category
+type VtexSpecificationFieldItemModel struct {
+	ID        types.String                      `tfsdk:"id"`
+	Name      types.String                      `tfsdk:"name"`
+	GroupID   types.String                      `tfsdk:"group_id"`
+	FieldType types.String                      `tfsdk:"field_type"`
+	SKU       types.Bool                        `tfsdk:"sku"`
+	Active    types.Bool                        `tfsdk:"active"`
+	Values    []VtexSpecificationValueItemModel `tfsdk:"values"`
+}
+
+// VtexSpecificationValueItemModel is a predefined value of a specification field
+type VtexSpecificationValueItemModel struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Value  types.String `tfsdk:"value"`
+	Active types.Bool   `tfsdk:"active"`
+}
+
+func (d *VtexSpecificationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_specifications"
+}
+
+func (d *VtexSpecificationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the specification groups, fields and predefined values of a catalog category.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Category ID",
+			},
+			"category_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the category to list the specifications of",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Specification groups of the category",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Specification group ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Group name",
+						},
+						"position": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Display order of the group",
+						},
+					},
+				},
+			},
+			"fields": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Specification fields of the category, including the ones inherited from parent categories",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Specification field ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Field name",
+						},
+						"group_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the group of the field",
+						},
+						"field_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Field type, as in vtex_specification_field",
+						},
+						"sku": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the field is a SKU specification",
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the field is active",
+						},
+						"values": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Predefined values of Combo, Radio and Checkbox fields",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.Int64Attribute{
+										Computed:    true,
+										Description: "Field value ID, as used by field_value_id of vtex_product_specification",
+									},
+									"value": schema.StringAttribute{
+										Computed:    true,
+										Description: "Value text",
+									},
+									"active": schema.BoolAttribute{
+										Computed:    true,
+										Description: "Whether the value is active",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"field_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Field IDs by field name",
+			},
+		},
+	}
+}
+
+func (d *VtexSpecificationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexSpecificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexSpecificationsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoryID, err := parseNumericID(data.CategoryID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("category_id"), "Invalid Category ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX category specifications", map[string]interface{}{
+		"category_id": categoryID,
+	})
+
+	groups, err := d.client.ListCategorySpecificationGroups(ctx, categoryID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Specifications",
+			"Could not list specification groups, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	fields, err := d.client.ListCategorySpecificationFields(ctx, categoryID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Specifications",
+			"Could not list specification fields, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The category listing has no group or type, so each field is read on its own
+	fieldIDs := make([]int64, 0, len(fields))
+	for _, field := range fields {
+		fieldIDs = append(fieldIDs, field.FieldID)
+	}
+
+	var mu sync.Mutex
+	details := make(map[int64]*client.SpecificationField, len(fields))
+	values := make(map[int64][]client.SpecificationFieldValue, len(fields))
+	err = forEachConcurrently(ctx, fieldIDs, func(ctx context.Context, fieldID int64) error {
+		field, err := d.client.GetSpecificationField(ctx, fieldID)
+		if err != nil {
+			return fmt.Errorf("field %d: %w", fieldID, err)
+		}
+
+		var fieldValues []client.SpecificationFieldValue
+		if specificationTypesWithValues[field.FieldTypeID] {
+			fieldValues, err = d.client.ListSpecificationFieldValues(ctx, fieldID)
+			if err != nil {
+				return fmt.Errorf("values of field %d: %w", fieldID, err)
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		details[fieldID] = field
+		values[fieldID] = fieldValues
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Specifications",
+			"Could not read specification field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(categoryID, 10))
+
+	data.Groups = make([]VtexSpecificationGroupItemModel, 0, len(groups))
+	for _, group := range groups {
+		position := types.Int64Null()
+		if group.Position != nil {
+			position = types.Int64Value(*group.Position)
+		}
+		data.Groups = append(data.Groups, VtexSpecificationGroupItemModel{
+			ID:       types.StringValue(strconv.FormatInt(group.ID, 10)),
+			Name:     types.StringValue(group.Name),
+			Position: position,
+		})
+	}
+
+	data.Fields = make([]VtexSpecificationFieldItemModel, 0, len(fields))
+	names := make(map[string]attr.Value, len(fields))
+	for _, field := range fields {
+		detail := details[field.FieldID]
+
+		fieldType := types.StringNull()
+		for name, id := range specificationFieldTypes {
+			if id == detail.FieldTypeID {
+				fieldType = types.StringValue(name)
+			}
+		}
+
+		fieldValues := make([]VtexSpecificationValueItemModel, 0, len(values[field.FieldID]))
+		for _, value := range values[field.FieldID] {
+			fieldValues = append(fieldValues, VtexSpecificationValueItemModel{
+				ID:     types.Int64Value(value.FieldValueID),
+				Value:  types.StringValue(value.Value),
+				Active: types.BoolValue(value.IsActive),
+			})
+		}
+
+		id := types.StringValue(strconv.FormatInt(field.FieldID, 10))
+		data.Fields = append(data.Fields, VtexSpecificationFieldItemModel{
+			ID:        id,
+			Name:      types.StringValue(field.Name),
+			GroupID:   types.StringValue(strconv.FormatInt(detail.FieldGroupID, 10)),
+			FieldType: fieldType,
+			SKU:       types.BoolValue(field.IsStockKeepingUnit),
+			Active:    types.BoolValue(field.IsActive),
+			Values:    fieldValues,
+		})
+
+		if _, ok := names[field.Name]; ok {
+			resp.Diagnostics.AddWarning(
+				"Duplicate Specification Field Name",
+				fmt.Sprintf("More than one field of the category is named %q; field_ids keeps the last one (%s). Use the fields list to tell them apart.", field.Name, id.ValueString()),
+			)
+		}
+		names[field.Name] = id
+	}
+
+	fieldIDsMap, diags := types.MapValue(types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	data.FieldIDs = fieldIDsMap
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
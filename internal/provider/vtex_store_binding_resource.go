@@ -0,0 +1,400 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexStoreBindingResource{}
+var _ resource.ResourceWithImportState = &VtexStoreBindingResource{}
+
+func NewVtexStoreBindingResource() resource.Resource {
+	return &VtexStoreBindingResource{}
+}
+
+// VtexStoreBindingResource is the resource implementation
+type VtexStoreBindingResource struct {
+	client *client.VtexClient
+}
+
+// VtexStoreBindingResourceModel is the resource data model
+type VtexStoreBindingResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Account              types.String `tfsdk:"account"`
+	Address              types.String `tfsdk:"address"`
+	AlternateAddresses   types.Set    `tfsdk:"alternate_addresses"`
+	DefaultLocale        types.String `tfsdk:"default_locale"`
+	AdditionalLocales    types.Set    `tfsdk:"additional_locales"`
+	DefaultCurrency      types.String `tfsdk:"default_currency"`
+	AdditionalCurrencies types.Set    `tfsdk:"additional_currencies"`
+	SalesChannelID       types.String `tfsdk:"sales_channel_id"`
+	TargetProduct        types.String `tfsdk:"target_product"`
+}
+
+func (r *VtexStoreBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store_binding"
+}
+
+func (r *VtexStoreBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a store binding: an address of the account bound to a storefront, with its locales, currencies and sales channel.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Binding ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account (tenant) that owns the binding (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "Canonical address of the store, a host with an optional path and no scheme, e.g. www.example.com/es",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: storeAddressPattern, name: "store address", example: "www.example.com/es"},
+				},
+			},
+			"alternate_addresses": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Other addresses that serve the same store and redirect to address",
+				Validators: []validator.Set{
+					setElementsValidator{inner: stringPatternValidator{pattern: storeAddressPattern, name: "store address", example: "example.com"}},
+				},
+			},
+			"default_locale": schema.StringAttribute{
+				Required:    true,
+				Description: "Locale of the store when the shopper does not choose one, e.g. es-AR",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: cultureInfoPattern, name: "culture", example: "es-AR"},
+				},
+			},
+			"additional_locales": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Other locales the store can be shown in, besides default_locale",
+				Validators: []validator.Set{
+					setElementsValidator{inner: stringPatternValidator{pattern: cultureInfoPattern, name: "culture", example: "en-US"}},
+				},
+			},
+			"default_currency": schema.StringAttribute{
+				Required:    true,
+				Description: "ISO 4217 currency of the store, e.g. ARS. Should match the currency of the sales channel",
+				Validators: []validator.String{
+					stringPatternValidator{pattern: currencyCodePattern, name: "ISO 4217 currency code", example: "ARS"},
+				},
+			},
+			"additional_currencies": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Other currencies the store supports, besides default_currency",
+				Validators: []validator.Set{
+					setElementsValidator{inner: stringPatternValidator{pattern: currencyCodePattern, name: "ISO 4217 currency code", example: "USD"}},
+				},
+			},
+			"sales_channel_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the sales channel (trade policy) the store sells in",
+			},
+			"target_product": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.BindingTargetStorefront),
+				Description: "VTEX product that answers on the address (default: vtex-storefront)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexStoreBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexStoreBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexStoreBindingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	binding, diags := bindingFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX store binding", map[string]interface{}{
+		"account": data.Account.ValueString(),
+		"address": binding.CanonicalBaseAddress,
+	})
+
+	result, err := r.client.CreateBinding(ctx, data.Account.ValueString(), binding)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Store Binding",
+			"Could not create store binding, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(bindingToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX store binding", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexStoreBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexStoreBindingResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX store binding", map[string]interface{}{
+		"account": data.Account.ValueString(),
+		"id":      data.ID.ValueString(),
+	})
+
+	binding, err := r.client.GetBinding(ctx, data.Account.ValueString(), data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX store binding not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Store Binding",
+			"Could not read store binding, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(bindingToModel(ctx, binding, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexStoreBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexStoreBindingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	binding, diags := bindingFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX store binding", map[string]interface{}{
+		"account": data.Account.ValueString(),
+		"id":      data.ID.ValueString(),
+	})
+
+	result, err := r.client.UpdateBinding(ctx, data.Account.ValueString(), data.ID.ValueString(), binding)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Store Binding",
+			"Could not update store binding, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(bindingToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexStoreBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexStoreBindingResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX store binding", map[string]interface{}{
+		"account": data.Account.ValueString(),
+		"id":      data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteBinding(ctx, data.Account.ValueString(), data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Store Binding",
+			"Could not delete store binding, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX store binding", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexStoreBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: account:binding_id
+	parts, err := decodeID(req.ID)
+	if err != nil || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: account:binding_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// bindingFromModel builds the API payload from the resource model. The API lists the
+// default locale and currency among the supported ones, so they are added first.
+func bindingFromModel(ctx context.Context, data VtexStoreBindingResourceModel) (client.Binding, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := parseNumericID(data.SalesChannelID.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("sales_channel_id"), "Invalid Sales Channel ID", err.Error())
+		return client.Binding{}, diags
+	}
+
+	alternateAddresses := []string{}
+	if !data.AlternateAddresses.IsNull() {
+		diags.Append(data.AlternateAddresses.ElementsAs(ctx, &alternateAddresses, false)...)
+	}
+	additionalLocales := []string{}
+	if !data.AdditionalLocales.IsNull() {
+		diags.Append(data.AdditionalLocales.ElementsAs(ctx, &additionalLocales, false)...)
+	}
+	additionalCurrencies := []string{}
+	if !data.AdditionalCurrencies.IsNull() {
+		diags.Append(data.AdditionalCurrencies.ElementsAs(ctx, &additionalCurrencies, false)...)
+	}
+	locales := append([]string{data.DefaultLocale.ValueString()}, additionalLocales...)
+	currencies := append([]string{data.DefaultCurrency.ValueString()}, additionalCurrencies...)
+
+	binding := client.Binding{
+		CanonicalBaseAddress:   data.Address.ValueString(),
+		AlternateBaseAddresses: alternateAddresses,
+		DefaultLocale:          data.DefaultLocale.ValueString(),
+		SupportedLocales:       withoutDuplicates(locales),
+		DefaultCurrency:        data.DefaultCurrency.ValueString(),
+		SupportedCurrencies:    withoutDuplicates(currencies),
+		TargetProduct:          data.TargetProduct.ValueString(),
+		ExtraContext: client.BindingExtraContext{
+			Portal: &client.BindingPortal{SalesChannel: data.SalesChannelID.ValueString()},
+		},
+	}
+	return binding, diags
+}
+
+// bindingToModel copies an API binding into the resource model
+func bindingToModel(ctx context.Context, binding *client.Binding, data *VtexStoreBindingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(binding.ID)
+	data.Address = types.StringValue(binding.CanonicalBaseAddress)
+	data.DefaultLocale = types.StringValue(binding.DefaultLocale)
+	data.DefaultCurrency = types.StringValue(binding.DefaultCurrency)
+	data.TargetProduct = types.StringValue(binding.TargetProduct)
+
+	data.SalesChannelID = types.StringValue("")
+	if binding.ExtraContext.Portal != nil {
+		data.SalesChannelID = types.StringValue(binding.ExtraContext.Portal.SalesChannel)
+	}
+
+	alternateAddresses, setDiags := optionalStringSet(ctx, binding.AlternateBaseAddresses, data.AlternateAddresses)
+	diags.Append(setDiags...)
+	data.AlternateAddresses = alternateAddresses
+
+	additionalLocales, setDiags := optionalStringSet(ctx, withoutValue(binding.SupportedLocales, binding.DefaultLocale), data.AdditionalLocales)
+	diags.Append(setDiags...)
+	data.AdditionalLocales = additionalLocales
+
+	additionalCurrencies, setDiags := optionalStringSet(ctx, withoutValue(binding.SupportedCurrencies, binding.DefaultCurrency), data.AdditionalCurrencies)
+	diags.Append(setDiags...)
+	data.AdditionalCurrencies = additionalCurrencies
+	return diags
+}
+
+// withoutDuplicates returns the values in order, keeping the first of repeated ones
+func withoutDuplicates(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// withoutValue returns the values other than value
+func withoutValue(values []string, value string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
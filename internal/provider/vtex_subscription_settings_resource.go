@@ -0,0 +1,651 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexSubscriptionSettingsResource{}
+var _ resource.ResourceWithImportState = &VtexSubscriptionSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &VtexSubscriptionSettingsResource{}
+
+// subscriptionSettingsID is the ID of the only Subscriptions settings of an account
+const subscriptionSettingsID = "subscriptions"
+
+// subscriptionFrequencyPattern matches a frequency of a subscription plan, like 2 week
+var subscriptionFrequencyPattern = regexp.MustCompile(`^[1-9][0-9]* (day|week|month|year)$`)
+
+// subscriptionPlanNamePattern matches the name of a subscription plan, the suffix of its attachment
+var subscriptionPlanNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Delivery channels and SLA options of subscription orders
+var (
+	subscriptionDeliveryChannels = []string{"delivery", "pickup-in-point"}
+	subscriptionSLAOptions       = []string{client.SubscriptionSLANone, client.SubscriptionSLACheapest, client.SubscriptionSLACustomerChoice}
+)
+
+func NewVtexSubscriptionSettingsResource() resource.Resource {
+	return &VtexSubscriptionSettingsResource{}
+}
+
+// VtexSubscriptionSettingsResource is the resource implementation
+type VtexSubscriptionSettingsResource struct {
+	client *client.VtexClient
+}
+
+// VtexSubscriptionSettingsResourceModel is the resource data model
+type VtexSubscriptionSettingsResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	ExecutionHourUTC      types.Int64  `tfsdk:"execution_hour_utc"`
+	DeliveryChannels      types.Set    `tfsdk:"delivery_channels"`
+	SLAOption             types.String `tfsdk:"sla_option"`
+	ManualPriceAllowed    types.Bool   `tfsdk:"manual_price_allowed"`
+	UseOriginalOrderPrice types.Bool   `tfsdk:"use_original_order_price"`
+	PostponeExpiration    types.Bool   `tfsdk:"postpone_expiration"`
+	Plans                 types.Map    `tfsdk:"plans"`
+	PlanAttachmentIDs     types.Map    `tfsdk:"plan_attachment_ids"`
+}
+
+// VtexSubscriptionPlanModel is a subscription plan: the frequencies and purchase days shoppers pick from
+type VtexSubscriptionPlanModel struct {
+	Frequencies  types.Set `tfsdk:"frequencies"`
+	PurchaseDays types.Set `tfsdk:"purchase_days"`
+}
+
+func (r *VtexSubscriptionSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_settings"
+}
+
+func (r *VtexSubscriptionSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Subscriptions (v3) settings of the account and its subscription plans. There is one per account; settings that are not set keep their current value.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always \"" + subscriptionSettingsID + "\"",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"execution_hour_utc": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Hour of the day (0 to 23, UTC) subscription orders are placed on their purchase day",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"delivery_channels": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Delivery channels subscriptions can use: delivery, pickup-in-point",
+				Validators: []validator.Set{
+					setElementsValidator{inner: stringOneOfValidator{values: subscriptionDeliveryChannels}},
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sla_option": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Delivery option of subscription orders: NONE (the one of the original order), CHEAPEST or CUSTOMER_CHOICE",
+				Validators: []validator.String{
+					stringOneOfValidator{values: subscriptionSLAOptions},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"manual_price_allowed": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether subscription items can have a manual price",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"use_original_order_price": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether subscription orders keep the item prices of the original order instead of the current ones",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"postpone_expiration": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether skipping a cycle moves the end of the subscription forward",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plans": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Subscription plans by name, each saved as the catalog attachment vtex.subscription.<name>",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"frequencies": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "Frequencies shoppers pick from, like 1 month or 2 week",
+						},
+						"purchase_days": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Days of the month (1 to 28) shoppers pick for their orders. Without them, orders are placed on the day of the original order",
+						},
+					},
+				},
+			},
+			"plan_attachment_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Attachment ID of each plan, to associate with the SKUs sold by subscription",
+			},
+		},
+	}
+}
+
+func (r *VtexSubscriptionSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexSubscriptionSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexSubscriptionSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.ExecutionHourUTC) && (data.ExecutionHourUTC.ValueInt64() < 0 || data.ExecutionHourUTC.ValueInt64() > 23) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("execution_hour_utc"),
+			"Invalid Execution Hour",
+			fmt.Sprintf("Expected an hour from 0 to 23, got: %d", data.ExecutionHourUTC.ValueInt64()),
+		)
+	}
+
+	// Unknown plans are checked again at apply time
+	if !isKnown(data.Plans) {
+		return
+	}
+
+	plans := map[string]VtexSubscriptionPlanModel{}
+	resp.Diagnostics.Append(data.Plans.ElementsAs(ctx, &plans, false)...)
+
+	for name, plan := range plans {
+		planPath := path.Root("plans").AtMapKey(name)
+		if !subscriptionPlanNamePattern.MatchString(name) {
+			resp.Diagnostics.AddAttributeError(
+				planPath,
+				"Invalid Subscription Plan Name",
+				fmt.Sprintf("Expected lowercase letters, digits and hyphens, like monthly-coffee, got: %q", name),
+			)
+		}
+
+		if isKnown(plan.Frequencies) {
+			if len(plan.Frequencies.Elements()) == 0 {
+				resp.Diagnostics.AddAttributeError(
+					planPath.AtName("frequencies"),
+					"Missing Subscription Frequencies",
+					"A subscription plan needs at least one frequency.",
+				)
+			}
+			var frequencies []string
+			resp.Diagnostics.Append(plan.Frequencies.ElementsAs(ctx, &frequencies, false)...)
+			for _, frequency := range frequencies {
+				if !subscriptionFrequencyPattern.MatchString(frequency) {
+					resp.Diagnostics.AddAttributeError(
+						planPath.AtName("frequencies"),
+						"Invalid Subscription Frequency",
+						fmt.Sprintf("Expected a number and a unit (day, week, month or year), like 2 week, got: %q", frequency),
+					)
+				}
+			}
+		}
+
+		if isKnown(plan.PurchaseDays) {
+			var days []string
+			resp.Diagnostics.Append(plan.PurchaseDays.ElementsAs(ctx, &days, false)...)
+			for _, day := range days {
+				if n, err := strconv.Atoi(day); err != nil || n < 1 || n > 28 {
+					resp.Diagnostics.AddAttributeError(
+						planPath.AtName("purchase_days"),
+						"Invalid Purchase Day",
+						fmt.Sprintf("Expected a day of the month from 1 to 28, got: %q", day),
+					)
+				}
+			}
+		}
+	}
+}
+
+func (r *VtexSubscriptionSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexSubscriptionSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX subscription settings")
+
+	settings, err := r.updateSettings(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Subscription Settings",
+			"Could not update subscription settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(subscriptionSettingsToModel(ctx, settings, &data)...)
+
+	attachmentIDs, err := r.savePlans(ctx, data.Plans, map[string]int64{})
+	resp.Diagnostics.Append(setPlanAttachmentIDs(ctx, attachmentIDs, &data)...)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("plans"),
+			"Error Creating VTEX Subscription Settings",
+			"Could not save subscription plans, unexpected error: "+err.Error(),
+		)
+		// Keep the plans that were saved in state so they are not orphaned
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Trace(ctx, "Created VTEX subscription settings", map[string]interface{}{
+		"plans": len(attachmentIDs),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSubscriptionSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexSubscriptionSettingsResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX subscription settings")
+
+	settings, err := r.client.GetSubscriptionSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Subscription Settings",
+			"Could not read subscription settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(subscriptionSettingsToModel(ctx, settings, &data)...)
+
+	attachmentIDs, diags := planAttachmentIDs(ctx, data.PlanAttachmentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Plans deleted outside Terraform are dropped, so the next apply creates them again
+	plans := map[string]VtexSubscriptionPlanModel{}
+	for name, attachmentID := range attachmentIDs {
+		attachment, err := r.client.GetAttachment(ctx, attachmentID)
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "VTEX subscription plan not found, removing it from state", map[string]interface{}{
+				"plan":          name,
+				"attachment_id": attachmentID,
+			})
+			delete(attachmentIDs, name)
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX Subscription Settings",
+				fmt.Sprintf("Could not read subscription plan %s, unexpected error: %s", name, err.Error()),
+			)
+			return
+		}
+		plan, diags := subscriptionPlanToModel(ctx, attachment)
+		resp.Diagnostics.Append(diags...)
+		plans[name] = plan
+	}
+
+	if len(plans) > 0 || !data.Plans.IsNull() {
+		plansMap, diags := types.MapValueFrom(ctx, data.Plans.ElementType(ctx), plans)
+		resp.Diagnostics.Append(diags...)
+		data.Plans = plansMap
+	}
+	resp.Diagnostics.Append(setPlanAttachmentIDs(ctx, attachmentIDs, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexSubscriptionSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexSubscriptionSettingsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentIDs, diags := planAttachmentIDs(ctx, state.PlanAttachmentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VTEX subscription settings")
+
+	settings, err := r.updateSettings(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Subscription Settings",
+			"Could not update subscription settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(subscriptionSettingsToModel(ctx, settings, &plan)...)
+
+	attachmentIDs, err := r.savePlans(ctx, plan.Plans, currentIDs)
+	resp.Diagnostics.Append(setPlanAttachmentIDs(ctx, attachmentIDs, &plan)...)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("plans"),
+			"Error Updating VTEX Subscription Settings",
+			"Could not save subscription plans, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexSubscriptionSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexSubscriptionSettingsResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentIDs, diags := planAttachmentIDs(ctx, data.PlanAttachmentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The settings cannot be deleted, so only the plans are
+	tflog.Debug(ctx, "Deleting VTEX subscription plans, the account keeps its subscription settings", map[string]interface{}{
+		"plans": len(currentIDs),
+	})
+
+	if _, err := r.savePlans(ctx, types.MapNull(data.Plans.ElementType(ctx)), currentIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Subscription Settings",
+			"Could not delete subscription plans, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX subscription plans")
+}
+
+func (r *VtexSubscriptionSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: "subscriptions"
+	if req.ID != subscriptionSettingsID {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("expected %q, got: %q", subscriptionSettingsID, req.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateSettings applies the configured arguments over the current settings
+// and reads the result back
+func (r *VtexSubscriptionSettingsResource) updateSettings(ctx context.Context, data VtexSubscriptionSettingsResourceModel) (*client.SubscriptionSettings, error) {
+	settings, err := r.client.GetSubscriptionSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if isKnown(data.ExecutionHourUTC) {
+		settings.ExecutionHourInUTC = data.ExecutionHourUTC.ValueInt64()
+	}
+	if isKnown(data.DeliveryChannels) {
+		var channels []string
+		data.DeliveryChannels.ElementsAs(ctx, &channels, false)
+		sort.Strings(channels)
+		settings.DeliveryChannels = channels
+	}
+	if isKnown(data.SLAOption) {
+		settings.SLAOption = data.SLAOption.ValueString()
+	}
+	if isKnown(data.ManualPriceAllowed) {
+		settings.ManualPriceAllowed = data.ManualPriceAllowed.ValueBool()
+	}
+	if isKnown(data.UseOriginalOrderPrice) {
+		settings.UseItemPriceFromOriginalOrder = data.UseOriginalOrderPrice.ValueBool()
+	}
+	if isKnown(data.PostponeExpiration) {
+		settings.PostponeExpiration = data.PostponeExpiration.ValueBool()
+	}
+
+	return r.client.UpdateSubscriptionSettings(ctx, *settings)
+}
+
+// savePlans creates or updates the attachment of every planned plan and deletes the attachments
+// of the current plans that are no longer planned. It returns the attachment IDs of the plans
+// that exist afterwards, also when it fails.
+func (r *VtexSubscriptionSettingsResource) savePlans(ctx context.Context, planned types.Map, current map[string]int64) (map[string]int64, error) {
+	plans := map[string]VtexSubscriptionPlanModel{}
+	if !planned.IsNull() && !planned.IsUnknown() {
+		if diags := planned.ElementsAs(ctx, &plans, false); diags.HasError() {
+			return current, fmt.Errorf("could not read the planned subscription plans")
+		}
+	}
+
+	attachmentIDs := make(map[string]int64, len(current))
+	for name, attachmentID := range current {
+		attachmentIDs[name] = attachmentID
+	}
+
+	names := make([]string, 0, len(plans))
+	for name := range plans {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attachment := subscriptionPlanFromModel(ctx, name, plans[name])
+		if attachmentID, ok := attachmentIDs[name]; ok {
+			if _, err := r.client.UpdateAttachment(ctx, attachmentID, attachment); err != nil {
+				return attachmentIDs, fmt.Errorf("could not update plan %s: %w", name, err)
+			}
+			continue
+		}
+
+		created, err := r.client.CreateAttachment(ctx, attachment)
+		if err != nil {
+			return attachmentIDs, fmt.Errorf("could not create plan %s: %w", name, err)
+		}
+		attachmentIDs[name] = created.ID
+	}
+
+	for name, attachmentID := range current {
+		if _, ok := plans[name]; ok {
+			continue
+		}
+		// The catalog refuses to delete attachments that SKUs still have
+		if err := r.client.DeleteAttachment(ctx, attachmentID); err != nil && !client.IsNotFound(err) {
+			return attachmentIDs, fmt.Errorf("could not delete plan %s, remove it from its SKUs first: %w", name, err)
+		}
+		delete(attachmentIDs, name)
+	}
+	return attachmentIDs, nil
+}
+
+// setPlanAttachmentIDs saves the attachment IDs of the plans into the resource model
+func setPlanAttachmentIDs(ctx context.Context, attachmentIDs map[string]int64, data *VtexSubscriptionSettingsResourceModel) diag.Diagnostics {
+	ids := make(map[string]string, len(attachmentIDs))
+	for name, attachmentID := range attachmentIDs {
+		ids[name] = strconv.FormatInt(attachmentID, 10)
+	}
+	idsMap, diags := types.MapValueFrom(ctx, types.StringType, ids)
+	data.PlanAttachmentIDs = idsMap
+	return diags
+}
+
+// planAttachmentIDs returns the attachment IDs of a plan_attachment_ids map by plan name
+func planAttachmentIDs(ctx context.Context, ids types.Map) (map[string]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attachmentIDs := map[string]int64{}
+	if ids.IsNull() || ids.IsUnknown() {
+		return attachmentIDs, diags
+	}
+
+	values := map[string]string{}
+	diags.Append(ids.ElementsAs(ctx, &values, false)...)
+	for name, value := range values {
+		attachmentID, err := parseNumericID(value)
+		if err != nil {
+			diags.AddError("Invalid VTEX Attachment ID", err.Error())
+			continue
+		}
+		attachmentIDs[name] = attachmentID
+	}
+	return attachmentIDs, diags
+}
+
+// subscriptionSettingsToModel copies the API subscription settings into the resource model
+func subscriptionSettingsToModel(ctx context.Context, settings *client.SubscriptionSettings, data *VtexSubscriptionSettingsResourceModel) diag.Diagnostics {
+	data.ID = types.StringValue(subscriptionSettingsID)
+	data.ExecutionHourUTC = types.Int64Value(settings.ExecutionHourInUTC)
+	data.SLAOption = types.StringValue(settings.SLAOption)
+	data.ManualPriceAllowed = types.BoolValue(settings.ManualPriceAllowed)
+	data.UseOriginalOrderPrice = types.BoolValue(settings.UseItemPriceFromOriginalOrder)
+	data.PostponeExpiration = types.BoolValue(settings.PostponeExpiration)
+
+	channels := settings.DeliveryChannels
+	if channels == nil {
+		channels = []string{}
+	}
+	set, diags := types.SetValueFrom(ctx, types.StringType, channels)
+	data.DeliveryChannels = set
+	return diags
+}
+
+// subscriptionPlanFromModel builds the catalog attachment of a subscription plan
+func subscriptionPlanFromModel(ctx context.Context, name string, plan VtexSubscriptionPlanModel) client.Attachment {
+	var frequencies, days []string
+	plan.Frequencies.ElementsAs(ctx, &frequencies, false)
+	if !plan.PurchaseDays.IsNull() {
+		plan.PurchaseDays.ElementsAs(ctx, &days, false)
+	}
+	sort.Strings(frequencies)
+	sort.Slice(days, func(i, j int) bool {
+		a, _ := strconv.Atoi(days[i])
+		b, _ := strconv.Atoi(days[j])
+		return a < b
+	})
+
+	domains := []client.AttachmentDomain{{
+		FieldName:    client.SubscriptionFrequencyField,
+		MaxCaracters: client.SubscriptionFieldMaxCharacters,
+		DomainValues: strings.Join(frequencies, ","),
+	}}
+	if len(days) > 0 {
+		domains = append(domains, client.AttachmentDomain{
+			FieldName:    client.SubscriptionPurchaseDayField,
+			MaxCaracters: client.SubscriptionFieldMaxCharacters,
+			DomainValues: strings.Join(days, ","),
+		})
+	}
+
+	return client.Attachment{
+		Name:       client.SubscriptionPlanPrefix + name,
+		IsRequired: false,
+		IsActive:   true,
+		Domains:    domains,
+	}
+}
+
+// subscriptionPlanToModel reads the frequencies and purchase days of a subscription plan attachment
+func subscriptionPlanToModel(ctx context.Context, attachment *client.Attachment) (VtexSubscriptionPlanModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := map[string][]string{}
+	for _, domain := range attachment.Domains {
+		for _, value := range strings.Split(domain.DomainValues, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values[domain.FieldName] = append(values[domain.FieldName], value)
+			}
+		}
+	}
+
+	plan := VtexSubscriptionPlanModel{PurchaseDays: types.SetNull(types.StringType)}
+	frequencies, setDiags := types.SetValueFrom(ctx, types.StringType, append([]string{}, values[client.SubscriptionFrequencyField]...))
+	diags.Append(setDiags...)
+	plan.Frequencies = frequencies
+
+	if days := values[client.SubscriptionPurchaseDayField]; len(days) > 0 {
+		purchaseDays, setDiags := types.SetValueFrom(ctx, types.StringType, days)
+		diags.Append(setDiags...)
+		plan.PurchaseDays = purchaseDays
+	}
+	return plan, diags
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexSubscriptionsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexSubscriptionsDataSource{}
+
+// Default and largest number of subscriptions the data source lists
+const (
+	subscriptionsDefaultLimit = 100
+	subscriptionsMaxLimit     = 5000
+)
+
+func NewVtexSubscriptionsDataSource() datasource.DataSource {
+	return &VtexSubscriptionsDataSource{}
+}
+
+// VtexSubscriptionsDataSource is the data source implementation
+type VtexSubscriptionsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexSubscriptionsDataSourceModel is the data source data model
+type VtexSubscriptionsDataSourceModel struct {
+	ID            types.String                `tfsdk:"id"`
+	CustomerEmail types.String                `tfsdk:"customer_email"`
+	Status        types.String                `tfsdk:"status"`
+	Limit         types.Int64                 `tfsdk:"limit"`
+	Subscriptions []VtexSubscriptionItemModel `tfsdk:"subscriptions"`
+}
+
+// VtexSubscriptionItemModel is a subscription in the list
+type VtexSubscriptionItemModel struct {
+	ID               types.String               `tfsdk:"id"`
+	CustomerEmail    types.String               `tfsdk:"customer_email"`
+	Title            types.String               `tfsdk:"title"`
+	Status           types.String               `tfsdk:"status"`
+	IsSkipped        types.Bool                 `tfsdk:"is_skipped"`
+	Plan             types.String               `tfsdk:"plan"`
+	Periodicity      types.String               `tfsdk:"periodicity"`
+	Interval         types.Int64                `tfsdk:"interval"`
+	PurchaseDay      types.String               `tfsdk:"purchase_day"`
+	SalesChannel     types.String               `tfsdk:"sales_channel"`
+	NextPurchaseDate types.String               `tfsdk:"next_purchase_date"`
+	LastPurchaseDate types.String               `tfsdk:"last_purchase_date"`
+	CreatedAt        types.String               `tfsdk:"created_at"`
+	Items            []VtexSubscriptionSKUModel `tfsdk:"items"`
+}
+
+// VtexSubscriptionSKUModel is a SKU of a subscription in the list
+type VtexSubscriptionSKUModel struct {
+	SKUID    types.String `tfsdk:"sku_id"`
+	Quantity types.Int64  `tfsdk:"quantity"`
+	Status   types.String `tfsdk:"status"`
+}
+
+func (d *VtexSubscriptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriptions"
+}
+
+func (d *VtexSubscriptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the subscriptions of the account, optionally of a shopper or in a status.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Filters used, or all",
+			},
+			"customer_email": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the subscriptions of the shopper with this email",
+				Validators: []validator.String{
+					emailValidator{},
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the subscriptions in this status: ACTIVE, PAUSED, CANCELED, EXPIRED or MISSING",
+				Validators: []validator.String{
+					stringOneOfValidator{values: client.SubscriptionStatuses},
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Largest number of subscriptions to list, up to %d (default: %d)", subscriptionsMaxLimit, subscriptionsDefaultLimit),
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Subscriptions found, in the order the API lists them",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Subscription ID",
+						},
+						"customer_email": schema.StringAttribute{
+							Computed:    true,
+							Description: "Email of the shopper",
+						},
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name the shopper gave the subscription",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the subscription",
+						},
+						"is_skipped": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the next purchase is skipped",
+						},
+						"plan": schema.StringAttribute{
+							Computed:    true,
+							Description: "Subscription plan, e.g. vtex.subscription.weekly",
+						},
+						"periodicity": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unit of the frequency: DAILY, WEEKLY, MONTHLY or YEARLY",
+						},
+						"interval": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of periodicity units between purchases",
+						},
+						"purchase_day": schema.StringAttribute{
+							Computed:    true,
+							Description: "Day of the period the purchase is placed, if any",
+						},
+						"sales_channel": schema.StringAttribute{
+							Computed:    true,
+							Description: "Trade policy of the purchases",
+						},
+						"next_purchase_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the next purchase is placed",
+						},
+						"last_purchase_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the last purchase was placed",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the subscription was created",
+						},
+						"items": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "SKUs of the subscription",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"sku_id": schema.StringAttribute{
+										Computed:    true,
+										Description: "SKU ID",
+									},
+									"quantity": schema.Int64Attribute{
+										Computed:    true,
+										Description: "Quantity bought in each purchase",
+									},
+									"status": schema.StringAttribute{
+										Computed:    true,
+										Description: "Status of the SKU in the subscription",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexSubscriptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexSubscriptionsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexSubscriptionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(data.Limit) && (data.Limit.ValueInt64() < 1 || data.Limit.ValueInt64() > subscriptionsMaxLimit) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Invalid Limit",
+			fmt.Sprintf("Expected 1 to %d subscriptions, got: %d", subscriptionsMaxLimit, data.Limit.ValueInt64()),
+		)
+	}
+}
+
+func (d *VtexSubscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexSubscriptionsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	search := client.SubscriptionSearch{
+		CustomerEmail: data.CustomerEmail.ValueString(),
+		Status:        data.Status.ValueString(),
+	}
+
+	limit := int64(subscriptionsDefaultLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Listing VTEX subscriptions", map[string]interface{}{
+		"status": search.Status,
+		"limit":  limit,
+	})
+
+	subscriptions, err := d.client.ListSubscriptions(ctx, search, int(limit))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Subscriptions",
+			"Could not list subscriptions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Subscriptions = make([]VtexSubscriptionItemModel, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		items := make([]VtexSubscriptionSKUModel, 0, len(subscription.Items))
+		for _, item := range subscription.Items {
+			items = append(items, VtexSubscriptionSKUModel{
+				SKUID:    types.StringValue(item.SKUID),
+				Quantity: types.Int64Value(item.Quantity),
+				Status:   types.StringValue(item.Status),
+			})
+		}
+
+		data.Subscriptions = append(data.Subscriptions, VtexSubscriptionItemModel{
+			ID:               types.StringValue(subscription.ID),
+			CustomerEmail:    types.StringValue(subscription.CustomerEmail),
+			Title:            types.StringValue(subscription.Title),
+			Status:           types.StringValue(subscription.Status),
+			IsSkipped:        types.BoolValue(subscription.IsSkipped),
+			Plan:             types.StringValue(subscription.Plan.ID),
+			Periodicity:      types.StringValue(subscription.Plan.Frequency.Periodicity),
+			Interval:         types.Int64Value(subscription.Plan.Frequency.Interval),
+			PurchaseDay:      types.StringValue(subscription.Plan.PurchaseDay),
+			SalesChannel:     types.StringValue(subscription.PurchaseSettings.SalesChannel),
+			NextPurchaseDate: types.StringValue(subscription.NextPurchaseDate),
+			LastPurchaseDate: types.StringValue(subscription.LastPurchaseDate),
+			CreatedAt:        types.StringValue(subscription.CreatedAt),
+			Items:            items,
+		})
+	}
+
+	data.ID = types.StringValue("all")
+	if search.CustomerEmail != "" || search.Status != "" || !data.Limit.IsNull() {
+		data.ID = types.StringValue(encodeID(search.CustomerEmail, search.Status, strconv.FormatInt(limit, 10)))
+	}
+
+	tflog.Trace(ctx, "Listed VTEX subscriptions", map[string]interface{}{
+		"count": len(data.Subscriptions),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,443 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexTaxRuleResource{}
+var _ resource.ResourceWithImportState = &VtexTaxRuleResource{}
+var _ resource.ResourceWithValidateConfig = &VtexTaxRuleResource{}
+
+func NewVtexTaxRuleResource() resource.Resource {
+	return &VtexTaxRuleResource{}
+}
+
+// VtexTaxRuleResource is the resource implementation
+type VtexTaxRuleResource struct {
+	client *client.VtexClient
+}
+
+// VtexTaxRuleResourceModel is the resource data model
+type VtexTaxRuleResourceModel struct {
+	ID               types.String  `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	Description      types.String  `tfsdk:"description"`
+	Active           types.Bool    `tfsdk:"active"`
+	BeginDate        types.String  `tfsdk:"begin_date"`
+	EndDate          types.String  `tfsdk:"end_date"`
+	Percentage       types.Float64 `tfsdk:"percentage"`
+	Amount           types.Float64 `tfsdk:"amount"`
+	SalesChannels    types.Set     `tfsdk:"sales_channels"`
+	PostalCodeRanges types.List    `tfsdk:"postal_code_ranges"`
+	CategoryIDs      types.Set     `tfsdk:"category_ids"`
+}
+
+// VtexTaxRulePostalCodeRangeModel is a range of postal codes the tax applies to
+type VtexTaxRulePostalCodeRangeModel struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+// taxRulePostalCodeRangeAttrTypes are the attribute types of a postal_code_ranges element
+var taxRulePostalCodeRangeAttrTypes = map[string]attr.Type{
+	"from": types.StringType,
+	"to":   types.StringType,
+}
+
+func (r *VtexTaxRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tax_rule"
+}
+
+func (r *VtexTaxRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a tax of the Promotions & Taxes API: a percentage or fixed amount added to the price, scoped by trade policy, postal code ranges and categories.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Tax ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Tax name, e.g. ICMS SP",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Internal description of the tax",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the tax is applied",
+			},
+			"begin_date": schema.StringAttribute{
+				Required:    true,
+				Description: "Start of the tax, as an RFC 3339 date",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+			"end_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the tax, as an RFC 3339 date (default: no end)",
+				Validators: []validator.String{
+					dateTimeValidator{},
+				},
+			},
+			"percentage": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Tax as a percentage of the item price, e.g. 18. Conflicts with amount",
+			},
+			"amount": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Tax as a fixed amount per item, in the currency of the trade policy. Conflicts with percentage",
+			},
+			"sales_channels": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the trade policies the tax applies to (default: all)",
+			},
+			"postal_code_ranges": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Postal code ranges of the delivery address the tax applies to (default: all)",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from": schema.StringAttribute{
+							Required:    true,
+							Description: "First postal code of the range",
+						},
+						"to": schema.StringAttribute{
+							Required:    true,
+							Description: "Last postal code of the range",
+						},
+					},
+				},
+			},
+			"category_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the categories the tax applies to (default: all)",
+			},
+		},
+	}
+}
+
+func (r *VtexTaxRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexTaxRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexTaxRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if !data.Percentage.IsUnknown() && !data.Amount.IsUnknown() {
+		if data.Percentage.IsNull() == data.Amount.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("percentage"),
+				"Invalid Tax Rule Value",
+				"Exactly one of percentage or amount must be set.",
+			)
+		}
+		if data.Percentage.ValueFloat64() < 0 || data.Amount.ValueFloat64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("percentage"),
+				"Invalid Tax Rule Value",
+				"percentage and amount cannot be negative.",
+			)
+		}
+	}
+
+	if isKnown(data.BeginDate) && isKnown(data.EndDate) {
+		begin, errBegin := parseDateTime(data.BeginDate.ValueString())
+		end, errEnd := parseDateTime(data.EndDate.ValueString())
+		if errBegin == nil && errEnd == nil && !end.After(begin) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_date"),
+				"Invalid Tax Rule Dates",
+				"end_date must be after begin_date.",
+			)
+		}
+	}
+}
+
+func (r *VtexTaxRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexTaxRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tax, diags := taxFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX tax rule", map[string]interface{}{
+		"name": tax.Name,
+	})
+
+	result, err := r.client.SaveTax(ctx, tax)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX Tax Rule",
+			"Could not create tax rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(taxToModel(ctx, result, &data)...)
+
+	tflog.Trace(ctx, "Created VTEX tax rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexTaxRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexTaxRuleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX tax rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	tax, err := r.client.GetTax(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) || (err == nil && tax.IsArchived) {
+		tflog.Warn(ctx, "VTEX tax rule not found or archived, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Tax Rule",
+			"Could not read tax rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(taxToModel(ctx, tax, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexTaxRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VtexTaxRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tax, diags := taxFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tax.ID = data.ID.ValueString()
+
+	tflog.Debug(ctx, "Updating VTEX tax rule", map[string]interface{}{
+		"id": tax.ID,
+	})
+
+	result, err := r.client.SaveTax(ctx, tax)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX Tax Rule",
+			"Could not update tax rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(taxToModel(ctx, result, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexTaxRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexTaxRuleResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Promotions & Taxes API cannot delete taxes, so they are archived
+	tflog.Debug(ctx, "Archiving VTEX tax rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.ArchiveCalculatorConfiguration(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX Tax Rule",
+			"Could not archive tax rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Archived VTEX tax rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexTaxRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: tax ID (idCalculatorConfiguration)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// taxFromModel builds the API payload from the resource model
+func taxFromModel(ctx context.Context, data VtexTaxRuleResourceModel) (client.Tax, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	salesChannels := []string{}
+	if !data.SalesChannels.IsNull() {
+		diags.Append(data.SalesChannels.ElementsAs(ctx, &salesChannels, false)...)
+	}
+
+	var ranges []VtexTaxRulePostalCodeRangeModel
+	if !data.PostalCodeRanges.IsNull() {
+		diags.Append(data.PostalCodeRanges.ElementsAs(ctx, &ranges, false)...)
+	}
+	zipCodeRanges := make([]client.TaxZipCodeRange, 0, len(ranges))
+	for _, postalCodeRange := range ranges {
+		zipCodeRanges = append(zipCodeRanges, client.TaxZipCodeRange{
+			ZipCodeFrom: postalCodeRange.From.ValueString(),
+			ZipCodeTo:   postalCodeRange.To.ValueString(),
+			Inclusive:   true,
+		})
+	}
+
+	var categoryIDs []string
+	if !data.CategoryIDs.IsNull() {
+		diags.Append(data.CategoryIDs.ElementsAs(ctx, &categoryIDs, false)...)
+	}
+	categories := make([]client.PromotionItem, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		categories = append(categories, client.PromotionItem{ID: categoryID})
+	}
+
+	tax := client.Tax{
+		Name:                   data.Name.ValueString(),
+		Description:            data.Description.ValueString(),
+		BeginDateUTC:           formatDateTimeRFC3339(data.BeginDate.ValueString()),
+		EndDateUTC:             formatDateTimeRFC3339(data.EndDate.ValueString()),
+		IsActive:               data.Active.ValueBool(),
+		PercentualTax:          data.Percentage.ValueFloat64(),
+		NominalTax:             data.Amount.ValueFloat64(),
+		IDsSalesChannel:        salesChannels,
+		ZipCodeRanges:          zipCodeRanges,
+		Categories:             categories,
+		CategoriesAreInclusive: true,
+	}
+	return tax, diags
+}
+
+// taxToModel copies an API tax into the resource model
+func taxToModel(ctx context.Context, tax *client.Tax, data *VtexTaxRuleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(tax.ID)
+	data.Name = types.StringValue(tax.Name)
+	data.Description = optionalString(tax.Description, data.Description)
+	data.Active = types.BoolValue(tax.IsActive)
+	data.BeginDate = dateTimeValue(tax.BeginDateUTC, data.BeginDate)
+	data.EndDate = dateTimeValue(tax.EndDateUTC, data.EndDate)
+	data.Percentage = taxValue(tax.PercentualTax, data.Percentage)
+	data.Amount = taxValue(tax.NominalTax, data.Amount)
+
+	salesChannels, setDiags := optionalStringSet(ctx, tax.IDsSalesChannel, data.SalesChannels)
+	diags.Append(setDiags...)
+	data.SalesChannels = salesChannels
+
+	if len(tax.ZipCodeRanges) == 0 && data.PostalCodeRanges.IsNull() {
+		data.PostalCodeRanges = types.ListNull(types.ObjectType{AttrTypes: taxRulePostalCodeRangeAttrTypes})
+	} else {
+		ranges := make([]VtexTaxRulePostalCodeRangeModel, 0, len(tax.ZipCodeRanges))
+		for _, zipCodeRange := range tax.ZipCodeRanges {
+			ranges = append(ranges, VtexTaxRulePostalCodeRangeModel{
+				From: types.StringValue(zipCodeRange.ZipCodeFrom),
+				To:   types.StringValue(zipCodeRange.ZipCodeTo),
+			})
+		}
+		list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: taxRulePostalCodeRangeAttrTypes}, ranges)
+		diags.Append(listDiags...)
+		data.PostalCodeRanges = list
+	}
+
+	categoryIDs := make([]string, 0, len(tax.Categories))
+	for _, category := range tax.Categories {
+		categoryIDs = append(categoryIDs, category.ID)
+	}
+	categories, setDiags := optionalStringSet(ctx, categoryIDs, data.CategoryIDs)
+	diags.Append(setDiags...)
+	data.CategoryIDs = categories
+	return diags
+}
+
+// taxValue returns the API percentage or amount, or null when it is zero and not configured,
+// since the API keeps both and only one of them is set
+func taxValue(apiValue float64, current types.Float64) types.Float64 {
+	if apiValue == 0 && current.IsNull() {
+		return types.Float64Null()
+	}
+	return types.Float64Value(apiValue)
+}
@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexTradePolicyDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VtexTradePolicyDataSource{}
+
+func NewVtexTradePolicyDataSource() datasource.DataSource {
+	return &VtexTradePolicyDataSource{}
+}
+
+// VtexTradePolicyDataSource is the data source implementation
+type VtexTradePolicyDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexTradePolicyDataSourceModel is the data source data model
+type VtexTradePolicyDataSourceModel struct {
+	ID                    types.String                  `tfsdk:"id"`
+	Name                  types.String                  `tfsdk:"name"`
+	Active                types.Bool                    `tfsdk:"active"`
+	CountryCode           types.String                  `tfsdk:"country_code"`
+	CultureInfo           types.String                  `tfsdk:"culture_info"`
+	CurrencyCode          types.String                  `tfsdk:"currency_code"`
+	CurrencySymbol        types.String                  `tfsdk:"currency_symbol"`
+	CurrencyDecimalDigits types.Int64                   `tfsdk:"currency_decimal_digits"`
+	TimeZone              types.String                  `tfsdk:"time_zone"`
+	ProductClusterID      types.String                  `tfsdk:"product_cluster_id"`
+	Sellers               []VtexTradePolicySellerModel  `tfsdk:"sellers"`
+	PaymentRules          []VtexTradePolicyPaymentModel `tfsdk:"payment_rules"`
+	DockIDs               []types.String                `tfsdk:"dock_ids"`
+	ShippingPolicyIDs     []types.String                `tfsdk:"shipping_policy_ids"`
+}
+
+// VtexTradePolicySellerModel is a seller of the trade policy
+type VtexTradePolicySellerModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Active types.Bool   `tfsdk:"active"`
+}
+
+// VtexTradePolicyPaymentModel is a payment rule of the trade policy
+type VtexTradePolicyPaymentModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	PaymentSystem types.String `tfsdk:"payment_system"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *VtexTradePolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trade_policy"
+}
+
+func (d *VtexTradePolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a trade policy (sales channel) by ID or name, with its sellers, payment rules and docks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Trade policy ID to look up. Conflicts with name",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Trade policy name to look up. Conflicts with id",
+			},
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the trade policy is active",
+			},
+			"country_code": schema.StringAttribute{
+				Computed:    true,
+				Description: "Three-letter country code",
+			},
+			"culture_info": schema.StringAttribute{
+				Computed:    true,
+				Description: "Language and region, e.g. pt-BR",
+			},
+			"currency_code": schema.StringAttribute{
+				Computed:    true,
+				Description: "ISO 4217 currency code",
+			},
+			"currency_symbol": schema.StringAttribute{
+				Computed:    true,
+				Description: "Currency symbol",
+			},
+			"currency_decimal_digits": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Decimal digits of amounts in the currency",
+			},
+			"time_zone": schema.StringAttribute{
+				Computed:    true,
+				Description: "Windows time zone name",
+			},
+			"product_cluster_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the collection that limits the catalog, or null",
+			},
+			"sellers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Sellers that sell in the trade policy",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Seller ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Seller name",
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the seller is active",
+						},
+					},
+				},
+			},
+			"payment_rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Payment rules (conditions) that apply to the trade policy",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Payment rule ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Payment rule name",
+						},
+						"payment_system": schema.StringAttribute{
+							Computed:    true,
+							Description: "Payment system offered, e.g. Visa",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the payment rule is enabled",
+						},
+					},
+				},
+			},
+			"dock_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the docks that serve the trade policy",
+			},
+			"shipping_policy_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the shipping policies of those docks, sorted",
+			},
+		},
+	}
+}
+
+func (d *VtexTradePolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexTradePolicyDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VtexTradePolicyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Trade Policy Lookup",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+func (d *VtexTradePolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexTradePolicyDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX trade policy", map[string]interface{}{
+		"id":   data.ID.ValueString(),
+		"name": data.Name.ValueString(),
+	})
+
+	var salesChannel *client.SalesChannel
+	if !data.ID.IsNull() {
+		salesChannelID, err := parseNumericID(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid VTEX Trade Policy ID", err.Error())
+			return
+		}
+
+		salesChannel, err = d.client.GetSalesChannel(ctx, salesChannelID)
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"VTEX Trade Policy Not Found",
+				fmt.Sprintf("No trade policy with ID %d in the account", salesChannelID),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX Trade Policy",
+				"Could not read trade policy, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		salesChannels, err := d.client.ListSalesChannels(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX Trade Policy",
+				"Could not list trade policies, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		var matches []int64
+		for i := range salesChannels {
+			if salesChannels[i].Name == data.Name.ValueString() {
+				salesChannel = &salesChannels[i]
+				matches = append(matches, salesChannels[i].ID)
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"VTEX Trade Policy Not Found",
+				fmt.Sprintf("No trade policy named %q in the account", data.Name.ValueString()),
+			)
+			return
+		}
+		if len(matches) > 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Ambiguous VTEX Trade Policy Name",
+				fmt.Sprintf("Trade policies %v are all named %q, look one up by id instead", matches, data.Name.ValueString()),
+			)
+			return
+		}
+	}
+
+	sellers, err := d.client.ListSellers(ctx, salesChannel.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Trade Policy",
+			"Could not list sellers, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	paymentRules, err := d.client.ListPaymentRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Trade Policy",
+			"Could not list payment rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	docks, err := d.client.ListDocks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Trade Policy",
+			"Could not list docks, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := strconv.FormatInt(salesChannel.ID, 10)
+	data.ID = types.StringValue(id)
+	data.Name = types.StringValue(salesChannel.Name)
+	data.Active = types.BoolValue(salesChannel.IsActive)
+	data.CountryCode = types.StringValue(salesChannel.CountryCode)
+	data.CultureInfo = types.StringValue(salesChannel.CultureInfo)
+	data.CurrencyCode = types.StringValue(salesChannel.CurrencyCode)
+	data.CurrencySymbol = types.StringValue(salesChannel.CurrencySymbol)
+	data.CurrencyDecimalDigits = types.Int64Value(salesChannel.CurrencyDecimalDigits)
+	data.TimeZone = types.StringValue(salesChannel.TimeZone)
+	data.ProductClusterID = types.StringNull()
+	if salesChannel.ProductClusterID != nil {
+		data.ProductClusterID = types.StringValue(strconv.FormatInt(*salesChannel.ProductClusterID, 10))
+	}
+
+	data.Sellers = make([]VtexTradePolicySellerModel, 0, len(sellers))
+	for _, seller := range sellers {
+		data.Sellers = append(data.Sellers, VtexTradePolicySellerModel{
+			ID:     types.StringValue(seller.SellerID),
+			Name:   types.StringValue(seller.Name),
+			Active: types.BoolValue(seller.IsActive),
+		})
+	}
+
+	data.PaymentRules = make([]VtexTradePolicyPaymentModel, 0)
+	for _, rule := range paymentRules {
+		if !slices.ContainsFunc(rule.SalesChannels, func(sc client.PaymentRuleSalesChannel) bool { return sc.ID == id }) {
+			continue
+		}
+		data.PaymentRules = append(data.PaymentRules, VtexTradePolicyPaymentModel{
+			ID:            types.StringValue(rule.ID),
+			Name:          types.StringValue(rule.Name),
+			PaymentSystem: types.StringValue(rule.PaymentSystem.Name),
+			Enabled:       types.BoolValue(rule.Enabled),
+		})
+	}
+
+	var dockIDs, shippingPolicyIDs []string
+	for _, dock := range docks {
+		if !slices.Contains(dock.SalesChannels, id) {
+			continue
+		}
+		dockIDs = append(dockIDs, dock.ID)
+		for _, shippingPolicyID := range dock.FreightTableIDs {
+			if !slices.Contains(shippingPolicyIDs, shippingPolicyID) {
+				shippingPolicyIDs = append(shippingPolicyIDs, shippingPolicyID)
+			}
+		}
+	}
+	sort.Strings(shippingPolicyIDs)
+	data.DockIDs = stringValues(dockIDs)
+	data.ShippingPolicyIDs = stringValues(shippingPolicyIDs)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexUserDataSource{}
+
+func NewVtexUserDataSource() datasource.DataSource {
+	return &VtexUserDataSource{}
+}
+
+// VtexUserDataSource is the data source implementation
+type VtexUserDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexUserDataSourceModel is the data source data model
+type VtexUserDataSourceModel struct {
+	ID      types.String        `tfsdk:"id"`
+	Email   types.String        `tfsdk:"email"`
+	Account types.String        `tfsdk:"account"`
+	Name    types.String        `tfsdk:"name"`
+	Active  types.Bool          `tfsdk:"active"`
+	Roles   []VtexUserRoleModel `tfsdk:"roles"`
+}
+
+// VtexUserRoleModel is a role assigned to a user
+type VtexUserRoleModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *VtexUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *VtexUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing VTEX user by email, with its assigned roles.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "License Manager user ID",
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "User email (case and surrounding spaces are ignored)",
+				Validators: []validator.String{
+					emailValidator{},
+				},
+			},
+			"account": schema.StringAttribute{
+				Optional:    true,
+				Description: "VTEX account to look up the user in (default: the provider account)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "User name",
+			},
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user can log in to the account (false when blocked)",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Roles assigned to the user",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "License Manager role ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Role name",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexUserDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := normalizeEmail(data.Email.ValueString())
+	account := data.Account.ValueString()
+
+	tflog.Debug(ctx, "Reading VTEX user", map[string]interface{}{
+		"email":   email,
+		"account": account,
+	})
+
+	user, err := d.client.GetUser(ctx, account, email)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"VTEX User Not Found",
+			fmt.Sprintf("No user with email %q in the account", email),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX User",
+			"Could not read user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	roles, err := d.client.GetUserRoles(ctx, account, user.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX User",
+			"Could not read user roles, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(user.ID)
+	data.Name = types.StringValue(user.Name)
+	data.Active = types.BoolValue(!user.IsBlocked)
+	data.Roles = make([]VtexUserRoleModel, 0, len(roles))
+	for _, role := range roles {
+		data.Roles = append(data.Roles, VtexUserRoleModel{
+			ID:   types.Int64Value(role.ID),
+			Name: types.StringValue(role.Name),
+		})
+	}
+
+	tflog.Trace(ctx, "Read VTEX user", map[string]interface{}{
+		"id":    data.ID.ValueString(),
+		"roles": len(data.Roles),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
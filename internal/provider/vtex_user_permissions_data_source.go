@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexUserPermissionsDataSource{}
+
+func NewVtexUserPermissionsDataSource() datasource.DataSource {
+	return &VtexUserPermissionsDataSource{}
+}
+
+// VtexUserPermissionsDataSource is the data source implementation
+type VtexUserPermissionsDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexUserPermissionsDataSourceModel is the data source data model
+type VtexUserPermissionsDataSourceModel struct {
+	ID          types.String          `tfsdk:"id"`
+	Email       types.String          `tfsdk:"email"`
+	Account     types.String          `tfsdk:"account"`
+	UserID      types.String          `tfsdk:"user_id"`
+	RoleNames   types.Set             `tfsdk:"role_names"`
+	Permissions []VtexPermissionModel `tfsdk:"permissions"`
+	Keys        types.Set             `tfsdk:"keys"`
+}
+
+// VtexPermissionModel is one effective permission and the roles that grant it
+type VtexPermissionModel struct {
+	Product  types.String `tfsdk:"product"`
+	Resource types.String `tfsdk:"resource"`
+	Roles    types.Set    `tfsdk:"roles"`
+}
+
+func (d *VtexUserPermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_permissions"
+}
+
+func (d *VtexUserPermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resolves the effective License Manager permissions of a user by combining the resources of all its roles.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier in the format email:account",
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "User email (case and surrounding spaces are ignored)",
+				Validators: []validator.String{
+					emailValidator{},
+				},
+			},
+			"account": schema.StringAttribute{
+				Optional:    true,
+				Description: "VTEX account to resolve the permissions in (default: the provider account)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "License Manager user ID",
+			},
+			"role_names": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of the roles assigned to the user",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Effective permissions, sorted by product and resource",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"product": schema.StringAttribute{
+							Computed:    true,
+							Description: "License Manager product",
+						},
+						"resource": schema.StringAttribute{
+							Computed:    true,
+							Description: "Resource key inside the product",
+						},
+						"roles": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Names of the roles that grant the permission",
+						},
+					},
+				},
+			},
+			"keys": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Effective permissions as product/resource strings, for contains() checks",
+			},
+		},
+	}
+}
+
+func (d *VtexUserPermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexUserPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexUserPermissionsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := normalizeEmail(data.Email.ValueString())
+	account := data.Account.ValueString()
+
+	tflog.Debug(ctx, "Reading VTEX user permissions", map[string]interface{}{
+		"email":   email,
+		"account": account,
+	})
+
+	user, err := d.client.GetUser(ctx, account, email)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"VTEX User Not Found",
+			fmt.Sprintf("No user with email %q in the account", email),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX User Permissions",
+			"Could not read user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	roles, err := d.client.GetUserRoles(ctx, account, user.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX User Permissions",
+			"Could not read user roles, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The user roles list does not include resources, so read each role
+	grantedBy := make(map[client.LicenseManagerResource][]string)
+	roleNames := make([]string, 0, len(roles))
+	for _, assigned := range roles {
+		role, err := d.client.GetRole(ctx, account, assigned.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading VTEX User Permissions",
+				fmt.Sprintf("Could not read role %d, unexpected error: %s", assigned.ID, err.Error()),
+			)
+			return
+		}
+
+		roleNames = append(roleNames, role.Name)
+		for _, res := range role.Resources {
+			grantedBy[res] = append(grantedBy[res], role.Name)
+		}
+	}
+
+	resources := make([]client.LicenseManagerResource, 0, len(grantedBy))
+	for res := range grantedBy {
+		resources = append(resources, res)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].Product != resources[j].Product {
+			return resources[i].Product < resources[j].Product
+		}
+		return resources[i].Key < resources[j].Key
+	})
+
+	data.Permissions = make([]VtexPermissionModel, 0, len(resources))
+	keys := make([]string, 0, len(resources))
+	for _, res := range resources {
+		granting, diags := types.SetValueFrom(ctx, types.StringType, grantedBy[res])
+		resp.Diagnostics.Append(diags...)
+
+		data.Permissions = append(data.Permissions, VtexPermissionModel{
+			Product:  types.StringValue(res.Product),
+			Resource: types.StringValue(res.Key),
+			Roles:    granting,
+		})
+		keys = append(keys, res.Product+"/"+res.Key)
+	}
+
+	roleNamesSet, diags := types.SetValueFrom(ctx, types.StringType, roleNames)
+	resp.Diagnostics.Append(diags...)
+	keysSet, diags := types.SetValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+
+	data.ID = types.StringValue(encodeID(email, account))
+	data.UserID = types.StringValue(user.ID)
+	data.RoleNames = roleNamesSet
+	data.Keys = keysSet
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Read VTEX user permissions", map[string]interface{}{
+		"user_id":     data.UserID.ValueString(),
+		"permissions": len(data.Permissions),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexUserResource{}
+var _ resource.ResourceWithImportState = &VtexUserResource{}
+
+func NewVtexUserResource() resource.Resource {
+	return &VtexUserResource{}
+}
+
+// VtexUserResource is the resource implementation
+type VtexUserResource struct {
+	client *client.VtexClient
+}
+
+// VtexUserResourceModel is the resource data model
+type VtexUserResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Email   EmailValue   `tfsdk:"email"`
+	Name    types.String `tfsdk:"name"`
+	RoleIDs types.Set    `tfsdk:"role_ids"`
+}
+
+func (r *VtexUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *VtexUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a VTEX user and its roles with the native License Manager API. Does not need the VTEX Apps Service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "License Manager user ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				CustomType:  EmailType{},
+				Description: "User email (case and surrounding spaces are ignored)",
+				Validators: []validator.String{
+					emailValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					emailRequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "User name (if not given, it is taken from email)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_ids": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "License Manager role IDs assigned to the user. If not set, roles are not managed",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VtexUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If name is not given, get it from email
+	if data.Name.ValueString() == "" {
+		data.Name = types.StringValue(nameFromEmail(data.Email.NormalizedValue()))
+	}
+
+	tflog.Debug(ctx, "Creating VTEX user", map[string]interface{}{
+		"email": data.Email.NormalizedValue(),
+	})
+
+	user, err := r.client.PutUser(ctx, "", client.LicenseManagerUserRequest{
+		Email: data.Email.NormalizedValue(),
+		Name:  data.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX User",
+			"Could not create user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(user.ID)
+
+	// Assign roles only when they are managed
+	if data.RoleIDs.IsUnknown() {
+		data.RoleIDs = types.SetValueMust(types.Int64Type, nil)
+	} else {
+		var roleIDs []int64
+		resp.Diagnostics.Append(data.RoleIDs.ElementsAs(ctx, &roleIDs, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if len(roleIDs) > 0 {
+			err = r.client.AddUserRoles(ctx, "", user.ID, roleIDs)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Creating VTEX User",
+					"User was created but roles could not be assigned, unexpected error: "+err.Error(),
+				)
+				// Keep the user in state so it is not orphaned
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				return
+			}
+		}
+	}
+
+	tflog.Trace(ctx, "Created VTEX user", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexUserResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading VTEX user", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	user, err := r.client.GetUser(ctx, "", data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "VTEX user not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX User",
+			"Could not read user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Email = NewEmailValue(user.Email)
+	data.Name = types.StringValue(user.Name)
+
+	roleIDs, diags := r.readRoleIDs(ctx, data.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.RoleIDs = roleIDs
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexUserResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := state.ID.ValueString()
+
+	if !plan.Name.Equal(state.Name) {
+		_, err := r.client.PutUser(ctx, "", client.LicenseManagerUserRequest{
+			Email: plan.Email.NormalizedValue(),
+			Name:  plan.Name.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating VTEX User",
+				"Could not update user name, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.RoleIDs.IsUnknown() {
+		var planned, current []int64
+		resp.Diagnostics.Append(plan.RoleIDs.ElementsAs(ctx, &planned, false)...)
+		resp.Diagnostics.Append(state.RoleIDs.ElementsAs(ctx, &current, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		toAdd := subtractInt64s(planned, current)
+		toRemove := subtractInt64s(current, planned)
+
+		tflog.Debug(ctx, "Updating VTEX user roles", map[string]interface{}{
+			"id":     userID,
+			"add":    toAdd,
+			"remove": toRemove,
+		})
+
+		// Add first, so the user is never left without a role
+		if len(toAdd) > 0 {
+			err := r.client.AddUserRoles(ctx, "", userID, toAdd)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating VTEX User",
+					"Could not add roles, unexpected error: "+err.Error(),
+				)
+				return
+			}
+		}
+
+		for _, roleID := range toRemove {
+			err := r.client.RemoveUserRole(ctx, "", userID, roleID)
+			if err != nil && !client.IsNotFound(err) {
+				resp.Diagnostics.AddError(
+					"Error Updating VTEX User",
+					fmt.Sprintf("Could not remove role %d, unexpected error: %s", roleID, err.Error()),
+				)
+				return
+			}
+		}
+	} else {
+		plan.RoleIDs = state.RoleIDs
+	}
+
+	plan.ID = state.ID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexUserResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX user", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteUser(ctx, "", data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX User",
+			"Could not delete user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX user", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VtexUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: user ID or email
+	userID := req.ID
+	if strings.Contains(req.ID, "@") {
+		user, err := r.client.GetUser(ctx, "", normalizeEmail(req.ID))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing VTEX User",
+				fmt.Sprintf("Could not find user %s, unexpected error: %s", req.ID, err.Error()),
+			)
+			return
+		}
+		userID = user.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+}
+
+// readRoleIDs returns the role IDs assigned to a user as a set
+func (r *VtexUserResource) readRoleIDs(ctx context.Context, userID string) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	roles, err := r.client.GetUserRoles(ctx, "", userID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading VTEX User",
+			"Could not read user roles, unexpected error: "+err.Error(),
+		)
+		return types.SetNull(types.Int64Type), diags
+	}
+
+	roleIDs := make([]int64, 0, len(roles))
+	for _, role := range roles {
+		roleIDs = append(roleIDs, role.ID)
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, types.Int64Type, roleIDs)
+	diags.Append(setDiags...)
+	return set, diags
+}
+
+// subtractInt64s returns the values of a that are not in b
+func subtractInt64s(a, b []int64) []int64 {
+	existing := make(map[int64]bool, len(b))
+	for _, v := range b {
+		existing[v] = true
+	}
+
+	var result []int64
+	for _, v := range a {
+		if !existing[v] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
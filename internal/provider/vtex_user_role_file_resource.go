@@ -0,0 +1,599 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexUserRoleFileResource{}
+var _ resource.ResourceWithValidateConfig = &VtexUserRoleFileResource{}
+var _ resource.ResourceWithModifyPlan = &VtexUserRoleFileResource{}
+
+// userRoleBatchSize is the most assignments sent in one Apps Service request
+const userRoleBatchSize = 500
+
+func NewVtexUserRoleFileResource() resource.Resource {
+	return &VtexUserRoleFileResource{}
+}
+
+// VtexUserRoleFileResource is the file driven bulk resource implementation
+type VtexUserRoleFileResource struct {
+	client *client.VtexClient
+}
+
+// VtexUserRoleFileResourceModel is the resource data model
+type VtexUserRoleFileResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Account       types.String `tfsdk:"account"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`
+	Format        types.String `tfsdk:"format"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	Assignments   types.Set    `tfsdk:"assignments"`
+
+	RemoveOnDestroy types.Bool `tfsdk:"remove_on_destroy"`
+}
+
+// roleFileRow is one row of a JSON assignment file
+type roleFileRow struct {
+	Email    string   `json:"email"`
+	Name     string   `json:"name"`
+	RoleName string   `json:"role_name"`
+	Roles    []string `json:"roles"`
+}
+
+func (r *VtexUserRoleFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_role_file"
+}
+
+func (r *VtexUserRoleFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reconciles all user role assignments of a VTEX account from a CSV, JSON or YAML file.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique ID of the resource (account)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account where the roles will be assigned (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the assignment file. Conflicts with content",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Inline assignment file content. Conflicts with path",
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Description: "File format, csv, json or yaml (default: from the path extension, else csv)",
+				Validators: []validator.String{
+					stringOneOfValidator{values: fileSourceFormats},
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the file content, changes when the file changes",
+			},
+			"assignments": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "Assignments parsed from the file",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  EmailType{},
+							Description: "User email (lowercased)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "User name (taken from email when the file has none)",
+						},
+						"role_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Role name assigned to the user",
+						},
+					},
+				},
+			},
+			"remove_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "When false, destroying the resource only removes it from the state and the users keep the roles in VTEX",
+			},
+		},
+	}
+}
+
+func (r *VtexUserRoleFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexUserRoleFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexUserRoleFileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if data.Path.IsUnknown() || data.Content.IsUnknown() {
+		return
+	}
+
+	if data.Path.IsNull() == data.Content.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Invalid Assignment File",
+			"Exactly one of path or content must be set.",
+		)
+	}
+}
+
+func (r *VtexUserRoleFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data VtexUserRoleFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Path.IsUnknown() || data.Content.IsUnknown() || data.Format.IsUnknown() {
+		return
+	}
+
+	// Parse the file at plan time, so file changes show up as a diff
+	content, format, err := fileSource(data.Path, data.Content, data.Format)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid Assignment File", err.Error())
+		return
+	}
+
+	users, err := parseRoleFile(content, format, data.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid Assignment File", err.Error())
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	assignments, diags := roleFileAssignments(ctx, users)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), hex.EncodeToString(sum[:]))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("assignments"), assignments)...)
+}
+
+func (r *VtexUserRoleFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexUserRoleFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, diags := r.plannedUserRoles(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VTEX user roles from file", map[string]interface{}{
+		"account": data.Account.ValueString(),
+		"count":   len(users),
+	})
+
+	err := r.createUserRoles(ctx, users)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX User Roles",
+			"Could not create user roles, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(data.Account.ValueString())
+
+	tflog.Trace(ctx, "Created VTEX user roles from file", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexUserRoleFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexUserRoleFileResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Refresh does not look the users up: that is one License Manager call per user, and
+	// License Manager is not available for every account. We assume the assignments exist
+	// if they are in the state
+
+	tflog.Debug(ctx, "Reading VTEX user roles from file", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexUserRoleFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexUserRoleFileResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := r.plannedUserRoles(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	var current []VtexUserRolesUserModel
+	resp.Diagnostics.Append(state.Assignments.ElementsAs(ctx, &current, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account := plan.Account.ValueString()
+	existing := userRolesFromModel(account, current)
+
+	// Name changes are sent again as creates, only dropped assignments are removed
+	toAdd := diffUserRoles(planned, existing, false)
+	toRemove := diffUserRoles(existing, planned, true)
+
+	tflog.Debug(ctx, "Updating VTEX user roles from file", map[string]interface{}{
+		"account": account,
+		"add":     len(toAdd),
+		"remove":  len(toRemove),
+	})
+
+	// Add first, so users moving between roles are never left without one
+	err := r.createUserRoles(ctx, toAdd)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX User Roles",
+			"Could not add user roles, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.deleteUserRoles(ctx, toRemove)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VTEX User Roles",
+			"Could not remove user roles, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexUserRoleFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexUserRoleFileResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RemoveOnDestroy.ValueBool() {
+		tflog.Info(ctx, "remove_on_destroy is false, removing VTEX user roles from state only", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		return
+	}
+
+	var assignments []VtexUserRolesUserModel
+	resp.Diagnostics.Append(data.Assignments.ElementsAs(ctx, &assignments, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users := userRolesFromModel(data.Account.ValueString(), assignments)
+
+	tflog.Debug(ctx, "Deleting VTEX user roles from file", map[string]interface{}{
+		"account": data.Account.ValueString(),
+		"count":   len(users),
+	})
+
+	err := r.deleteUserRoles(ctx, users)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX User Roles",
+			"Could not delete user roles, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX user roles from file", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// plannedUserRoles returns the assignments to apply. They are parsed in ModifyPlan,
+// but a path only known at apply time is read here.
+func (r *VtexUserRoleFileResource) plannedUserRoles(ctx context.Context, data *VtexUserRoleFileResourceModel) ([]client.UserRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	account := data.Account.ValueString()
+
+	if data.Assignments.IsUnknown() || data.ContentSHA256.IsUnknown() {
+		content, format, err := fileSource(data.Path, data.Content, data.Format)
+		if err != nil {
+			diags.AddAttributeError(path.Root("path"), "Invalid Assignment File", err.Error())
+			return nil, diags
+		}
+
+		users, err := parseRoleFile(content, format, account)
+		if err != nil {
+			diags.AddAttributeError(path.Root("content"), "Invalid Assignment File", err.Error())
+			return nil, diags
+		}
+
+		sum := sha256.Sum256(content)
+		data.ContentSHA256 = types.StringValue(hex.EncodeToString(sum[:]))
+		assignments, setDiags := roleFileAssignments(ctx, users)
+		diags.Append(setDiags...)
+		data.Assignments = assignments
+		return users, diags
+	}
+
+	var assignments []VtexUserRolesUserModel
+	diags.Append(data.Assignments.ElementsAs(ctx, &assignments, false)...)
+	return userRolesFromModel(account, assignments), diags
+}
+
+// createUserRoles sends the assignments in batches of userRoleBatchSize
+func (r *VtexUserRoleFileResource) createUserRoles(ctx context.Context, users []client.UserRole) error {
+	for start := 0; start < len(users); start += userRoleBatchSize {
+		end := min(start+userRoleBatchSize, len(users))
+		if _, err := r.client.CreateUserRoles(ctx, users[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteUserRoles removes the assignments in batches of userRoleBatchSize
+func (r *VtexUserRoleFileResource) deleteUserRoles(ctx context.Context, users []client.UserRole) error {
+	for start := 0; start < len(users); start += userRoleBatchSize {
+		end := min(start+userRoleBatchSize, len(users))
+		if err := r.client.DeleteUserRoles(ctx, users[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseRoleFile parses CSV or JSON (YAML is converted to JSON by fileSource) rows into assignments, one per email and role.
+// CSV files need a header with email and role_name (or roles, separated by ";"),
+// name is optional.
+func parseRoleFile(content []byte, format, account string) ([]client.UserRole, error) {
+	var rows []roleFileRow
+
+	switch format {
+	case fileFormatJSON:
+		if err := json.Unmarshal(content, &rows); err != nil {
+			return nil, fmt.Errorf("expected a JSON list of objects with email and role_name or roles: %w", err)
+		}
+	default:
+		parsed, err := parseRoleFileCSV(content)
+		if err != nil {
+			return nil, err
+		}
+		rows = parsed
+	}
+
+	seen := make(map[client.UserRole]bool)
+	var users []client.UserRole
+	for i, row := range rows {
+		email := normalizeEmail(row.Email)
+		if !validEmail(email) {
+			return nil, fmt.Errorf("row %d: invalid email %q", i+1, row.Email)
+		}
+
+		roles := row.Roles
+		if row.RoleName != "" {
+			roles = append([]string{row.RoleName}, roles...)
+		}
+		if len(roles) == 0 {
+			return nil, fmt.Errorf("row %d: no role for %s", i+1, email)
+		}
+
+		name := strings.TrimSpace(row.Name)
+		if name == "" {
+			name = nameFromEmail(email)
+		}
+
+		for _, role := range roles {
+			role = strings.TrimSpace(role)
+			if role == "" {
+				continue
+			}
+			if utf8.RuneCountInString(role) > roleNameMaxLength {
+				return nil, fmt.Errorf("row %d: role name %q is longer than %d characters", i+1, role, roleNameMaxLength)
+			}
+
+			// The first row wins when the same assignment is listed twice
+			key := client.UserRole{Email: email, Account: account, RoleName: role}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			users = append(users, client.UserRole{
+				Email:    email,
+				Name:     name,
+				Account:  account,
+				RoleName: role,
+			})
+		}
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Email != users[j].Email {
+			return users[i].Email < users[j].Email
+		}
+		return users[i].RoleName < users[j].RoleName
+	})
+	return users, nil
+}
+
+// parseRoleFileCSV reads CSV rows by header name
+func parseRoleFileCSV(content []byte) ([]roleFileRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[csvColumnName(column)] = i
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, fmt.Errorf("CSV header must have an email column, got: %s", strings.Join(header, ","))
+	}
+	_, hasRoleName := columns["role_name"]
+	_, hasRoles := columns["roles"]
+	if !hasRoleName && !hasRoles {
+		return nil, fmt.Errorf("CSV header must have a role_name or roles column, got: %s", strings.Join(header, ","))
+	}
+
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []roleFileRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read CSV: %w", err)
+		}
+
+		// Skip blank lines left by spreadsheet exports
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		row := roleFileRow{
+			Email:    field(record, "email"),
+			Name:     field(record, "name"),
+			RoleName: field(record, "role_name"),
+		}
+		if roles := field(record, "roles"); roles != "" {
+			row.Roles = strings.Split(roles, ";")
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// roleFileAssignments converts assignments into the computed assignments set
+func roleFileAssignments(ctx context.Context, users []client.UserRole) (types.Set, diag.Diagnostics) {
+	elementType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"email":     EmailType{},
+		"name":      types.StringType,
+		"role_name": types.StringType,
+	}}
+
+	assignments := make([]VtexUserRolesUserModel, 0, len(users))
+	for _, u := range users {
+		assignments = append(assignments, VtexUserRolesUserModel{
+			Email:    NewEmailValue(u.Email),
+			Name:     types.StringValue(u.Name),
+			RoleName: types.StringValue(u.RoleName),
+		})
+	}
+
+	return types.SetValueFrom(ctx, elementType, assignments)
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+)
+
+func TestParseRoleFile(t *testing.T) {
+	longRole := strings.Repeat("é", roleNameMaxLength)
+
+	tests := []struct {
+		name    string
+		content string
+		format  string
+		want    []client.UserRole
+		wantErr string
+	}{
+		{
+			name:    "csv with role_name",
+			content: "email,name,role_name\njohn@example.com,John Doe,Owner\n",
+			format:  fileFormatCSV,
+			want: []client.UserRole{
+				{Email: "john@example.com", Name: "John Doe", Account: "vendor", RoleName: "Owner"},
+			},
+		},
+		{
+			name:    "csv roles split by semicolon, sorted",
+			content: "email,roles\njohn@example.com,Sales; Owner\n",
+			format:  fileFormatCSV,
+			want: []client.UserRole{
+				{Email: "john@example.com", Name: "john", Account: "vendor", RoleName: "Owner"},
+				{Email: "john@example.com", Name: "john", Account: "vendor", RoleName: "Sales"},
+			},
+		},
+		{
+			name:    "csv header with BOM and mixed case",
+			content: "\ufeffEmail,Role_Name\nJohn@Example.com,Owner\n",
+			format:  fileFormatCSV,
+			want: []client.UserRole{
+				{Email: "john@example.com", Name: "john", Account: "vendor", RoleName: "Owner"},
+			},
+		},
+		{
+			name:    "csv blank lines are skipped",
+			content: "email,role_name\n\njohn@example.com,Owner\n,\n",
+			format:  fileFormatCSV,
+			want: []client.UserRole{
+				{Email: "john@example.com", Name: "john", Account: "vendor", RoleName: "Owner"},
+			},
+		},
+		{
+			name:    "duplicate assignments keep the first row",
+			content: "email,name,role_name\njohn@example.com,John,Owner\nJOHN@example.com,Johnny,Owner\n",
+			format:  fileFormatCSV,
+			want: []client.UserRole{
+				{Email: "john@example.com", Name: "John", Account: "vendor", RoleName: "Owner"},
+			},
+		},
+		{
+			name:    "empty csv",
+			content: "",
+			format:  fileFormatCSV,
+		},
+		{
+			name:    "json with role_name and roles",
+			content: `[{"email":"jane@example.com","role_name":"Owner","roles":["Sales"]}]`,
+			format:  fileFormatJSON,
+			want: []client.UserRole{
+				{Email: "jane@example.com", Name: "jane", Account: "vendor", RoleName: "Owner"},
+				{Email: "jane@example.com", Name: "jane", Account: "vendor", RoleName: "Sales"},
+			},
+		},
+		{
+			name:    "role name at the limit in multibyte characters",
+			content: "email,role_name\njohn@example.com," + longRole + "\n",
+			format:  fileFormatCSV,
+			want: []client.UserRole{
+				{Email: "john@example.com", Name: "john", Account: "vendor", RoleName: longRole},
+			},
+		},
+		{
+			name:    "role name over the limit",
+			content: "email,role_name\njohn@example.com," + longRole + "x\n",
+			format:  fileFormatCSV,
+			wantErr: "longer than 100 characters",
+		},
+		{
+			name:    "invalid email",
+			content: "email,role_name\nnot-an-email,Owner\n",
+			format:  fileFormatCSV,
+			wantErr: `row 1: invalid email "not-an-email"`,
+		},
+		{
+			name:    "row without role",
+			content: `[{"email":"jane@example.com"}]`,
+			format:  fileFormatJSON,
+			wantErr: "row 1: no role for jane@example.com",
+		},
+		{
+			name:    "csv without role column",
+			content: "email,name\njohn@example.com,John\n",
+			format:  fileFormatCSV,
+			wantErr: "role_name or roles column",
+		},
+		{
+			name:    "csv without email column",
+			content: "role_name\nOwner\n",
+			format:  fileFormatCSV,
+			wantErr: "email column",
+		},
+		{
+			name:    "json that is not a list",
+			content: `{"email":"jane@example.com"}`,
+			format:  fileFormatJSON,
+			wantErr: "expected a JSON list",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseRoleFile([]byte(test.content), test.format, "vendor")
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("parseRoleFile error = %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRoleFile returned error: %s", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseRoleFile = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseRoleFileYAML(t *testing.T) {
+	content, err := yamlToJSON([]byte(`
+- email: jane@example.com
+  name: Jane Doe
+  roles:
+    - Owner
+    - Sales
+- email: john@example.com
+  role_name: Owner
+`))
+	if err != nil {
+		t.Fatalf("yamlToJSON returned error: %s", err)
+	}
+
+	got, err := parseRoleFile(content, fileFormatJSON, "vendor")
+	if err != nil {
+		t.Fatalf("parseRoleFile returned error: %s", err)
+	}
+
+	want := []client.UserRole{
+		{Email: "jane@example.com", Name: "Jane Doe", Account: "vendor", RoleName: "Owner"},
+		{Email: "jane@example.com", Name: "Jane Doe", Account: "vendor", RoleName: "Sales"},
+		{Email: "john@example.com", Name: "john", Account: "vendor", RoleName: "Owner"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseRoleFile = %+v, want %+v", got, want)
+	}
+}
@@ -6,11 +6,15 @@ import (
 	"strings"
 
 	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +22,26 @@ import (
 // Check that types satisfy framework interfaces
 var _ resource.Resource = &VtexUserRoleResource{}
 var _ resource.ResourceWithImportState = &VtexUserRoleResource{}
+var _ resource.ResourceWithValidateConfig = &VtexUserRoleResource{}
+var _ resource.ResourceWithModifyPlan = &VtexUserRoleResource{}
+var _ resource.ResourceWithUpgradeState = &VtexUserRoleResource{}
+
+// roleNameMaxLength is the longest role name accepted by License Manager
+const roleNameMaxLength = 100
+
+// exists_policy values, how Read checks that the assignment still exists
+const (
+	existsPolicyAssume   = "assume_exists"
+	existsPolicyVerify   = "verify"
+	existsPolicyRecreate = "recreate_if_missing"
+)
+
+// on_existing values, what Create does when the user already has the role
+const (
+	onExistingAdopt     = "adopt"
+	onExistingFail      = "fail"
+	onExistingOverwrite = "overwrite"
+)
 
 func NewVtexUserRoleResource() resource.Resource {
 	return &VtexUserRoleResource{}
@@ -30,11 +54,22 @@ type VtexUserRoleResource struct {
 
 // VtexUserRoleResourceModel is the resource data model
 type VtexUserRoleResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Email    types.String `tfsdk:"email"`
-	Name     types.String `tfsdk:"name"`
-	Account  types.String `tfsdk:"account"`
-	RoleName types.String `tfsdk:"role_name"`
+	ID        types.String `tfsdk:"id"`
+	Email     EmailValue   `tfsdk:"email"`
+	Name      types.String `tfsdk:"name"`
+	Account   types.String `tfsdk:"account"`
+	RoleName  types.String `tfsdk:"role_name"`
+	RoleNames types.Set    `tfsdk:"role_names"`
+	UserID    types.String `tfsdk:"user_id"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	RemoveOnDestroy    types.Bool `tfsdk:"remove_on_destroy"`
+
+	OnExisting          types.String `tfsdk:"on_existing"`
+	DeleteUserOnDestroy types.Bool   `tfsdk:"delete_user_on_destroy"`
+	ExistsPolicy        types.String `tfsdk:"exists_policy"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 func (r *VtexUserRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,25 +79,31 @@ func (r *VtexUserRoleResource) Metadata(ctx context.Context, req resource.Metada
 func (r *VtexUserRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a user with a specific role in a VTEX account.",
+		// Version 1: ID parts are escaped so role names can contain ":"
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
-				Description: "Unique ID of the resource (email:account:role_name)",
+				Description: "Unique ID of the resource (email:account:role_name, \":\" inside a part is escaped as %3A)",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"email": schema.StringAttribute{
 				Required:    true,
-				Description: "User email",
+				CustomType:  EmailType{},
+				Description: "User email (case and surrounding spaces are ignored)",
+				Validators: []validator.String{
+					emailValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					emailRequiresReplace(),
 				},
 			},
 			"name": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "User name (if not given, it is taken from email)",
+				Description: "User name (if not given, it is taken from email). Changes are applied in place",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -70,17 +111,74 @@ func (r *VtexUserRoleResource) Schema(ctx context.Context, req resource.SchemaRe
 			"account": schema.StringAttribute{
 				Required:    true,
 				Description: "VTEX account where the role will be assigned (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"role_name": schema.StringAttribute{
-				Required:    true,
-				Description: "Role name to assign (e.g. Owner, Operation)",
+				Optional:    true,
+				Description: "Role name to assign (e.g. Owner, Operation). Changes are applied in place. Conflicts with role_names",
+				Validators: []validator.String{
+					stringLengthValidator{max: roleNameMaxLength},
+				},
+			},
+			"role_names": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Set of role names to assign. Changes only add or remove the changed roles. Conflicts with role_name",
+				Validators: []validator.Set{
+					setElementsValidator{inner: stringLengthValidator{max: roleNameMaxLength}},
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "License Manager user ID, for resources that need the canonical user ID instead of the email",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, destroying the resource fails. Set it to false (and apply) before destroying",
+			},
+			"on_existing": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(onExistingOverwrite),
+				Description: "What to do on create when the user already has the role: adopt (take it into state without calling the API), fail, or overwrite (default)",
+				Validators: []validator.String{
+					stringOneOfValidator{values: []string{onExistingAdopt, onExistingFail, onExistingOverwrite}},
+				},
+			},
+			"exists_policy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(existsPolicyAssume),
+				Description: "How refresh checks the assignment: assume_exists (default, no API call), verify (fail when a role is missing) or recreate_if_missing (plan to create it again). verify and recreate_if_missing need License Manager access",
+				Validators: []validator.String{
+					stringOneOfValidator{values: []string{existsPolicyAssume, existsPolicyVerify, existsPolicyRecreate}},
+				},
+			},
+			"delete_user_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, destroying the resource also deletes the user from the account if it has no roles left (full offboarding)",
+			},
+			"remove_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "When false, destroying the resource only removes it from the state and the user keeps the roles in VTEX",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
 		},
 	}
 }
@@ -104,6 +202,46 @@ func (r *VtexUserRoleResource) Configure(ctx context.Context, req resource.Confi
 	r.client = client
 }
 
+func (r *VtexUserRoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VtexUserRoleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again at apply time
+	if data.RoleName.IsUnknown() || data.RoleNames.IsUnknown() {
+		return
+	}
+
+	if data.RoleName.IsNull() == data.RoleNames.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_names"),
+			"Invalid Role Configuration",
+			"Exactly one of role_name or role_names must be set.",
+		)
+	}
+}
+
+func (r *VtexUserRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data VtexUserRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.RoleName.IsUnknown() || data.RoleNames.IsUnknown() {
+		return
+	}
+
+	// A role change keeps the resource but changes its ID, plan the new one
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), userRoleID(data))...)
+}
+
 func (r *VtexUserRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data VtexUserRoleResourceModel
 
@@ -114,43 +252,81 @@ func (r *VtexUserRoleResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts, "create")
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// If name is not given, get it from email
 	name := data.Name.ValueString()
 	if name == "" {
-		emailParts := strings.Split(data.Email.ValueString(), "@")
-		name = emailParts[0]
+		name = nameFromEmail(data.Email.NormalizedValue())
 		data.Name = types.StringValue(name)
 	}
 
-	// Create user in VTEX
-	userRole := client.UserRole{
-		Email:    data.Email.ValueString(),
-		Name:     name,
-		Account:  data.Account.ValueString(),
-		RoleName: data.RoleName.ValueString(),
+	roles, diags := userRoleNames(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	// Check existing assignments unless we overwrite them anyway
+	if data.OnExisting.ValueString() != onExistingOverwrite {
+		existing, err := r.existingRoles(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating VTEX User Role",
+				"Could not check existing roles, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		// Planned roles the user already has
+		assigned := subtractStrings(roles, subtractStrings(roles, existing))
+		if len(assigned) > 0 && data.OnExisting.ValueString() == onExistingFail {
+			resp.Diagnostics.AddError(
+				"VTEX User Role Already Exists",
+				fmt.Sprintf("User %s already has the roles %s in account %s. "+
+					"Import the existing assignment or set on_existing = \"adopt\".",
+					data.Email.NormalizedValue(), strings.Join(assigned, ", "), data.Account.ValueString()),
+			)
+			return
+		}
+
+		// Adopt: only create the roles the user does not have yet
+		roles = subtractStrings(roles, assigned)
+	}
+
+	// Create user in VTEX, one entry per role
+	userRoles := userRolesFor(data, roles)
+
 	tflog.Debug(ctx, "Creating VTEX user role", map[string]interface{}{
-		"email":     userRole.Email,
-		"account":   userRole.Account,
-		"role_name": userRole.RoleName,
+		"email":      data.Email.NormalizedValue(),
+		"account":    data.Account.ValueString(),
+		"role_names": roles,
 	})
 
-	err := r.client.CreateUserRole(userRole)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating VTEX User Role",
-			"Could not create user role, unexpected error: "+err.Error(),
-		)
-		return
+	result := &client.UserRoleResponse{}
+	if len(userRoles) > 0 {
+		var err error
+		result, err = r.client.CreateUserRoles(ctx, userRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating VTEX User Role",
+				"Could not create user role, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
+	data.UserID = r.userID(ctx, data, result)
+
 	// Generate unique ID
-	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s",
-		data.Email.ValueString(),
-		data.Account.ValueString(),
-		data.RoleName.ValueString(),
-	))
+	data.ID = types.StringValue(userRoleID(data))
 
 	tflog.Trace(ctx, "Created VTEX user role", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -170,13 +346,62 @@ func (r *VtexUserRoleResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	// VTEX does not have an endpoint to query specific users
-	// We assume the resource exists if it is in the state
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts, "read")
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "Reading VTEX user role", map[string]interface{}{
-		"id": data.ID.ValueString(),
+		"id":            data.ID.ValueString(),
+		"exists_policy": data.ExistsPolicy.ValueString(),
 	})
 
+	// License Manager is not available for every account, by default
+	// we assume the resource exists if it is in the state
+	if data.ExistsPolicy.ValueString() != existsPolicyVerify && data.ExistsPolicy.ValueString() != existsPolicyRecreate {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	roles, diags := userRoleNames(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.existingRoles(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX User Role",
+			"Could not read the user's roles from License Manager, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	missing := subtractStrings(roles, existing)
+	if len(missing) > 0 {
+		if data.ExistsPolicy.ValueString() == existsPolicyRecreate {
+			tflog.Warn(ctx, "VTEX user role is missing, removing it from state to recreate it", map[string]interface{}{
+				"id":      data.ID.ValueString(),
+				"missing": missing,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"VTEX User Role Drift Detected",
+			fmt.Sprintf("User %s no longer has the roles %s in account %s. "+
+				"Remove the resource from state or use exists_policy = \"recreate_if_missing\" to create them again.",
+				data.Email.NormalizedValue(), strings.Join(missing, ", "), data.Account.ValueString()),
+		)
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -191,14 +416,78 @@ func (r *VtexUserRoleResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	// Main fields (email, account, role_name) have RequiresReplace
-	// Any change will destroy and recreate the resource
-	// VTEX does not have an update endpoint, so this is a no-op
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts, "update")
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
 
-	tflog.Debug(ctx, "Update called for VTEX user role (no-op)", map[string]interface{}{
-		"id": data.ID.ValueString(),
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state VtexUserRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Email and account have RequiresReplace, roles change in place
+	// Add the new roles first, then remove the old ones, so the user is never left without a role
+	plannedRoles, diags := userRoleNames(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	currentRoles, diags := userRoleNames(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toAdd := subtractStrings(plannedRoles, currentRoles)
+	toRemove := subtractStrings(currentRoles, plannedRoles)
+
+	// The app saves the name with each role, so a name change sends every role again
+	if !data.Name.Equal(state.Name) {
+		toAdd = plannedRoles
+	}
+
+	tflog.Debug(ctx, "Updating VTEX user role", map[string]interface{}{
+		"id":     data.ID.ValueString(),
+		"name":   data.Name.ValueString(),
+		"add":    toAdd,
+		"remove": toRemove,
 	})
 
+	result := &client.UserRoleResponse{}
+	if len(toAdd) > 0 {
+		var err error
+		result, err = r.client.CreateUserRoles(ctx, userRolesFor(data, toAdd))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating VTEX User Role",
+				"Could not add roles, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if len(toRemove) > 0 {
+		err := r.client.DeleteUserRoles(ctx, userRolesFor(state, toRemove))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating VTEX User Role",
+				"Could not remove roles, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	data.ID = types.StringValue(userRoleID(data))
+
+	// Imported or upgraded states may not have the user ID yet
+	if data.UserID.IsUnknown() {
+		data.UserID = r.userID(ctx, data, result)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -213,21 +502,45 @@ func (r *VtexUserRoleResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	// Delete user from VTEX
-	userRole := client.UserRole{
-		Email:    data.Email.ValueString(),
-		Name:     data.Name.ValueString(),
-		Account:  data.Account.ValueString(),
-		RoleName: data.RoleName.ValueString(),
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts, "delete")
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Cannot delete VTEX user role %s because deletion_protection is true. "+
+				"Set deletion_protection = false and apply before destroying it.", data.ID.ValueString()),
+		)
+		return
 	}
 
+	if !data.RemoveOnDestroy.ValueBool() {
+		tflog.Info(ctx, "remove_on_destroy is false, removing VTEX user role from state only", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		return
+	}
+
+	roles, diags := userRoleNames(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete user from VTEX, one entry per role
 	tflog.Debug(ctx, "Deleting VTEX user role", map[string]interface{}{
-		"email":     userRole.Email,
-		"account":   userRole.Account,
-		"role_name": userRole.RoleName,
+		"email":      data.Email.NormalizedValue(),
+		"account":    data.Account.ValueString(),
+		"role_names": roles,
 	})
 
-	err := r.client.DeleteUserRole(userRole)
+	err := r.client.DeleteUserRoles(ctx, userRolesFor(data, roles))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting VTEX User Role",
@@ -236,28 +549,327 @@ func (r *VtexUserRoleResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	if data.DeleteUserOnDestroy.ValueBool() {
+		resp.Diagnostics.Append(r.deleteUserIfNoRoles(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "Deleted VTEX user role", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
 }
 
 func (r *VtexUserRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// ID format: email:account:role_name
-	parts := strings.SplitN(req.ID, ":", 3)
-	if len(parts) != 3 {
+	// ID format: email:account:role_name, or email:account to discover the roles
+	parts, err := decodeID(req.ID)
+	if err != nil || len(parts) < 2 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: email:account:role_name, got: %s", req.ID),
+			fmt.Sprintf("Expected import ID format: email:account:role_name or email:account, got: %s", req.ID),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	// Old unescaped IDs with ":" inside the role name
+	if len(parts) > 3 {
+		parts = []string{parts[0], parts[1], strings.Join(parts[2:], ":")}
+	}
+	parts[0] = normalizeEmail(parts[0])
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), encodeID(parts...))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_name"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remove_on_destroy"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_existing"), onExistingOverwrite)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_user_on_destroy"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exists_policy"), existsPolicyAssume)...)
+
+	if len(parts) == 3 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_name"), parts[2])...)
+
+		// Get name from email
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), nameFromEmail(parts[0]))...)
+		return
+	}
+
+	// Only email:account given, ask License Manager for the user's roles
+	user, err := r.client.GetUser(ctx, parts[1], parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing VTEX User Role",
+			fmt.Sprintf("Could not find user %s in account %s, unexpected error: %s", parts[0], parts[1], err.Error()),
+		)
+		return
+	}
+
+	roles, err := r.client.GetUserRoles(ctx, parts[1], user.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing VTEX User Role",
+			fmt.Sprintf("Could not read roles of user %s in account %s, unexpected error: %s", parts[0], parts[1], err.Error()),
+		)
+		return
+	}
+
+	if len(roles) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Importing VTEX User Role",
+			fmt.Sprintf("User %s has no roles in account %s, nothing to import", parts[0], parts[1]),
+		)
+		return
+	}
+
+	roleNames := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleNames = append(roleNames, role.Name)
+	}
+
+	tflog.Debug(ctx, "Discovered VTEX user roles for import", map[string]interface{}{
+		"email":      parts[0],
+		"account":    parts[1],
+		"role_names": roleNames,
+	})
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), user.ID)...)
+
+	// The two-part ID is the role_names form, all discovered roles are managed
+	roleSet, diags := types.SetValueFrom(ctx, types.StringType, roleNames)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_names"), roleSet)...)
+
+	name := user.Name
+	if name == "" {
+		name = nameFromEmail(parts[0])
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func (r *VtexUserRoleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := vtexUserRoleSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior VtexUserRoleResourceModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := upgradeUserRoleStateV0(prior)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// VtexUserRoleResourceModelV0 is the resource data model of schema version 0
+type VtexUserRoleResourceModelV0 struct {
+	ID       types.String `tfsdk:"id"`
+	Email    types.String `tfsdk:"email"`
+	Name     types.String `tfsdk:"name"`
+	Account  types.String `tfsdk:"account"`
+	RoleName types.String `tfsdk:"role_name"`
+}
+
+// vtexUserRoleSchemaV0 is schema version 0: one role per resource and an unescaped
+// email:account:role_name ID
+func vtexUserRoleSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"email": schema.StringAttribute{
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"account": schema.StringAttribute{
+				Required: true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+// upgradeUserRoleStateV0 maps a version 0 state to the current model. The attributes added
+// since then get their defaults, and the ID is rebuilt from the attributes because the old one
+// is ambiguous when role names have ":"
+func upgradeUserRoleStateV0(prior VtexUserRoleResourceModelV0) VtexUserRoleResourceModel {
+	data := VtexUserRoleResourceModel{
+		Email:     NewEmailValue(prior.Email.ValueString()),
+		Name:      prior.Name,
+		Account:   prior.Account,
+		RoleName:  prior.RoleName,
+		RoleNames: types.SetNull(types.StringType),
+		UserID:    types.StringNull(),
+
+		DeletionProtection: types.BoolValue(false),
+		RemoveOnDestroy:    types.BoolValue(true),
+
+		OnExisting:          types.StringValue(onExistingOverwrite),
+		DeleteUserOnDestroy: types.BoolValue(false),
+		ExistsPolicy:        types.StringValue(existsPolicyAssume),
+
+		Timeouts: types.ObjectNull(timeoutsAttrTypes),
+	}
+	data.ID = types.StringValue(userRoleID(data))
+	return data
+}
+
+// userID gets the License Manager user ID from the create response, or asks License Manager for it.
+// Failing to get it is not an error: the assignment exists, only the computed attribute stays empty.
+func (r *VtexUserRoleResource) userID(ctx context.Context, data VtexUserRoleResourceModel, result *client.UserRoleResponse) types.String {
+	email := data.Email.NormalizedValue()
+	if id := result.UserID(email); id != "" {
+		return types.StringValue(id)
+	}
+
+	user, err := r.client.GetUser(ctx, data.Account.ValueString(), email)
+	if err != nil {
+		tflog.Warn(ctx, "Could not get VTEX user ID, user_id will be empty", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return types.StringNull()
+	}
+
+	return types.StringValue(user.ID)
+}
+
+// existingRoles returns the role names the user already has in the account (none if the user does not exist)
+func (r *VtexUserRoleResource) existingRoles(ctx context.Context, data VtexUserRoleResourceModel) ([]string, error) {
+	user, err := r.client.GetUser(ctx, data.Account.ValueString(), data.Email.NormalizedValue())
+	if client.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := r.client.GetUserRoles(ctx, data.Account.ValueString(), user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	return names, nil
+}
+
+// deleteUserIfNoRoles deletes the user from the account once its last role is gone.
+// Roles managed outside this resource keep the user in place.
+func (r *VtexUserRoleResource) deleteUserIfNoRoles(ctx context.Context, data VtexUserRoleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	account := data.Account.ValueString()
+
+	user, err := r.client.GetUser(ctx, account, data.Email.NormalizedValue())
+	if client.IsNotFound(err) {
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Deleting VTEX User",
+			"Could not read user after removing its roles, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	remaining, err := r.client.GetUserRoles(ctx, account, user.ID)
+	if err != nil {
+		diags.AddError(
+			"Error Deleting VTEX User",
+			"Could not read remaining roles of the user, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if len(remaining) > 0 {
+		tflog.Info(ctx, "VTEX user still has roles, not deleting it", map[string]interface{}{
+			"user_id":   user.ID,
+			"remaining": len(remaining),
+		})
+		return diags
+	}
+
+	tflog.Debug(ctx, "Deleting VTEX user with no roles left", map[string]interface{}{
+		"user_id": user.ID,
+		"account": account,
+	})
+
+	err = r.client.DeleteUser(ctx, account, user.ID)
+	if err != nil && !client.IsNotFound(err) {
+		diags.AddError(
+			"Error Deleting VTEX User",
+			"Could not delete user from the account, unexpected error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// userRoleNames returns the roles managed by the resource, from role_name or role_names
+func userRoleNames(ctx context.Context, data VtexUserRoleResourceModel) ([]string, diag.Diagnostics) {
+	if !data.RoleName.IsNull() {
+		return []string{data.RoleName.ValueString()}, nil
+	}
+
+	var roles []string
+	diags := data.RoleNames.ElementsAs(ctx, &roles, false)
+	return roles, diags
+}
+
+// userRolesFor builds one API entry per role for the user in data
+func userRolesFor(data VtexUserRoleResourceModel, roles []string) []client.UserRole {
+	users := make([]client.UserRole, 0, len(roles))
+	for _, role := range roles {
+		users = append(users, client.UserRole{
+			Email:    data.Email.NormalizedValue(),
+			Name:     data.Name.ValueString(),
+			Account:  data.Account.ValueString(),
+			RoleName: role,
+		})
+	}
+	return users
+}
+
+// userRoleID returns email:account:role_name, or email:account when role_names is used
+func userRoleID(data VtexUserRoleResourceModel) string {
+	if data.RoleName.IsNull() {
+		return encodeID(data.Email.NormalizedValue(), data.Account.ValueString())
+	}
+	return encodeID(data.Email.NormalizedValue(), data.Account.ValueString(), data.RoleName.ValueString())
+}
+
+// subtractStrings returns the values of a that are not in b
+func subtractStrings(a, b []string) []string {
+	existing := make(map[string]bool, len(b))
+	for _, v := range b {
+		existing[v] = true
+	}
+
+	var result []string
+	for _, v := range a {
+		if !existing[v] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
 
-	// Get name from email
-	emailParts := strings.Split(parts[0], "@")
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), emailParts[0])...)
+// nameFromEmail returns the local part of an email, used when no name is given
+func nameFromEmail(email string) string {
+	return strings.Split(email, "@")[0]
 }
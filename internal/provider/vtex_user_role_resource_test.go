@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// userRoleStateV0 builds a raw version 0 state
+func userRoleStateV0(t *testing.T, id, email, name, account, roleName string) tfsdk.State {
+	t.Helper()
+
+	priorSchema := vtexUserRoleSchemaV0()
+	stateType := priorSchema.Type().TerraformType(context.Background())
+	return tfsdk.State{
+		Schema: priorSchema,
+		Raw: tftypes.NewValue(stateType, map[string]tftypes.Value{
+			"id":        tftypes.NewValue(tftypes.String, id),
+			"email":     tftypes.NewValue(tftypes.String, email),
+			"name":      tftypes.NewValue(tftypes.String, name),
+			"account":   tftypes.NewValue(tftypes.String, account),
+			"role_name": tftypes.NewValue(tftypes.String, roleName),
+		}),
+	}
+}
+
+func TestVtexUserRoleResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &VtexUserRoleResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	currentSchema := schemaResp.Schema
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("no state upgrader for version 0")
+	}
+
+	tests := []struct {
+		name     string
+		state    tfsdk.State
+		wantID   string
+		wantRole string
+	}{
+		{
+			name:     "plain role name keeps the ID",
+			state:    userRoleStateV0(t, "john@example.com:vendor:Owner", "john@example.com", "john", "vendor", "Owner"),
+			wantID:   "john@example.com:vendor:Owner",
+			wantRole: "Owner",
+		},
+		{
+			name:     "role name with colon is escaped",
+			state:    userRoleStateV0(t, "john@example.com:vendor:Sales: Admin", "john@example.com", "john", "vendor", "Sales: Admin"),
+			wantID:   "john@example.com:vendor:Sales%3A Admin",
+			wantRole: "Sales: Admin",
+		},
+		{
+			name:     "email is normalized in the ID",
+			state:    userRoleStateV0(t, "John@Example.com:vendor:Owner", "John@Example.com", "John", "vendor", "Owner"),
+			wantID:   "john@example.com:vendor:Owner",
+			wantRole: "Owner",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := resource.UpgradeStateRequest{State: &test.state}
+			resp := &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: currentSchema,
+					Raw:    tftypes.NewValue(currentSchema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			upgrader.StateUpgrader(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("upgrade returned errors: %v", resp.Diagnostics)
+			}
+
+			var data VtexUserRoleResourceModel
+			if diags := resp.State.Get(ctx, &data); diags.HasError() {
+				t.Fatalf("could not read upgraded state: %v", diags)
+			}
+
+			if got := data.ID.ValueString(); got != test.wantID {
+				t.Errorf("id = %q, want %q", got, test.wantID)
+			}
+			if got := data.RoleName.ValueString(); got != test.wantRole {
+				t.Errorf("role_name = %q, want %q", got, test.wantRole)
+			}
+			if !data.RoleNames.IsNull() {
+				t.Errorf("role_names = %s, want null", data.RoleNames)
+			}
+			if !data.UserID.IsNull() {
+				t.Errorf("user_id = %s, want null", data.UserID)
+			}
+			if !data.Timeouts.IsNull() {
+				t.Errorf("timeouts = %s, want null", data.Timeouts)
+			}
+
+			defaults := map[string]struct{ got, want interface{} }{
+				"deletion_protection":    {data.DeletionProtection, types.BoolValue(false)},
+				"remove_on_destroy":      {data.RemoveOnDestroy, types.BoolValue(true)},
+				"delete_user_on_destroy": {data.DeleteUserOnDestroy, types.BoolValue(false)},
+				"on_existing":            {data.OnExisting, types.StringValue(onExistingOverwrite)},
+				"exists_policy":          {data.ExistsPolicy, types.StringValue(existsPolicyAssume)},
+			}
+			for attribute, value := range defaults {
+				if value.got != value.want {
+					t.Errorf("%s = %v, want %v", attribute, value.got, value.want)
+				}
+			}
+		})
+	}
+}
+
+func TestUpgradeUserRoleStateV0KeepsName(t *testing.T) {
+	data := upgradeUserRoleStateV0(VtexUserRoleResourceModelV0{
+		ID:       types.StringValue("jane@example.com:vendor:Owner"),
+		Email:    types.StringValue("jane@example.com"),
+		Name:     types.StringValue("Jane Doe"),
+		Account:  types.StringValue("vendor"),
+		RoleName: types.StringValue("Owner"),
+	})
+
+	if got := data.Name.ValueString(); got != "Jane Doe" {
+		t.Errorf("name = %q, want %q", got, "Jane Doe")
+	}
+	if got := data.Email.ValueString(); got != "jane@example.com" {
+		t.Errorf("email = %q, want %q", got, "jane@example.com")
+	}
+	if got := data.Account.ValueString(); got != "vendor" {
+		t.Errorf("account = %q, want %q", got, "vendor")
+	}
+}
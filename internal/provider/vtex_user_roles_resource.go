@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ resource.Resource = &VtexUserRolesResource{}
+
+func NewVtexUserRolesResource() resource.Resource {
+	return &VtexUserRolesResource{}
+}
+
+// VtexUserRolesResource is the bulk resource implementation
+type VtexUserRolesResource struct {
+	client *client.VtexClient
+}
+
+// VtexUserRolesResourceModel is the resource data model
+type VtexUserRolesResourceModel struct {
+	ID      types.String             `tfsdk:"id"`
+	Account types.String             `tfsdk:"account"`
+	Users   []VtexUserRolesUserModel `tfsdk:"users"`
+
+	RemoveOnDestroy types.Bool `tfsdk:"remove_on_destroy"`
+}
+
+// VtexUserRolesUserModel is one email/role assignment inside vtex_user_roles
+type VtexUserRolesUserModel struct {
+	Email    EmailValue   `tfsdk:"email"`
+	Name     types.String `tfsdk:"name"`
+	RoleName types.String `tfsdk:"role_name"`
+}
+
+func (r *VtexUserRolesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_roles"
+}
+
+func (r *VtexUserRolesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of user role assignments for one VTEX account in a single API payload.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique ID of the resource (account)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "VTEX account where the roles will be assigned (e.g. vendor)",
+				Validators: []validator.String{
+					accountNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "Set of user role assignments",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Required:    true,
+							CustomType:  EmailType{},
+							Description: "User email (case and surrounding spaces are ignored)",
+							Validators: []validator.String{
+								emailValidator{},
+							},
+						},
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "User name (if not given, it is taken from email)",
+						},
+						"role_name": schema.StringAttribute{
+							Required:    true,
+							Description: "Role name to assign (e.g. Owner, Operation)",
+							Validators: []validator.String{
+								stringLengthValidator{max: roleNameMaxLength},
+							},
+						},
+					},
+				},
+			},
+			"remove_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "When false, destroying the resource only removes it from the state and the users keep the roles in VTEX",
+			},
+		},
+	}
+}
+
+func (r *VtexUserRolesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VtexUserRolesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VtexUserRolesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users := userRolesFromModel(data.Account.ValueString(), data.Users)
+
+	tflog.Debug(ctx, "Creating VTEX user roles", map[string]interface{}{
+		"account": data.Account.ValueString(),
+		"count":   len(users),
+	})
+
+	_, err := r.client.CreateUserRoles(ctx, users)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VTEX User Roles",
+			"Could not create user roles, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(data.Account.ValueString())
+
+	tflog.Trace(ctx, "Created VTEX user roles", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexUserRolesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VtexUserRolesResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Refresh does not look the users up: that is one License Manager call per user, and
+	// License Manager is not available for every account. We assume the assignments exist
+	// if they are in the state
+
+	tflog.Debug(ctx, "Reading VTEX user roles", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VtexUserRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VtexUserRolesResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account := plan.Account.ValueString()
+	planned := userRolesFromModel(account, plan.Users)
+	current := userRolesFromModel(account, state.Users)
+
+	// Name changes are sent again as creates, only dropped assignments are removed
+	toAdd := diffUserRoles(planned, current, false)
+	toRemove := diffUserRoles(current, planned, true)
+
+	tflog.Debug(ctx, "Updating VTEX user roles", map[string]interface{}{
+		"account": account,
+		"add":     len(toAdd),
+		"remove":  len(toRemove),
+	})
+
+	// Add first, so users moving between roles are never left without one
+	if len(toAdd) > 0 {
+		_, err := r.client.CreateUserRoles(ctx, toAdd)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating VTEX User Roles",
+				"Could not add user roles, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if len(toRemove) > 0 {
+		err := r.client.DeleteUserRoles(ctx, toRemove)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating VTEX User Roles",
+				"Could not remove user roles, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VtexUserRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VtexUserRolesResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RemoveOnDestroy.ValueBool() {
+		tflog.Info(ctx, "remove_on_destroy is false, removing VTEX user roles from state only", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		return
+	}
+
+	users := userRolesFromModel(data.Account.ValueString(), data.Users)
+
+	tflog.Debug(ctx, "Deleting VTEX user roles", map[string]interface{}{
+		"account": data.Account.ValueString(),
+		"count":   len(users),
+	})
+
+	err := r.client.DeleteUserRoles(ctx, users)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting VTEX User Roles",
+			"Could not delete user roles, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted VTEX user roles", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// userRolesFromModel converts the users set into API payload entries
+func userRolesFromModel(account string, users []VtexUserRolesUserModel) []client.UserRole {
+	result := make([]client.UserRole, 0, len(users))
+	for _, u := range users {
+		name := u.Name.ValueString()
+		if name == "" {
+			name = nameFromEmail(u.Email.NormalizedValue())
+		}
+		result = append(result, client.UserRole{
+			Email:    u.Email.NormalizedValue(),
+			Name:     name,
+			Account:  account,
+			RoleName: u.RoleName.ValueString(),
+		})
+	}
+	return result
+}
+
+// diffUserRoles returns the entries of a that are not in b.
+// With sameAssignment, entries only need the same email and role to match (name is ignored).
+func diffUserRoles(a, b []client.UserRole, sameAssignment bool) []client.UserRole {
+	key := func(u client.UserRole) client.UserRole {
+		if sameAssignment {
+			return client.UserRole{Email: u.Email, Account: u.Account, RoleName: u.RoleName}
+		}
+		return u
+	}
+
+	existing := make(map[client.UserRole]bool, len(b))
+	for _, u := range b {
+		existing[key(u)] = true
+	}
+
+	var result []client.UserRole
+	for _, u := range a {
+		if !existing[key(u)] {
+			result = append(result, u)
+		}
+	}
+	return result
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davispalomino/terraform-provider-vtex/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Check that types satisfy framework interfaces
+var _ datasource.DataSource = &VtexWarehousesDataSource{}
+
+func NewVtexWarehousesDataSource() datasource.DataSource {
+	return &VtexWarehousesDataSource{}
+}
+
+// VtexWarehousesDataSource is the data source implementation
+type VtexWarehousesDataSource struct {
+	client *client.VtexClient
+}
+
+// VtexWarehousesDataSourceModel is the data source data model
+type VtexWarehousesDataSourceModel struct {
+	ID         types.String             `tfsdk:"id"`
+	ActiveOnly types.Bool               `tfsdk:"active_only"`
+	Warehouses []VtexWarehouseItemModel `tfsdk:"warehouses"`
+}
+
+// VtexWarehouseItemModel is a warehouse in the list
+type VtexWarehouseItemModel struct {
+	ID             types.String             `tfsdk:"id"`
+	Name           types.String             `tfsdk:"name"`
+	Active         types.Bool               `tfsdk:"active"`
+	Docks          []VtexWarehouseDockModel `tfsdk:"docks"`
+	PickupPointIDs []types.String           `tfsdk:"pickup_point_ids"`
+}
+
+// VtexWarehouseDockModel is a dock of a warehouse
+type VtexWarehouseDockModel struct {
+	DockID types.String  `tfsdk:"dock_id"`
+	Time   types.String  `tfsdk:"time"`
+	Cost   types.Float64 `tfsdk:"cost"`
+}
+
+func (d *VtexWarehousesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warehouses"
+}
+
+func (d *VtexWarehousesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Logistics warehouses of the account with their docks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "\"active\" when only active warehouses are listed, else \"all\"",
+			},
+			"active_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list active warehouses",
+			},
+			"warehouses": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Warehouses found",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Warehouse ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Warehouse name",
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the warehouse is active",
+						},
+						"docks": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Docks the orders of the warehouse leave from",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"dock_id": schema.StringAttribute{
+										Computed:    true,
+										Description: "Dock ID",
+									},
+									"time": schema.StringAttribute{
+										Computed:    true,
+										Description: "Time to move orders to the dock, as a Go duration",
+									},
+									"cost": schema.Float64Attribute{
+										Computed:    true,
+										Description: "Cost to move orders to the dock",
+									},
+								},
+							},
+						},
+						"pickup_point_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the pickup points served by the warehouse",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VtexWarehousesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if provider is not configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VtexClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VtexClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VtexWarehousesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VtexWarehousesDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activeOnly := data.ActiveOnly.ValueBool()
+
+	tflog.Debug(ctx, "Listing VTEX warehouses", map[string]interface{}{
+		"active_only": activeOnly,
+	})
+
+	warehouses, err := d.client.ListWarehouses(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VTEX Warehouses",
+			"Could not list warehouses, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Warehouses = make([]VtexWarehouseItemModel, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		if activeOnly && !warehouse.IsActive {
+			continue
+		}
+
+		docks := make([]VtexWarehouseDockModel, 0, len(warehouse.WarehouseDocks))
+		for _, dock := range warehouse.WarehouseDocks {
+			docks = append(docks, VtexWarehouseDockModel{
+				DockID: types.StringValue(dock.DockID),
+				Time:   timeSpanValue(dock.Time, types.StringNull()),
+				Cost:   types.Float64Value(dock.Cost),
+			})
+		}
+
+		data.Warehouses = append(data.Warehouses, VtexWarehouseItemModel{
+			ID:             types.StringValue(warehouse.ID),
+			Name:           types.StringValue(warehouse.Name),
+			Active:         types.BoolValue(warehouse.IsActive),
+			Docks:          docks,
+			PickupPointIDs: stringValues(warehouse.PickupPointIDs),
+		})
+	}
+
+	data.ID = types.StringValue("all")
+	if activeOnly {
+		data.ID = types.StringValue("active")
+	}
+
+	tflog.Trace(ctx, "Listed VTEX warehouses", map[string]interface{}{
+		"count": len(data.Warehouses),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}